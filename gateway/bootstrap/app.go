@@ -13,7 +13,9 @@ import (
 	commentpb "voidspaceGateway/proto/generated/comments/v1"
 	postpb "voidspaceGateway/proto/generated/posts/v1"
 	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
 
+	"github.com/vhysxl/voidspace/shared/utils/grpcclient"
 	"github.com/vhysxl/voidspace/shared/utils/helper"
 
 	"github.com/go-playground/validator/v10"
@@ -43,6 +45,10 @@ func App() (*Application, error) {
 	config := config.GetConfig()
 
 	validator := validator.New()
+	err = validator.RegisterValidation("maxgraphemes", utils.MaxGraphemes)
+	if err != nil {
+		return nil, err
+	}
 
 	logger, err := helper.InitLogger()
 	if err != nil {
@@ -56,17 +62,17 @@ func App() (*Application, error) {
 	}
 
 	// gRPC Connections to microservices
-	userConn, err := NewConn(config.UserServiceAddr, config.Environment)
+	userConn, err := grpcclient.NewConn(config.UserServiceAddr, config.Environment)
 	if err != nil {
 		return nil, err
 	}
 
-	postConn, err := NewConn(config.PostServiceAddr, config.Environment)
+	postConn, err := grpcclient.NewConn(config.PostServiceAddr, config.Environment)
 	if err != nil {
 		return nil, err
 	}
 
-	commentConn, err := NewConn(config.CommentServiceAddr, config.Environment)
+	commentConn, err := grpcclient.NewConn(config.CommentServiceAddr, config.Environment)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +89,7 @@ func App() (*Application, error) {
 		commentpb.NewCommentServiceClient(commentConn),
 		temporalService.Client,
 		temporalService.Service,
+		config.PublicURL,
 	)
 
 	postService := post_service.NewPostService(
@@ -27,6 +27,10 @@ func main() {
 
 	temporal.RegisterTemporal(app)
 
+	// each failed schedule is logged on its own, without them trending
+	// hashtags and likes counts drift but the API still works
+	_ = temporal.StartSchedules(app)
+
 	go func() {
 		if err := app.TemporalService.TemporalStart(); err != nil {
 			app.Logger.Fatal("Failed to start Temporal worker", zap.Error(err))
@@ -13,6 +13,7 @@ type Config struct {
 	Port                  string
 	PublicKey             *rsa.PublicKey
 	ApiSecret             string
+	AdminSecret           string
 	ContextTimeout        int
 	UserServiceAddr       string
 	PostServiceAddr       string
@@ -21,6 +22,10 @@ type Config struct {
 	TemporalPort          string
 	Environment           string
 	GoogleCredentialsPath string
+	PublicURL             string
+	// CelebrityFollowers is the follower count from which an author's posts
+	// are pulled into home timelines instead of fanned out
+	CelebrityFollowers int
 }
 
 var (
@@ -47,6 +52,7 @@ func initConfig() Config {
 		Port:                  helper.GetEnv("PORT", "8080"),
 		PublicKey:             publicKey,
 		ApiSecret:             helper.GetEnv("API_SECRET", "SUPER SECRET LMAO"),
+		AdminSecret:           helper.GetEnv("ADMIN_API_SECRET", ""),
 		ContextTimeout:        helper.GetEnvInt("CONTEXT_TIMEOUT", 30),
 		UserServiceAddr:       helper.GetEnv("USER_SERVICE_URL", "localhost:8080"),
 		PostServiceAddr:       helper.GetEnv("POST_SERVICE_URL", "localhost:5000"),
@@ -55,5 +61,7 @@ func initConfig() Config {
 		TemporalPort:          helper.GetEnv("TEMPORAL_PORT", "localhost:7233"),
 		Environment:           helper.GetEnv("ENV", "PROD"),
 		GoogleCredentialsPath: helper.GetEnv("GOOGLE_APPLICATION_CREDENTIALS", "/etc/secrets/credentials_gcs"),
+		PublicURL:             helper.GetEnv("PUBLIC_URL", "http://localhost:3000"),
+		CelebrityFollowers:    helper.GetEnvInt("TIMELINE_CELEBRITY_FOLLOWERS", 10000),
 	}
 }
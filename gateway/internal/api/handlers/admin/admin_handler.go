@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"time"
+	post_service "voidspaceGateway/internal/service/post"
+	user_service "voidspaceGateway/internal/service/user"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+type AdminHandler struct {
+	ContextTimeout time.Duration
+	Logger         *zap.Logger
+	Validator      *validator.Validate
+	UserService    *user_service.UserService
+	PostService    *post_service.PostService
+}
+
+func NewAdminHandler(
+	timeout time.Duration,
+	logger *zap.Logger,
+	validator *validator.Validate,
+	userService *user_service.UserService,
+	postService *post_service.PostService,
+) *AdminHandler {
+	return &AdminHandler{
+		ContextTimeout: timeout,
+		Logger:         logger,
+		Validator:      validator,
+		UserService:    userService,
+		PostService:    postService,
+	}
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListInvites lists every invite with its redemptions, optionally only those
+// issued by issuer_id, paging with cursor (the last invite id).
+func (h *AdminHandler) ListInvites(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := &userpb.ListInvitesRequest{}
+
+	if issuerID := c.QueryParam("issuer_id"); issuerID != "" {
+		id, err := strconv.ParseInt(issuerID, 10, 64)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.IssuerId = &id
+	}
+
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		id, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.CursorId = &id
+	}
+
+	if limit := c.QueryParam("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.Limit = int32(l)
+	}
+
+	res, err := h.UserService.AdminListInvites(ctx, req)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to list invites")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.ListInvitesSuccess, res)
+}
@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QuerySecurityEvents filters the audit log by user_id, event_type and an
+// RFC3339 since/until window, paging with cursor (the last event id).
+func (h *AdminHandler) QuerySecurityEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := &userpb.QuerySecurityEventsRequest{}
+
+	if userID := c.QueryParam("user_id"); userID != "" {
+		id, err := strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.UserId = &id
+	}
+
+	if eventType := c.QueryParam("event_type"); eventType != "" {
+		req.EventType = &eventType
+	}
+
+	if since := c.QueryParam("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.Since = timestamppb.New(t)
+	}
+
+	if until := c.QueryParam("until"); until != "" {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.Until = timestamppb.New(t)
+	}
+
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		id, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.CursorId = &id
+	}
+
+	if limit := c.QueryParam("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		req.Limit = int32(l)
+	}
+
+	res, err := h.UserService.QuerySecurityEvents(ctx, req)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to query security events")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetSecurityLogSuccess, res)
+}
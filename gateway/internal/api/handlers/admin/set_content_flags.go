@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SetContentFlags lets a moderator set a post's content warning and flag or
+// clear its images as sensitive.
+func (h *AdminHandler) SetContentFlags(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	req := new(models.ContentFlagsRequest)
+	if err := c.Bind(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
+	}
+
+	res, err := h.PostService.SetContentFlags(ctx, postID, req)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to set content flags")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.SetContentFlagsSuccess, res)
+}
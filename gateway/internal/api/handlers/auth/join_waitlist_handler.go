@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *AuthHandler) JoinWaitlist(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := new(models.JoinWaitlistRequest)
+	if err := c.Bind(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
+	}
+
+	if err := h.UserService.JoinWaitlist(ctx, req, utils.ExtractClientInfo(c)); err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to join waitlist")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusCreated, constants.JoinWaitlistSuccess, nil)
+}
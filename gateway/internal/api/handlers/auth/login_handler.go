@@ -24,7 +24,7 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
 	}
 
-	res, err := h.UserService.Login(ctx, requestBody)
+	res, err := h.UserService.Login(ctx, requestBody, utils.ExtractClientInfo(c))
 	if err != nil {
 		h.Logger.Error("failed to login", zap.Error(err))
 		code, msg := utils.GRPCErrorToHTTP(err)
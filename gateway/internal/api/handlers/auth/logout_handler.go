@@ -2,13 +2,18 @@ package auth
 
 import (
 	"net/http"
+	"strconv"
 	"voidspaceGateway/internal/api/responses"
 	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/utils"
 
 	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
 )
 
 func (h *AuthHandler) Logout(c echo.Context) error {
+	h.revokeCurrentSession(c)
+
 	cookie := &http.Cookie{
 		Name:     "refresh_token",
 		Value:    "",
@@ -26,3 +31,31 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 		nil,
 	)
 }
+
+// revokeCurrentSession revokes the session bound to the refresh cookie so the
+// token cannot be replayed after logout. Failures never block the logout.
+func (h *AuthHandler) revokeCurrentSession(c echo.Context) {
+	cookie, err := c.Cookie("refresh_token")
+	if err != nil || cookie.Value == "" {
+		return
+	}
+
+	claims, err := utils.VerifyToken(cookie.Value, h.PublicKey)
+	if err != nil {
+		return
+	}
+
+	userID, _ := claims["ID"].(string)
+	username, _ := claims["Username"].(string)
+	sessionClaim, _ := claims["SessionID"].(string)
+
+	sessionID, err := strconv.ParseInt(sessionClaim, 10, 64)
+	if err != nil {
+		return
+	}
+
+	err = h.UserService.RevokeSession(c.Request().Context(), userID, username, sessionID)
+	if err != nil {
+		h.Logger.Warn("failed to revoke session on logout", zap.Error(err))
+	}
+}
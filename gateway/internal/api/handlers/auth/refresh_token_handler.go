@@ -29,7 +29,11 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	userID := claims["ID"].(string)
 	username := claims["Username"].(string)
 
-	res, err := h.UserService.RefreshToken(ctx, userID, username)
+	// tokens issued before session tracking carry no session and are rejected upstream
+	sessionID, _ := claims["SessionID"].(string)
+	tokenFamily, _ := claims["TokenFamily"].(string)
+
+	res, err := h.UserService.RefreshToken(ctx, userID, username, sessionID, tokenFamily)
 	if err != nil {
 		return utils.HandleDialError(h.Logger, c, err, "failed to refresh token")
 	}
@@ -24,7 +24,7 @@ func (h *AuthHandler) Register(c echo.Context) error {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
 	}
 
-	res, err := h.UserService.Register(ctx, r)
+	res, err := h.UserService.Register(ctx, r, utils.ExtractClientInfo(c))
 	if err != nil {
 		return utils.HandleDialError(h.Logger, c, err, "failed to register")
 	}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *AuthHandler) GetRegistrationMode(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	res, err := h.UserService.GetRegistrationMode(ctx)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to get registration mode")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetRegistrationModeSuccess, res)
+}
@@ -0,0 +1,42 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) AddBookmark(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, "invalid post id")
+	}
+
+	// the body is optional, no body means unfiled
+	req := new(models.AddBookmarkRequest)
+	if c.Request().ContentLength > 0 {
+		if err := c.Bind(req); err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
+	}
+
+	if err := h.PostService.AddBookmark(ctx, postID, req, user.Username, user.ID); err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to bookmark post")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.BookmarkSuccess, nil)
+}
@@ -0,0 +1,30 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) CancelScheduled(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	scheduledPostID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	if err := h.PostService.CancelScheduled(ctx, scheduledPostID, user.ID, user.Username); err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to cancel scheduled post")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.CancelScheduledSuccess, nil)
+}
@@ -34,5 +34,9 @@ func (h *PostHandler) Create(c echo.Context) error {
 		return utils.HandleDialError(h.Logger, c, err, "failed to create post")
 	}
 
+	if res.PublishAt != nil {
+		return responses.SuccessResponseMessage(c, http.StatusCreated, constants.PostScheduled, res)
+	}
+
 	return responses.SuccessResponseMessage(c, http.StatusCreated, constants.PostCreated, res)
 }
@@ -0,0 +1,44 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetForYouFeed reads the ranked feed, the first page is requested without
+// query params and later ones with ?snapshot=<snapshot_id>&offset=<next_offset>.
+func (h *PostHandler) GetForYouFeed(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	var snapshotID *int64
+	offset := 0
+
+	if snapshot := c.QueryParam("snapshot"); snapshot != "" {
+		id, err := strconv.ParseInt(snapshot, 10, 64)
+		if err != nil || id <= 0 {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		snapshotID = &id
+
+		offset, err = strconv.Atoi(c.QueryParam("offset"))
+		if err != nil || offset < 0 {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+	}
+
+	res, err := h.PostService.GetForYouFeed(ctx, snapshotID, offset, user.ID, user.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to fetch for you feed")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetFeedSuccess, res)
+}
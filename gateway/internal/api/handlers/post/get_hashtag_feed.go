@@ -0,0 +1,49 @@
+package post
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PostHandler) GetHashtagFeed(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	val := c.Get("authUser")
+	authUser, _ := val.(*models.AuthUser)
+	if authUser == nil {
+		authUser = &models.AuthUser{}
+	}
+
+	tag := c.Param("tag")
+	if tag == "" {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, "invalid hashtag")
+	}
+
+	cursorTime, cursorIDInt := utils.ExtractCursor(c.QueryParam("cursor"), c.QueryParam("cursorid"))
+
+	req := &postpb.GetHashtagFeedRequest{
+		Tag: tag,
+	}
+	if !cursorTime.IsZero() {
+		req.CursorTime = timestamppb.New(cursorTime)
+	}
+	if cursorIDInt > 0 {
+		id := int64(cursorIDInt)
+		req.CursorId = &id
+	}
+
+	res, err := h.PostService.GetHashtagFeed(ctx, req, authUser.ID, authUser.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to fetch hashtag feed")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetFeedSuccess, res)
+}
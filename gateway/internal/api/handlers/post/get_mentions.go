@@ -0,0 +1,31 @@
+package post
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	post_service "voidspaceGateway/internal/service/post"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) GetMentions(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	cursorTime, cursorID := utils.ExtractCursor(c.QueryParam("cursor"), c.QueryParam("cursorid"))
+
+	cursorType := c.QueryParam("cursortype")
+	if cursorType != "" && cursorType != post_service.MentionTypePost && cursorType != post_service.MentionTypeComment {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, "invalid cursor type")
+	}
+
+	res, err := h.PostService.GetMentions(ctx, cursorTime, int64(cursorID), cursorType, user.ID, user.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to fetch mentions")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetMentionsSuccess, res)
+}
@@ -27,7 +27,10 @@ func (h *PostHandler) GetPost(c echo.Context) error {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
 	}
 
-	res, err := h.PostService.GetPost(ctx, postID, authUser.Username, authUser.ID)
+	// ?reveal=true is the viewer opting in to this post's sensitive media
+	reveal := c.QueryParam("reveal") == "true"
+
+	res, err := h.PostService.GetPost(ctx, postID, authUser.Username, authUser.ID, reveal)
 	if err != nil {
 		return utils.HandleDialError(h.Logger, c, err, "failed to get post")
 	}
@@ -0,0 +1,50 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PostHandler) GetThread(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	val := c.Get("authUser")
+	authUser, _ := val.(*models.AuthUser)
+	if authUser == nil {
+		authUser = &models.AuthUser{}
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	cursorTime, cursorIDInt := utils.ExtractCursor(c.QueryParam("cursor"), c.QueryParam("cursorid"))
+
+	req := &postpb.GetThreadRequest{PostId: postID}
+	if !cursorTime.IsZero() {
+		req.CursorTime = timestamppb.New(cursorTime)
+	}
+	if cursorIDInt > 0 {
+		id := int64(cursorIDInt)
+		req.CursorId = &id
+	}
+
+	res, err := h.PostService.GetThread(ctx, req, authUser.ID, authUser.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to get thread")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetThreadSuccess, res)
+}
@@ -0,0 +1,31 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) GetTrendingHashtags(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit := 10
+	if l := c.QueryParam("limit"); l != "" {
+		parsed, err := strconv.Atoi(l)
+		if err != nil || parsed <= 0 {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, "invalid limit")
+		}
+		limit = parsed
+	}
+
+	res, err := h.PostService.GetTrendingHashtags(ctx, limit)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to fetch trending hashtags")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetTrendingSuccess, res)
+}
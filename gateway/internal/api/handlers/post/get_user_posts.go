@@ -5,11 +5,13 @@ import (
 	"voidspaceGateway/internal/api/responses"
 	"voidspaceGateway/internal/constants"
 	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
 	"voidspaceGateway/utils"
 
 	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (h *PostHandler) GetUserPosts(c echo.Context) error {
@@ -28,7 +30,21 @@ func (h *PostHandler) GetUserPosts(c echo.Context) error {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
 	}
 
-	res, err := h.PostService.GetUserPosts(ctx, username, authUser.ID, authUser.Username)
+	cursor := c.QueryParam("cursor")
+	cursorID := c.QueryParam("cursorid")
+
+	cursorTime, cursorIDInt := utils.ExtractCursor(cursor, cursorID)
+
+	req := &postpb.GetUserPostsRequest{}
+	if !cursorTime.IsZero() {
+		req.CursorTime = timestamppb.New(cursorTime)
+	}
+	if cursorIDInt > 0 {
+		id := int64(cursorIDInt)
+		req.CursorId = &id
+	}
+
+	res, err := h.PostService.GetUserPosts(ctx, username, req, authUser.ID, authUser.Username)
 	if err != nil {
 		return utils.HandleDialError(h.Logger, c, err, "failed to get user posts")
 	}
@@ -0,0 +1,23 @@
+package post
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) ListBookmarkFolders(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	res, err := h.PostService.ListBookmarkFolders(ctx, user.ID, user.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to fetch bookmark folders")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetBookmarkFoldersSuccess, res)
+}
@@ -0,0 +1,55 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PostHandler) ListPostLikers(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	val := c.Get("authUser")
+	authUser, _ := val.(*models.AuthUser)
+	if authUser == nil {
+		authUser = &models.AuthUser{}
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	cursor := c.QueryParam("cursor")
+	cursorID := c.QueryParam("cursorid")
+
+	cursorTime, cursorIDInt := utils.ExtractCursor(cursor, cursorID)
+
+	req := &postpb.ListPostLikersRequest{PostId: postID}
+	if !cursorTime.IsZero() {
+		req.CursorTime = timestamppb.New(cursorTime)
+	}
+	if cursorIDInt > 0 {
+		id := int64(cursorIDInt)
+		req.CursorId = &id
+	}
+	if cursorFollowed, err := strconv.ParseBool(c.QueryParam("cursorfollowed")); err == nil {
+		req.CursorFollowed = &cursorFollowed
+	}
+
+	res, err := h.PostService.ListPostLikers(ctx, req, authUser.ID, authUser.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to list post likers")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetLikersSuccess, res)
+}
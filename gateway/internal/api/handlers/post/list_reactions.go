@@ -0,0 +1,21 @@
+package post
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) ListReactions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	res, err := h.PostService.ListReactions(ctx)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to list reactions")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetReactionsSuccess, res)
+}
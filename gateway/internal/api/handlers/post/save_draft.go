@@ -0,0 +1,48 @@
+package post
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *PostHandler) CreateDraft(c echo.Context) error {
+	return h.saveDraft(c, nil)
+}
+
+func (h *PostHandler) UpdateDraft(c echo.Context) error {
+	draftID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	return h.saveDraft(c, &draftID)
+}
+
+func (h *PostHandler) saveDraft(c echo.Context, draftID *int64) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	req := new(models.SaveDraftRequest)
+	if err := c.Bind(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
+	}
+
+	res, err := h.PostService.SaveDraft(ctx, draftID, req, user.ID, user.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to save draft")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.SaveDraftSuccess, res)
+}
@@ -14,7 +14,7 @@ func (h *UserHandler) DeleteUser(c echo.Context) error {
 	ctx := c.Request().Context()
 	user := c.Get("authUser").(*models.AuthUser)
 
-	if err := h.UserService.DeleteUser(ctx, user.ID, user.Username); err != nil {
+	if err := h.UserService.DeleteUser(ctx, user.ID, user.Username, utils.ExtractClientInfo(c)); err != nil {
 		return utils.HandleDialError(h.Logger, c, err, "failed to delete user")
 	}
 
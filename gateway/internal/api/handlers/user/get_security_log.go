@@ -0,0 +1,35 @@
+package user
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) GetSecurityLog(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	var cursorID int64
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		parsed, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+		}
+		cursorID = parsed
+	}
+
+	res, err := h.UserService.ListSecurityEvents(ctx, user.ID, user.Username, cursorID)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to get security log")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetSecurityLogSuccess, res)
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) GetSettings(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	settings, err := h.UserService.GetSettings(ctx, user.ID, user.Username)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to get settings")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.GetSettingsSuccess, settings)
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) ListSessions(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	sessions, err := h.UserService.ListSessions(ctx, user.ID, user.Username, user.SessionID)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to list sessions")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.ListSessionsSuccess, sessions)
+}
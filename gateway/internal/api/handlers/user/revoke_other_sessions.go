@@ -0,0 +1,23 @@
+package user
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) RevokeAllOtherSessions(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	res, err := h.UserService.RevokeAllOtherSessions(ctx, user.ID, user.Username, user.SessionID)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to revoke other sessions")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.RevokeOtherSessionsSuccess, res)
+}
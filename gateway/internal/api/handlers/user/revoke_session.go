@@ -0,0 +1,30 @@
+package user
+
+import (
+	"net/http"
+	"strconv"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) RevokeSession(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	sessionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	if err := h.UserService.RevokeSession(ctx, user.ID, user.Username, sessionID); err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to revoke session")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.RevokeSessionSuccess, nil)
+}
@@ -21,7 +21,7 @@ func (h *UserHandler) UpdateProfile(c echo.Context) error {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
 	}
 
-	if *req == (models.UpdateProfileRequest{}) {
+	if req.IsEmpty() {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, constants.ErrNoField)
 	}
 
@@ -29,7 +29,7 @@ func (h *UserHandler) UpdateProfile(c echo.Context) error {
 		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
 	}
 
-	if err := h.UserService.UpdateProfile(ctx, user.ID, user.Username, req); err != nil {
+	if err := h.UserService.UpdateProfile(ctx, user.ID, user.Username, req, utils.ExtractClientInfo(c)); err != nil {
 		return utils.HandleDialError(h.Logger, c, err, "failed to update profile")
 	}
 
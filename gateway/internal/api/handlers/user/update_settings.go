@@ -0,0 +1,38 @@
+package user
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) UpdateSettings(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	req := new(models.UpdateSettingsRequest)
+	if err := c.Bind(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, shared_constants.InvalidRequest)
+	}
+
+	if *req == (models.UpdateSettingsRequest{}) {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, constants.ErrNoField)
+	}
+
+	if err := h.Validator.Struct(req); err != nil {
+		return responses.ErrorResponseMessage(c, http.StatusBadRequest, utils.FormatValidationError(err))
+	}
+
+	settings, err := h.UserService.UpdateSettings(ctx, user.ID, user.Username, req)
+	if err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to update settings")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusOK, constants.UpdateSettingsSuccess, settings)
+}
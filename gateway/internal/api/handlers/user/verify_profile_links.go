@@ -0,0 +1,22 @@
+package user
+
+import (
+	"net/http"
+	"voidspaceGateway/internal/api/responses"
+	"voidspaceGateway/internal/constants"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *UserHandler) VerifyProfileLinks(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("authUser").(*models.AuthUser)
+
+	if err := h.UserService.VerifyProfileLinks(ctx, user.ID, user.Username); err != nil {
+		return utils.HandleDialError(h.Logger, c, err, "failed to verify profile links")
+	}
+
+	return responses.SuccessResponseMessage(c, http.StatusAccepted, constants.VerifyLinksStarted, nil)
+}
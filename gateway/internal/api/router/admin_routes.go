@@ -0,0 +1,21 @@
+package router
+
+import (
+	admin_handler "voidspaceGateway/internal/api/handlers/admin"
+
+	"github.com/labstack/echo/v4"
+)
+
+func AdminRoutes(
+	api *echo.Group,
+	adminHandler *admin_handler.AdminHandler,
+	adminMiddleware echo.MiddlewareFunc,
+) {
+	admin := api.Group("/admin")
+	admin.Use(adminMiddleware)
+
+	admin.GET("/security-events", adminHandler.QuerySecurityEvents)
+	admin.GET("/invites", adminHandler.ListInvites)
+	admin.POST("/invites", adminHandler.CreateInvite)
+	admin.PUT("/posts/:id/content-flags", adminHandler.SetContentFlags)
+}
@@ -17,4 +17,7 @@ func AuthRoutes(
 	auth.POST("/login", authHandler.Login)
 	auth.POST("/logout", authHandler.Logout)
 	auth.POST("/refresh", authHandler.RefreshToken)
+
+	auth.GET("/registration-mode", authHandler.GetRegistrationMode)
+	auth.POST("/waitlist", authHandler.JoinWaitlist)
 }
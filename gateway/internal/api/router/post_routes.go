@@ -16,6 +16,9 @@ func PostRoutes(
 	postsPublic := api.Group("/posts")
 	postsPublic.Use(optionalAuthMiddleware)
 	postsPublic.GET("/:id", postHandler.GetPost)
+	postsPublic.GET("/:id/thread", postHandler.GetThread)
+	postsPublic.GET("/:id/history", postHandler.GetPostHistory)
+	postsPublic.GET("/:id/likes", postHandler.ListPostLikers)
 	postsPublic.GET("/user/:username", postHandler.GetUserPosts)
 	postsPublic.GET("/liked/:username", postHandler.GetLikedPosts)
 
@@ -23,10 +26,37 @@ func PostRoutes(
 	postsPrivate := api.Group("/posts")
 	postsPrivate.Use(authMiddleware)
 	postsPrivate.POST("", postHandler.Create)
+	postsPrivate.GET("/scheduled", postHandler.ListScheduled)
+	postsPrivate.PUT("/scheduled/:id", postHandler.UpdateScheduled)
+	postsPrivate.DELETE("/scheduled/:id", postHandler.CancelScheduled)
 	postsPrivate.PUT("/:id", postHandler.Update)
 	postsPrivate.DELETE("/:id", postHandler.Delete)
 	postsPrivate.POST("/:id/like", postHandler.LikePost)
 	postsPrivate.DELETE("/:id/like", postHandler.UnlikePost)
+	postsPrivate.PUT("/:id/reaction", postHandler.ReactToPost)
+	postsPrivate.DELETE("/:id/reaction", postHandler.RemoveReaction)
+	postsPrivate.POST("/:id/repost", postHandler.Repost)
+	postsPrivate.DELETE("/:id/repost", postHandler.UndoRepost)
+	postsPrivate.POST("/:id/poll/vote", postHandler.VotePoll)
+	postsPrivate.POST("/:id/pin", postHandler.PinPost)
+	postsPrivate.DELETE("/:id/pin", postHandler.UnpinPost)
+	postsPrivate.POST("/:id/bookmark", postHandler.AddBookmark)
+	postsPrivate.DELETE("/:id/bookmark", postHandler.RemoveBookmark)
+
+	// Draft routes
+	drafts := api.Group("/drafts")
+	drafts.Use(authMiddleware)
+	drafts.GET("", postHandler.ListDrafts)
+	drafts.POST("", postHandler.CreateDraft)
+	drafts.PUT("/:id", postHandler.UpdateDraft)
+	drafts.DELETE("/:id", postHandler.DeleteDraft)
+	drafts.POST("/:id/publish", postHandler.PublishDraft)
+
+	// Bookmark routes
+	bookmarks := api.Group("/bookmarks")
+	bookmarks.Use(authMiddleware)
+	bookmarks.GET("", postHandler.ListBookmarks)
+	bookmarks.GET("/folders", postHandler.ListBookmarkFolders)
 
 	// Feed routes
 	feed := api.Group("/feed")
@@ -36,4 +66,20 @@ func PostRoutes(
 	feedFollowing := api.Group("/feed/following")
 	feedFollowing.Use(authMiddleware)
 	feedFollowing.GET("", postHandler.GetFollowingFeed)
+
+	feedForYou := api.Group("/feed/for-you")
+	feedForYou.Use(authMiddleware)
+	feedForYou.GET("", postHandler.GetForYouFeed)
+
+	mentions := api.Group("/mentions")
+	mentions.Use(authMiddleware)
+	mentions.GET("", postHandler.GetMentions)
+
+	// Hashtag routes
+	tags := api.Group("/tags")
+	tags.Use(optionalAuthMiddleware)
+	tags.GET("/:tag", postHandler.GetHashtagFeed)
+
+	api.GET("/trending", postHandler.GetTrendingHashtags)
+	api.GET("/reactions", postHandler.ListReactions)
 }
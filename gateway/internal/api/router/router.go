@@ -2,6 +2,7 @@ package router
 
 import (
 	"voidspaceGateway/bootstrap"
+	admin_handler "voidspaceGateway/internal/api/handlers/admin"
 	auth_handler "voidspaceGateway/internal/api/handlers/auth"
 	comment_handler "voidspaceGateway/internal/api/handlers/comment"
 	follow_handler "voidspaceGateway/internal/api/handlers/follow"
@@ -68,10 +69,19 @@ func SetupRoutes(app *bootstrap.Application, e *echo.Echo) {
 		app.Logger,
 	)
 
+	adminHandler := admin_handler.NewAdminHandler(
+		app.ContextTimeout,
+		app.Logger,
+		app.Validator,
+		app.UserService,
+		app.PostService,
+	)
+
 	// MIDDLEWARE
 	authMiddleware := middleware.AuthMiddleware((app.Config.PublicKey))
 	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(app.Config.PublicKey)
 	apiMiddleware := middleware.ApiMiddleware(app.Config.ApiSecret)
+	adminMiddleware := middleware.AdminMiddleware(app.Config.AdminSecret)
 
 	api := e.Group("/api/v2")
 	api.Use(apiMiddleware)
@@ -84,4 +94,5 @@ func SetupRoutes(app *bootstrap.Application, e *echo.Echo) {
 	CommentRoutes(api, commentHandler, authMiddleware)
 	UploadRoutes(api, uploadHandler, authMiddleware)
 	SearchRoutes(api, searchHandler)
+	AdminRoutes(api, adminHandler, adminMiddleware)
 }
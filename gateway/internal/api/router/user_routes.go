@@ -23,4 +23,17 @@ func UserRoutes(
 	user.GET("/me", userHandler.GetCurrentUser, authMiddleware)
 	user.PUT("/me", userHandler.UpdateProfile, authMiddleware)
 	user.DELETE("/me", userHandler.DeleteUser, authMiddleware)
+	user.POST("/me/links/verify", userHandler.VerifyProfileLinks, authMiddleware)
+
+	user.GET("/me/sessions", userHandler.ListSessions, authMiddleware)
+	user.DELETE("/me/sessions", userHandler.RevokeAllOtherSessions, authMiddleware)
+	user.DELETE("/me/sessions/:id", userHandler.RevokeSession, authMiddleware)
+
+	user.GET("/me/security-log", userHandler.GetSecurityLog, authMiddleware)
+
+	user.GET("/me/invites", userHandler.ListInvites, authMiddleware)
+	user.POST("/me/invites", userHandler.CreateInvite, authMiddleware)
+
+	user.GET("/me/settings", userHandler.GetSettings, authMiddleware)
+	user.PUT("/me/settings", userHandler.UpdateSettings, authMiddleware)
 }
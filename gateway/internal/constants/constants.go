@@ -20,19 +20,82 @@ const (
 	UnfollowSuccess        = "User unfollowed successfully"
 	ListFollowersSuccess   = "Followers retrieved successfully"
 	ListFollowingSuccess   = "Following retrieved successfully"
+	VerifyLinksStarted     = "Link verification started"
+
+	// Session
+	ListSessionsSuccess        = "Sessions retrieved successfully"
+	RevokeSessionSuccess       = "Session revoked successfully"
+	RevokeOtherSessionsSuccess = "Other sessions revoked successfully"
+	GetSecurityLogSuccess      = "Security log retrieved successfully"
+
+	// Invites
+	GetRegistrationModeSuccess = "Registration mode retrieved successfully"
+	JoinWaitlistSuccess        = "Joined the waitlist successfully"
+	CreateInviteSuccess        = "Invite created successfully"
+	ListInvitesSuccess         = "Invites retrieved successfully"
+
+	// Moderation
+	SetContentFlagsSuccess = "Content flags updated successfully"
+
+	// Settings
+	GetSettingsSuccess    = "Settings retrieved successfully"
+	UpdateSettingsSuccess = "Settings updated successfully"
 
 	// Post
 	PostCreated        = "Post created successfully"
 	GetPostSuccess     = "Post retrieved successfully"
 	UpdatePostSuccess  = "Post updated successfully"
 	DeletePostSuccess  = "Post deleted successfully"
+	GetThreadSuccess   = "Thread retrieved successfully"
+	GetHistorySuccess  = "Post history retrieved successfully"
 	GetFeedSuccess     = "Feed retrieved successfully"
 	GetUserPostsSuccess  = "User posts retrieved successfully"
 	GetLikedPostsSuccess = "Liked posts retrieved successfully"
 
+	// Scheduled post
+	PostScheduled          = "Post scheduled successfully"
+	GetScheduledSuccess    = "Scheduled posts retrieved successfully"
+	UpdateScheduledSuccess = "Scheduled post updated successfully"
+	CancelScheduledSuccess = "Scheduled post cancelled successfully"
+
+	// Draft
+	SaveDraftSuccess    = "Draft saved successfully"
+	GetDraftsSuccess    = "Drafts retrieved successfully"
+	DeleteDraftSuccess  = "Draft deleted successfully"
+	PublishDraftSuccess = "Draft published successfully"
+
+	// Poll
+	VotePollSuccess = "Vote recorded successfully"
+
+	// Pin
+	PinSuccess   = "Post pinned successfully"
+	UnpinSuccess = "Post unpinned successfully"
+
+	// Bookmark
+	BookmarkSuccess           = "Post bookmarked successfully"
+	RemoveBookmarkSuccess     = "Bookmark removed successfully"
+	GetBookmarksSuccess       = "Bookmarks retrieved successfully"
+	GetBookmarkFoldersSuccess = "Bookmark folders retrieved successfully"
+
+	// Mention
+	GetMentionsSuccess = "Mentions retrieved successfully"
+
+	// Hashtag
+	GetTrendingSuccess = "Trending hashtags retrieved successfully"
+
 	// Like
-	LikeSuccess   = "Post liked successfully"
-	UnlikeSuccess = "Post unliked successfully"
+	LikeSuccess      = "Post liked successfully"
+	UnlikeSuccess    = "Post unliked successfully"
+	GetLikersSuccess = "Likers retrieved successfully"
+
+	// Reaction
+	ReactSuccess          = "Reaction saved successfully"
+	RemoveReactionSuccess = "Reaction removed successfully"
+	GetReactionsSuccess   = "Reactions retrieved successfully"
+
+	// Repost
+	RepostSuccess     = "Post reposted successfully"
+	UndoRepostSuccess = "Repost removed successfully"
 
 	// Comment
 	CommentSuccess       = "Comment created successfully"
@@ -9,12 +9,21 @@ type RegisterRequest struct {
 	Username string `json:"username" validate:"required,min=3,max=30,alphanum"`
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=6"`
+	// only needed while registration is invite-only
+	InviteCode string `json:"invite_code" validate:"omitempty,alphanum,max=32"`
 }
 
 // used in middleware
 type AuthUser struct {
-	ID       string
-	Username string
+	ID        string
+	Username  string
+	SessionID string
+}
+
+// forwarded to the user service so sessions know where they come from
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
 }
 
 // auth service generic response
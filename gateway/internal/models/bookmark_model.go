@@ -0,0 +1,11 @@
+package models
+
+// AddBookmarkRequest files the bookmark under Folder, nil leaves it unfiled.
+type AddBookmarkRequest struct {
+	Folder *string `json:"folder" validate:"omitempty,maxgraphemes=32"`
+}
+
+type BookmarkFolder struct {
+	Name           string `json:"name"`
+	BookmarksCount int    `json:"bookmarks_count"`
+}
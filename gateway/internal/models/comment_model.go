@@ -12,5 +12,6 @@ type Comment struct {
 	PostID    int       `json:"post_id"`
 	Content   string    `json:"content"`
 	Author    *User     `json:"author"`
+	Mentions  []Mention `json:"mentions"`
 	CreatedAt time.Time `json:"created_at"`
 }
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type SaveDraftRequest struct {
+	Content    string      `json:"content" validate:"maxgraphemes=240"`
+	PostImages []PostImage `json:"post_images" validate:"omitempty,max=5,dive"`
+	ReplyTo    *int        `json:"reply_to" validate:"omitempty,gt=0"`
+	Visibility *string     `json:"visibility" validate:"omitempty,oneof=public followers mentioned unlisted"`
+	// ContentWarning is applied to the post when the draft is published
+	ContentWarning *string `json:"content_warning" validate:"omitempty,maxgraphemes=100"`
+}
+
+type Draft struct {
+	ID             int         `json:"id"`
+	Content        string      `json:"content"`
+	PostImages     []PostImage `json:"post_images"`
+	ReplyToPostID  *int        `json:"reply_to_post_id,omitempty"`
+	Visibility     string      `json:"visibility"`
+	ContentWarning *string     `json:"content_warning,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
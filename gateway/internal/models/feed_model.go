@@ -12,7 +12,35 @@ type GetGlobalFeedRequest struct {
 	Cursor   time.Time `json:"cursor"`
 }
 
+// MentionItem is a post or a comment that mentions the viewer, Type is
+// "post" or "comment".
+type MentionItem struct {
+	Type    string   `json:"type"`
+	Post    *Post    `json:"post,omitempty"`
+	Comment *Comment `json:"comment,omitempty"`
+}
+
+type GetMentionsResponse struct {
+	Items          []MentionItem `json:"items"`
+	HasMore        bool          `json:"has_more"`
+	NextCursor     *time.Time    `json:"next_cursor,omitempty"`
+	NextCursorID   *int          `json:"next_cursor_id,omitempty"`
+	NextCursorType string        `json:"next_cursor_type,omitempty"`
+}
+
+// ForYouFeedResponse is a page of the ranked feed, the next page is read by
+// passing SnapshotID and NextOffset back. A snapshot expires after a while
+// and the feed has to be reloaded from the first page.
+type ForYouFeedResponse struct {
+	Posts      []Post `json:"posts"`
+	HasMore    bool   `json:"has_more"`
+	SnapshotID *int   `json:"snapshot_id,omitempty"`
+	NextOffset *int   `json:"next_offset,omitempty"`
+}
+
 type GetFeedResponse struct {
-	Posts   []Post `json:"posts"`
-	HasMore bool   `json:"has_more"`
+	Posts        []Post     `json:"posts"`
+	HasMore      bool       `json:"has_more"`
+	NextCursor   *time.Time `json:"next_cursor,omitempty"`
+	NextCursorID *int       `json:"next_cursor_id,omitempty"`
 }
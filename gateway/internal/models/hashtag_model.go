@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type TrendingHashtag struct {
+	Tag   string  `json:"tag"`
+	Uses  int     `json:"uses"`
+	Score float64 `json:"score"`
+}
+
+type TrendingHashtagsResponse struct {
+	Hashtags   []TrendingHashtag `json:"hashtags"`
+	ComputedAt *time.Time        `json:"computed_at,omitempty"`
+}
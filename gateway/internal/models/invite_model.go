@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+type JoinWaitlistRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type CreateInviteRequest struct {
+	MaxUses   int        `json:"max_uses" validate:"omitempty,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type RegistrationMode struct {
+	Mode string `json:"mode"`
+}
+
+type InviteRedemption struct {
+	UserID     int       `json:"user_id"`
+	Username   string    `json:"username"`
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
+
+type Invite struct {
+	ID          int                `json:"id"`
+	Code        string             `json:"code"`
+	IssuerID    *int               `json:"issuer_id,omitempty"`
+	MaxUses     int                `json:"max_uses"`
+	Uses        int                `json:"uses"`
+	ExpiresAt   *time.Time         `json:"expires_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Redemptions []InviteRedemption `json:"redemptions"`
+}
+
+type InviteListResponse struct {
+	Invites []*Invite `json:"invites"`
+	HasMore bool      `json:"has_more"`
+}
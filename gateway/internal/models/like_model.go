@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type LikeRequest struct {
 	PostID   int    `json:"post_id" validate:"required"`
 	UserID   string `json:"user_id" validate:"required"`
@@ -9,3 +11,35 @@ type LikeRequest struct {
 type LikeResponse struct {
 	NewLikesCount int `json:"new_likes_count"`
 }
+
+// ReactRequest sets the viewer's reaction to a post, GET /reactions lists the
+// allowed ones.
+type ReactRequest struct {
+	Reaction string `json:"reaction" validate:"required,max=32"`
+}
+
+type ReactionCount struct {
+	Reaction string `json:"reaction"`
+	Count    int    `json:"count"`
+}
+
+type ListReactionsResponse struct {
+	Reactions       []string `json:"reactions"`
+	DefaultReaction string   `json:"default_reaction"`
+}
+
+type PostLiker struct {
+	User       UserBanner `json:"user"`
+	LikedAt    time.Time  `json:"liked_at"`
+	IsFollowed bool       `json:"is_followed"`
+}
+
+// ListPostLikersResponse pages likers the viewer follows first, pass
+// next_cursor_followed back as cursorfollowed with the cursor.
+type ListPostLikersResponse struct {
+	Likers             []PostLiker `json:"likers"`
+	HasMore            bool        `json:"has_more"`
+	NextCursor         *time.Time  `json:"next_cursor,omitempty"`
+	NextCursorID       *int        `json:"next_cursor_id,omitempty"`
+	NextCursorFollowed *bool       `json:"next_cursor_followed,omitempty"`
+}
@@ -3,15 +3,105 @@ package models
 import "time"
 
 type PostImage struct {
-	ImageURL string `json:"image_url" validate:"required,url"`
-	Order    int    `json:"order" validate:"required,gt=0"`
-	Width    int    `json:"width" validate:"required,gt=0"`
-	Height   int    `json:"height" validate:"required,gt=0"`
+	ImageURL  string `json:"image_url" validate:"required,url"`
+	Order     int    `json:"order" validate:"required,gt=0"`
+	Width     int    `json:"width" validate:"required,gt=0"`
+	Height    int    `json:"height" validate:"required,gt=0"`
+	Sensitive bool   `json:"sensitive"`
+	// Blurred images are placeholders, their image_url is left out until the
+	// viewer opts in
+	Blurred bool `json:"blurred,omitempty"`
 }
 
 type CreatePostRequest struct {
-	Content    string      `json:"content" validate:"max=240"`
+	Content     string      `json:"content" validate:"maxgraphemes=240"`
+	PostImages  []PostImage `json:"post_images" validate:"omitempty,max=5,dive"`
+	ReplyTo     *int        `json:"reply_to" validate:"omitempty,gt=0"`
+	QuotePostID *int        `json:"quote_post_id" validate:"omitempty,gt=0"`
+	// PublishAt schedules the post instead of publishing it now
+	PublishAt *time.Time `json:"publish_at"`
+	Poll      *PollInput `json:"poll"`
+	// Visibility falls back to the author's default_post_visibility setting
+	Visibility *string `json:"visibility" validate:"omitempty,oneof=public followers mentioned unlisted"`
+	// ContentWarning is shown in place of the content until the viewer
+	// expands the post
+	ContentWarning *string `json:"content_warning" validate:"omitempty,maxgraphemes=100"`
+}
+
+// PollInput is a poll attached to a new post, it can't be combined with
+// PublishAt.
+type PollInput struct {
+	Options        []string  `json:"options" validate:"min=2,max=4,dive,required,maxgraphemes=25"`
+	EndsAt         time.Time `json:"ends_at" validate:"required"`
+	MultipleChoice bool      `json:"multiple_choice"`
+}
+
+// VotePollRequest takes one option id unless the poll is multiple choice.
+type VotePollRequest struct {
+	OptionIDs []int `json:"option_ids" validate:"required,min=1,max=4,dive,gt=0"`
+}
+
+// Poll tallies are null until TalliesVisible, which is once the viewer has
+// voted or the poll has closed.
+type Poll struct {
+	Options        []PollOption `json:"options"`
+	MultipleChoice bool         `json:"multiple_choice"`
+	EndsAt         time.Time    `json:"ends_at"`
+	Closed         bool         `json:"closed"`
+	Voted          bool         `json:"voted"`
+	TalliesVisible bool         `json:"tallies_visible"`
+	VotersCount    int          `json:"voters_count"`
+}
+
+type PollOption struct {
+	ID         int    `json:"id"`
+	Label      string `json:"label"`
+	VotesCount *int   `json:"votes_count"`
+	IsVoted    bool   `json:"is_voted"`
+}
+
+// UpdateScheduledPostRequest replaces the content of a scheduled post, a nil
+// PublishAt keeps the current publish time.
+type UpdateScheduledPostRequest struct {
+	Content    string      `json:"content" validate:"maxgraphemes=240"`
 	PostImages []PostImage `json:"post_images" validate:"omitempty,max=5,dive"`
+	PublishAt  *time.Time  `json:"publish_at"`
+}
+
+// Mention links content[Start:End], byte offsets of "@username", to a user.
+// Username is the user's current name, it can differ from the text after a
+// rename.
+type Mention struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+// Entity is a typed span of the content: url, mention, hashtag or cashtag.
+// Start and End are byte offsets, Utf16Start and Utf16End UTF-16 code unit
+// offsets.
+type Entity struct {
+	Type       string `json:"type"`
+	Text       string `json:"text"`
+	Start      int    `json:"start"`
+	End        int    `json:"end"`
+	Utf16Start int    `json:"utf16_start"`
+	Utf16End   int    `json:"utf16_end"`
+	UserID     *int   `json:"user_id,omitempty"`
+}
+
+// ContentFlagsRequest is a moderator flagging a post, a nil ContentWarning
+// keeps the current one and an empty one removes it. Images not listed keep
+// their flag.
+type ContentFlagsRequest struct {
+	ContentWarning  *string     `json:"content_warning" validate:"omitempty,maxgraphemes=100"`
+	SensitiveImages []ImageFlag `json:"sensitive_images" validate:"omitempty,max=5,dive"`
+}
+
+type ImageFlag struct {
+	Order     int  `json:"order" validate:"required,gt=0"`
+	Sensitive bool `json:"sensitive"`
 }
 
 type GetPostRequest struct {
@@ -24,8 +114,67 @@ type Post struct {
 	PostImages    []PostImage `json:"post_images"`
 	LikesCount    int         `json:"likes_count"`
 	CommentsCount int         `json:"comments_count"`
+	RepliesCount  int         `json:"replies_count"`
+	ReplyToPostID *int        `json:"reply_to_post_id,omitempty"`
+	RootPostID    *int        `json:"root_post_id,omitempty"`
+	RepostsCount  int         `json:"reposts_count"`
+	QuotesCount   int         `json:"quotes_count"`
+	QuotePostID   *int        `json:"quote_post_id,omitempty"`
+	QuotedPost    *Post       `json:"quoted_post,omitempty"`
+	RepostedBy    *User       `json:"reposted_by,omitempty"`
+	RepostedAt    *time.Time  `json:"reposted_at,omitempty"`
+	Mentions      []Mention   `json:"mentions"`
+	Entities      []Entity    `json:"entities"`
+	Edited        bool        `json:"edited"`
+	EditCount     int         `json:"edit_count"`
+	Visibility    string      `json:"visibility"`
+	PublishAt     *time.Time  `json:"publish_at,omitempty"`
+	Poll          *Poll       `json:"poll,omitempty"`
 	CreatedAt     time.Time   `json:"created_at"`
 	UpdatedAt     time.Time   `json:"updated_at"`
 	Author        *User       `json:"author"`
 	IsLiked       bool        `json:"is_liked"`
+	IsReposted    bool        `json:"is_reposted"`
+	IsBookmarked  bool        `json:"is_bookmarked"`
+	IsPinned      bool        `json:"is_pinned"`
+	// Reactions are most used first, LikesCount is their total
+	Reactions      []ReactionCount `json:"reactions"`
+	ViewerReaction string          `json:"viewer_reaction,omitempty"`
+	// LinkPreview is set once the first link has been unfurled
+	LinkPreview *LinkPreview `json:"link_preview,omitempty"`
+	// ContentWarning is shown in place of the content until the viewer
+	// expands the post
+	ContentWarning *string `json:"content_warning,omitempty"`
+	// BookmarkedAt and BookmarkFolder are only set when listing bookmarks
+	BookmarkedAt   *time.Time `json:"bookmarked_at,omitempty"`
+	BookmarkFolder *string    `json:"bookmark_folder,omitempty"`
+}
+
+// LinkPreview is the unfurled first link of a post.
+type LinkPreview struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+// PostRevision is a previous version of a post, version 1 is the original.
+type PostRevision struct {
+	Version    int         `json:"version"`
+	Content    string      `json:"content"`
+	PostImages []PostImage `json:"post_images"`
+	Entities   []Entity    `json:"entities"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type PostHistoryResponse struct {
+	Revisions []PostRevision `json:"revisions"`
+}
+
+type ThreadResponse struct {
+	Post      *Post  `json:"post"`
+	Ancestors []Post `json:"ancestors"`
+	Replies   []Post `json:"replies"`
+	HasMore   bool   `json:"has_more"`
 }
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type SecurityEvent struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id,omitempty"`
+	EventType string    `json:"event_type"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Detail    string    `json:"detail,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SecurityLogResponse struct {
+	Events  []*SecurityEvent `json:"events"`
+	HasMore bool             `json:"has_more"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type Session struct {
+	ID         int       `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	IsCurrent  bool      `json:"is_current"`
+}
+
+type RevokeOtherSessionsResponse struct {
+	RevokedCount int `json:"revoked_count"`
+}
@@ -0,0 +1,34 @@
+package models
+
+type UserSettings struct {
+	DefaultPostVisibility string               `json:"default_post_visibility"`
+	ReplyPolicy           string               `json:"reply_policy"`
+	MentionPolicy         string               `json:"mention_policy"`
+	Notifications         NotificationSettings `json:"notifications"`
+	Language              string               `json:"language"`
+	SensitiveMedia        string               `json:"sensitive_media"`
+}
+
+type NotificationSettings struct {
+	Likes    bool `json:"likes"`
+	Comments bool `json:"comments"`
+	Follows  bool `json:"follows"`
+	Mentions bool `json:"mentions"`
+}
+
+// nil fields are left untouched
+type UpdateSettingsRequest struct {
+	DefaultPostVisibility *string                            `json:"default_post_visibility" validate:"omitempty,oneof=public followers mentioned unlisted"`
+	ReplyPolicy           *string                            `json:"reply_policy" validate:"omitempty,oneof=everyone following mentioned nobody"`
+	MentionPolicy         *string                            `json:"mention_policy" validate:"omitempty,oneof=everyone following nobody"`
+	Notifications         *UpdateNotificationSettingsRequest `json:"notifications"`
+	Language              *string                            `json:"language" validate:"omitempty,min=2,max=16"`
+	SensitiveMedia        *string                            `json:"sensitive_media" validate:"omitempty,oneof=show blur hide"`
+}
+
+type UpdateNotificationSettingsRequest struct {
+	Likes    *bool `json:"likes"`
+	Comments *bool `json:"comments"`
+	Follows  *bool `json:"follows"`
+	Mentions *bool `json:"mentions"`
+}
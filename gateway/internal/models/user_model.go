@@ -21,6 +21,22 @@ type UpdateProfileRequest struct {
 	AvatarURL   string `json:"avatar_url" validate:"omitempty,url"`
 	BannerURL   string `json:"banner_url" validate:"omitempty,url"`
 	Location    string `json:"location" validate:"omitempty,max=100"`
+
+	Pronouns           *string               `json:"pronouns" validate:"omitempty,max=40"`
+	Birthday           *string               `json:"birthday" validate:"omitempty,max=10"`
+	BirthdayVisibility *string               `json:"birthday_visibility" validate:"omitempty,oneof=public followers private"`
+	Links              *[]ProfileLinkRequest `json:"links" validate:"omitempty,max=5,dive"`
+}
+
+// IsEmpty reports whether the request carries no field to update.
+func (r *UpdateProfileRequest) IsEmpty() bool {
+	return r.DisplayName == "" && r.Bio == "" && r.AvatarURL == "" && r.BannerURL == "" && r.Location == "" &&
+		r.Pronouns == nil && r.Birthday == nil && r.BirthdayVisibility == nil && r.Links == nil
+}
+
+type ProfileLinkRequest struct {
+	Label string `json:"label" validate:"required,max=30"`
+	URL   string `json:"url" validate:"required,url,max=255"`
 }
 
 type User struct {
@@ -39,6 +55,17 @@ type Profile struct {
 	Location    string `json:"location"`
 	Followers   int    `json:"followers"`
 	Following   int    `json:"following"`
+
+	Pronouns           string        `json:"pronouns"`
+	Birthday           string        `json:"birthday,omitempty"`
+	BirthdayVisibility string        `json:"birthday_visibility"`
+	Links              []ProfileLink `json:"links"`
+}
+
+type ProfileLink struct {
+	Label    string `json:"label"`
+	URL      string `json:"url"`
+	Verified bool   `json:"verified"`
 }
 
 type UserBanner struct {
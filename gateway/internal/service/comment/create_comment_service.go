@@ -20,9 +20,15 @@ func (s *CommentService) Create(
 	md := utils.MetaDataHandler(userID, username)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	_, err := s.CommentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{
-		PostId:  int64(req.PostID),
-		Content: req.Content,
+	mentions, err := utils.ResolveMentions(ctx, req.Content, s.UserClient, s.Logger)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.CommentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{
+		PostId:   int64(req.PostID),
+		Content:  req.Content,
+		Mentions: utils.CommentMentionsMapper(mentions),
 	})
 	if err != nil {
 		s.Logger.Error("failed to call CommentService.CreteComment", zap.Error(err))
@@ -39,13 +39,7 @@ func (s *CommentService) GetAllByUser(
 		return []models.Comment{}, nil
 	}
 
-	user := utils.UserMapper(userClientRes.GetUser())
-
-	comments := make([]models.Comment, 0, len(commentsClient))
-	for _, comment := range commentsClient {
-		comments = append(comments, *utils.CommentMapper(comment, user))
-	}
-
-	return comments, nil
+	// mentioned users need a lookup, the author comes from the same batch
+	return utils.EnrichComments(ctx, commentsClient, s.UserClient, s.Logger)
 
 }
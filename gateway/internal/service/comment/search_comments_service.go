@@ -30,6 +30,9 @@ func (s *CommentService) SearchComments(ctx context.Context, query string) ([]*m
 	userIDsMap := make(map[int64]bool)
 	for _, c := range comments {
 		userIDsMap[c.GetUserId()] = true
+		for _, m := range c.GetMentions() {
+			userIDsMap[m.GetUserId()] = true
+		}
 	}
 
 	userIDs := make([]int64, 0, len(userIDsMap))
@@ -45,17 +48,18 @@ func (s *CommentService) SearchComments(ctx context.Context, query string) ([]*m
 		// Continue anyway, mapper will handle nil user
 	}
 
-	userMap := make(map[int64]*userpb.UserProfile)
+	userMap := make(map[int64]*models.User)
 	if usersRes != nil {
 		for _, u := range usersRes.GetUsers() {
-			userMap[u.GetId()] = u
+			userMap[u.GetId()] = utils.UserMapper(u)
 		}
 	}
 
 	hydratedComments := make([]*models.Comment, 0, len(comments))
 	for _, c := range comments {
-		author := utils.UserMapper(userMap[c.GetUserId()])
-		hydratedComments = append(hydratedComments, utils.CommentMapper(c, author))
+		comment := utils.CommentMapper(c, userMap[c.GetUserId()])
+		comment.Mentions = utils.MentionsMapper(c.GetMentions(), userMap)
+		hydratedComments = append(hydratedComments, comment)
 	}
 
 	return hydratedComments, nil
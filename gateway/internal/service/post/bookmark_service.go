@@ -0,0 +1,101 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (ps *PostService) AddBookmark(ctx context.Context, postID int, req *models.AddBookmarkRequest, username, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := ps.PostClient.AddBookmark(ctx, &postpb.AddBookmarkRequest{
+		PostId: int64(postID),
+		Folder: req.Folder,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.AddBookmark", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostService) RemoveBookmark(ctx context.Context, postID int, username, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := ps.PostClient.RemoveBookmark(ctx, &postpb.BookmarkRequest{
+		PostId: int64(postID),
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.RemoveBookmark", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostService) ListBookmarks(ctx context.Context, req *postpb.ListBookmarksRequest, userID, username string) (*models.GetFeedResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.ListBookmarks(ctx, req)
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ListBookmarks", zap.Error(err))
+		return nil, err
+	}
+
+	posts, err := utils.EnrichPosts(ctx, res.GetPosts(), ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &models.GetFeedResponse{
+		Posts:   posts,
+		HasMore: res.GetHasMore(),
+	}
+	utils.FeedCursorMapper(res, feed)
+
+	return feed, nil
+}
+
+func (ps *PostService) ListBookmarkFolders(ctx context.Context, userID, username string) ([]models.BookmarkFolder, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.ListBookmarkFolders(ctx, &emptypb.Empty{})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ListBookmarkFolders", zap.Error(err))
+		return nil, err
+	}
+
+	folders := make([]models.BookmarkFolder, 0, len(res.GetFolders()))
+	for _, folder := range res.GetFolders() {
+		folders = append(folders, models.BookmarkFolder{
+			Name:           folder.GetName(),
+			BookmarksCount: int(folder.GetBookmarksCount()),
+		})
+	}
+
+	return folders, nil
+}
@@ -0,0 +1,47 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+)
+
+// SetContentFlags applies a moderator's flags to postID. The post comes back
+// with its sensitive media revealed so the moderator can check the result.
+func (ps *PostService) SetContentFlags(
+	ctx context.Context,
+	postID int64,
+	req *models.ContentFlagsRequest,
+) (*models.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	images := make([]*postpb.ImageFlag, 0, len(req.SensitiveImages))
+	for _, image := range req.SensitiveImages {
+		images = append(images, &postpb.ImageFlag{
+			Order:     int64(image.Order),
+			Sensitive: image.Sensitive,
+		})
+	}
+
+	res, err := ps.PostClient.SetContentFlags(ctx, &postpb.SetContentFlagsRequest{
+		PostId:         postID,
+		ContentWarning: req.ContentWarning,
+		Images:         images,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.SetContentFlags", zap.Error(err))
+		return nil, err
+	}
+
+	posts, err := utils.EnrichPosts(utils.WithSensitiveMediaRevealed(ctx), []*postpb.Post{res}, ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &posts[0], nil
+}
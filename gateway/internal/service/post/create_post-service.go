@@ -5,10 +5,12 @@ import (
 
 	"voidspaceGateway/internal/models"
 	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
 	"voidspaceGateway/utils"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *PostService) Create(
@@ -26,16 +28,52 @@ func (s *PostService) Create(
 
 	for _, image := range req.PostImages {
 		postImages = append(postImages, &postpb.PostImage{
-			Url:    image.ImageURL,
-			Order:  int64(image.Order),
-			Width:  int64(image.Width),
-			Height: int64(image.Height),
+			Url:       image.ImageURL,
+			Order:     int64(image.Order),
+			Width:     int64(image.Width),
+			Height:    int64(image.Height),
+			Sensitive: image.Sensitive,
 		})
 	}
 
+	mentions, err := utils.ResolveMentions(ctx, req.Content, s.UserClient, s.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := s.resolveVisibility(ctx, req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
 	data := &postpb.CreatePostRequest{
-		Content: req.Content,
-		Images:  postImages,
+		Content:        req.Content,
+		Images:         postImages,
+		Mentions:       utils.PostMentionsMapper(mentions),
+		Visibility:     visibility,
+		ContentWarning: req.ContentWarning,
+	}
+
+	if req.ReplyTo != nil {
+		replyTo := int64(*req.ReplyTo)
+		data.ReplyTo = &replyTo
+	}
+
+	if req.QuotePostID != nil {
+		quotePostID := int64(*req.QuotePostID)
+		data.QuotePostId = &quotePostID
+	}
+
+	if req.PublishAt != nil {
+		data.PublishAt = timestamppb.New(*req.PublishAt)
+	}
+
+	if req.Poll != nil {
+		data.Poll = &postpb.PollInput{
+			Options:        req.Poll.Options,
+			EndsAt:         timestamppb.New(req.Poll.EndsAt),
+			MultipleChoice: req.Poll.MultipleChoice,
+		}
 	}
 
 	res, err := s.PostClient.CreatePost(ctx, data)
@@ -44,5 +82,25 @@ func (s *PostService) Create(
 		return nil, err
 	}
 
+	if res.PublishAt != nil {
+		err = s.startPublishWorkflow(ctx, res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if res.Poll != nil {
+		s.startClosePollWorkflow(ctx, res)
+	}
+
+	// scheduled posts get their preview and fan-out from the publish workflow
+	if res.PublishAt == nil {
+		s.startLinkPreviewWorkflow(ctx, res)
+		s.startFanOutWorkflow(ctx, temporal_dto.FanOutPostWorkflowParam{
+			PostID:   res.GetId(),
+			SourceID: res.GetUserId(),
+		})
+	}
+
 	return res, nil
 }
@@ -0,0 +1,27 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ps *PostService) DeleteDraft(ctx context.Context, draftID int64, userID, username string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := ps.PostClient.DeleteDraft(ctx, &postpb.DraftRequest{DraftId: draftID})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.DeleteDraft", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 	"voidspaceGateway/utils"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func (ps *PostService) GetFollowingFeed(ctx context.Context, req *postpb.GetFollowingFeedRequest, reqUserID string, reqUsername string) (*models.GetFeedResponse, error) {
@@ -25,7 +27,42 @@ func (ps *PostService) GetFollowingFeed(ctx context.Context, req *postpb.GetFoll
 		return nil, err
 	}
 
-	// 1. Get the list of users following by the current user
+	res, err := ps.PostClient.GetHomeTimeline(ctx, &postpb.GetHomeTimelineRequest{
+		CursorTime: req.CursorTime,
+		CursorId:   req.CursorId,
+	})
+	switch {
+	case status.Code(err) == codes.FailedPrecondition:
+		// the timeline is built in the background, until then the feed is
+		// pulled from every follow
+		ps.startRebuildTimelineWorkflow(ctx, userID)
+
+		res, err = ps.pullFollowingFeed(ctx, req, userID)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		ps.Logger.Error("failed to call PostService.GetHomeTimeline", zap.Error(err))
+		return nil, err
+	}
+
+	posts, err := utils.EnrichPosts(ctx, res.GetPosts(), ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &models.GetFeedResponse{
+		Posts:   posts,
+		HasMore: res.GetHasMore(),
+	}
+	utils.FeedCursorMapper(res, feed)
+
+	return feed, nil
+}
+
+// pullFollowingFeed reads the feed from the posts of every user userID
+// follows, for timelines that are not built yet.
+func (ps *PostService) pullFollowingFeed(ctx context.Context, req *postpb.GetFollowingFeedRequest, userID int64) (*postpb.GetFeedResponse, error) {
 	followingRes, err := ps.UserClient.ListFollowing(ctx, &userpb.GetUserByIdRequest{
 		UserId: userID,
 	})
@@ -34,35 +71,21 @@ func (ps *PostService) GetFollowingFeed(ctx context.Context, req *postpb.GetFoll
 		return nil, err
 	}
 
-	// 2. If the user follows no one, return an empty feed
 	if len(followingRes.GetUsers()) == 0 {
-		return &models.GetFeedResponse{
-			Posts:   []models.Post{},
-			HasMore: false,
-		}, nil
+		return &postpb.GetFeedResponse{}, nil
 	}
 
-	// 3. Extract IDs and update the request
 	followedIDs := make([]int64, 0, len(followingRes.GetUsers()))
 	for _, user := range followingRes.GetUsers() {
 		followedIDs = append(followedIDs, user.GetId())
 	}
 	req.UserIds = followedIDs
 
-	// 4. Call PostService with the list of followed IDs
 	res, err := ps.PostClient.GetFollowingFeed(ctx, req)
 	if err != nil {
 		ps.Logger.Error("failed to call PostService.GetFollowingFeed", zap.Error(err))
 		return nil, err
 	}
 
-	posts, err := utils.EnrichPosts(ctx, res.GetPosts(), ps.UserClient, ps.CommentClient, ps.Logger)
-	if err != nil {
-		return nil, err
-	}
-
-	return &models.GetFeedResponse{
-		Posts:   posts,
-		HasMore: res.GetHasMore(),
-	}, nil
+	return res, nil
 }
@@ -0,0 +1,57 @@
+package post
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+// GetForYouFeed returns a page of the ranked feed, a nil snapshotID ranks a
+// new snapshot and returns its first page.
+func (ps *PostService) GetForYouFeed(
+	ctx context.Context,
+	snapshotID *int64,
+	offset int,
+	reqUserID string,
+	reqUsername string,
+) (*models.ForYouFeedResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(reqUserID, reqUsername)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	req := &postpb.GetForYouFeedRequest{
+		SnapshotId: snapshotID,
+		Offset:     int32(offset),
+	}
+
+	res, err := ps.PostClient.GetForYouFeed(ctx, req)
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.GetForYouFeed", zap.Error(err))
+		return nil, err
+	}
+
+	posts, err := utils.EnrichPosts(ctx, res.GetPosts(), ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &models.ForYouFeedResponse{
+		Posts:   posts,
+		HasMore: res.GetHasMore(),
+	}
+
+	if res.GetSnapshotId() != 0 {
+		snapshot := int(res.GetSnapshotId())
+		nextOffset := int(res.GetNextOffset())
+		feed.SnapshotID = &snapshot
+		feed.NextOffset = &nextOffset
+	}
+
+	return feed, nil
+}
@@ -29,8 +29,11 @@ func (ps *PostService) GetGlobalFeed(ctx context.Context, req *postpb.GetGlobalF
 		return nil, err
 	}
 
-	return &models.GetFeedResponse{
+	feed := &models.GetFeedResponse{
 		Posts:   posts,
 		HasMore: res.GetHasMore(),
-	}, nil
+	}
+	utils.FeedCursorMapper(res, feed)
+
+	return feed, nil
 }
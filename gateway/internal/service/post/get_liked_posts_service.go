@@ -12,7 +12,7 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-func (ps *PostService) GetLikedPosts(ctx context.Context, targetUsername string, reqUserID string, reqUsername string) ([]models.Post, error) {
+func (ps *PostService) GetLikedPosts(ctx context.Context, targetUsername string, req *postpb.GetUserPostsRequest, reqUserID string, reqUsername string) (*models.GetFeedResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
 	defer cancel()
 
@@ -27,9 +27,9 @@ func (ps *PostService) GetLikedPosts(ctx context.Context, targetUsername string,
 		return nil, err
 	}
 
-	res, err := ps.PostClient.GetLikedPosts(ctx, &postpb.GetUserPostsRequest{
-		UserId: user.GetUser().GetId(),
-	})
+	req.UserId = user.GetUser().GetId()
+
+	res, err := ps.PostClient.GetLikedPosts(ctx, req)
 	if err != nil {
 		ps.Logger.Error("failed to call PostService.GetLikedPosts", zap.Error(err))
 		return nil, err
@@ -40,5 +40,11 @@ func (ps *PostService) GetLikedPosts(ctx context.Context, targetUsername string,
 		return nil, err
 	}
 
-	return posts, nil
+	feed := &models.GetFeedResponse{
+		Posts:   posts,
+		HasMore: res.GetHasMore(),
+	}
+	utils.FeedCursorMapper(res, feed)
+
+	return feed, nil
 }
@@ -0,0 +1,135 @@
+package post
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"voidspaceGateway/internal/models"
+	commentpb "voidspaceGateway/proto/generated/comments/v1"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	MentionTypePost    = "post"
+	MentionTypeComment = "comment"
+
+	mentionsPageSize = 10
+)
+
+// GetMentions merges the posts and comments that mention the viewer into one
+// page ordered by created_at, then type (posts first), then id. The cursor is
+// the last item's (time, type, id), each service gets a cursor that skips the
+// items already shown at the same timestamp.
+func (ps *PostService) GetMentions(
+	ctx context.Context,
+	cursorTime time.Time,
+	cursorID int64,
+	cursorType string,
+	reqUserID string,
+	reqUsername string,
+) (*models.GetMentionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(reqUserID, reqUsername)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	postReq := &postpb.GetMentionsRequest{}
+	commentReq := &commentpb.GetMentionedCommentsRequest{}
+	if !cursorTime.IsZero() {
+		ts := timestamppb.New(cursorTime)
+		postCursorID, commentCursorID := cursorID, cursorID
+		switch cursorType {
+		case MentionTypeComment:
+			// every post at cursorTime sorts before the cursor comment
+			postCursorID = 0
+		default:
+			// every comment at cursorTime sorts after the cursor post
+			commentCursorID = math.MaxInt64
+		}
+		postReq.CursorTime, postReq.CursorId = ts, &postCursorID
+		commentReq.CursorTime, commentReq.CursorId = ts, &commentCursorID
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+
+	var postRes *postpb.GetFeedResponse
+	g.Go(func() error {
+		var err error
+		postRes, err = ps.PostClient.GetMentions(gCtx, postReq)
+		if err != nil {
+			ps.Logger.Error("failed to call PostService.GetMentions", zap.Error(err))
+		}
+		return err
+	})
+
+	var commentRes *commentpb.GetMentionedCommentsResponse
+	g.Go(func() error {
+		var err error
+		commentRes, err = ps.CommentClient.GetMentionedComments(gCtx, commentReq)
+		if err != nil {
+			ps.Logger.Error("failed to call CommentService.GetMentionedComments", zap.Error(err))
+		}
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	posts, err := utils.EnrichPosts(ctx, postRes.GetPosts(), ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := utils.EnrichComments(ctx, commentRes.GetComments(), ps.UserClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// both lists are already sorted, merge them
+	items := make([]models.MentionItem, 0, len(posts)+len(comments))
+	i, j := 0, 0
+	for i < len(posts) || j < len(comments) {
+		takePost := j >= len(comments) ||
+			(i < len(posts) && !posts[i].CreatedAt.Before(comments[j].CreatedAt))
+		if takePost {
+			items = append(items, models.MentionItem{Type: MentionTypePost, Post: &posts[i]})
+			i++
+		} else {
+			items = append(items, models.MentionItem{Type: MentionTypeComment, Comment: &comments[j]})
+			j++
+		}
+	}
+
+	res := &models.GetMentionsResponse{
+		Items:   items,
+		HasMore: len(items) > mentionsPageSize || postRes.GetHasMore() || commentRes.GetHasMore(),
+	}
+	if len(items) > mentionsPageSize {
+		res.Items = items[:mentionsPageSize]
+	}
+
+	if len(res.Items) > 0 {
+		last := res.Items[len(res.Items)-1]
+		var nextCursor time.Time
+		var nextCursorID int
+		if last.Post != nil {
+			nextCursor, nextCursorID = last.Post.CreatedAt, last.Post.ID
+		} else {
+			nextCursor, nextCursorID = last.Comment.CreatedAt, last.Comment.CommentID
+		}
+		res.NextCursor = &nextCursor
+		res.NextCursorID = &nextCursorID
+		res.NextCursorType = last.Type
+	}
+
+	return res, nil
+}
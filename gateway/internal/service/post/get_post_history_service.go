@@ -0,0 +1,30 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ps *PostService) GetPostHistory(ctx context.Context, postID int64, username string, userID string) (*models.PostHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.GetPostRevisions(ctx, &postpb.GetPostRevisionsRequest{
+		PostId: postID,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.GetPostRevisions", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.PostRevisionsMapper(res, utils.ViewerSensitiveMedia(ctx, ps.UserClient, ps.Logger)), nil
+}
@@ -4,13 +4,10 @@ import (
 	"context"
 
 	"voidspaceGateway/internal/models"
-	commentpb "voidspaceGateway/proto/generated/comments/v1"
 	postpb "voidspaceGateway/proto/generated/posts/v1"
-	userpb "voidspaceGateway/proto/generated/users/v1"
 	"voidspaceGateway/utils"
 
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -19,6 +16,7 @@ func (s *PostService) GetPost(
 	postID int64,
 	username string,
 	userID string,
+	reveal bool,
 ) (*models.Post, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
 	defer cancel()
@@ -26,6 +24,10 @@ func (s *PostService) GetPost(
 	md := utils.MetaDataHandler(userID, username)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
+	if reveal {
+		ctx = utils.WithSensitiveMediaRevealed(ctx)
+	}
+
 	postRes, err := s.PostClient.GetPost(ctx, &postpb.GetPostRequest{
 		PostId: postID,
 	})
@@ -34,39 +36,11 @@ func (s *PostService) GetPost(
 		return nil, err
 	}
 
-	// Fetch user and comment count in parallel
-	g, gCtx := errgroup.WithContext(ctx)
-
-	var userRes *userpb.GetUserResponse
-	g.Go(func() error {
-		var err error
-		userRes, err = s.UserClient.GetUserById(gCtx, &userpb.GetUserByIdRequest{
-			UserId: postRes.GetUserId(),
-		})
-		if err != nil {
-			s.Logger.Error("Failed to call UserService.GetUserById", zap.Error(err))
-		}
-		return err
-	})
-
-	var commentCount int64
-	g.Go(func() error {
-		commentRes, err := s.CommentClient.GetAllCommentsByPostId(gCtx, &commentpb.GetAllCommentsByPostIdRequest{
-			PostId: postRes.GetId(),
-		})
-		if err != nil {
-			s.Logger.Error("Failed to call CommentService.GetAllCommentsByPostId", zap.Error(err))
-			return err
-		}
-		commentCount = commentRes.GetCommentsCount()
-		return nil
-	})
-
-	if err := g.Wait(); err != nil {
+	// author, mentioned users, the quoted post and comment counts in one batch
+	posts, err := utils.EnrichPosts(ctx, []*postpb.Post{postRes}, s.UserClient, s.CommentClient, s.Logger)
+	if err != nil {
 		return nil, err
 	}
 
-	author := utils.UserMapper(userRes.GetUser())
-
-	return utils.PostMapper(postRes, author, int(commentCount)), nil
+	return &posts[0], nil
 }
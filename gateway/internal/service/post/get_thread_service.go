@@ -0,0 +1,51 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ps *PostService) GetThread(
+	ctx context.Context,
+	req *postpb.GetThreadRequest,
+	reqUserID string,
+	reqUsername string,
+) (*models.ThreadResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(reqUserID, reqUsername)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.GetThread(ctx, req)
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.GetThread", zap.Error(err))
+		return nil, err
+	}
+
+	// enrich the whole thread in one batch, then split it back up
+	all := make([]*postpb.Post, 0, 1+len(res.GetAncestors())+len(res.GetReplies()))
+	all = append(all, res.GetPost())
+	all = append(all, res.GetAncestors()...)
+	all = append(all, res.GetReplies()...)
+
+	posts, err := utils.EnrichPosts(ctx, all, ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestorsEnd := 1 + len(res.GetAncestors())
+
+	return &models.ThreadResponse{
+		Post:      &posts[0],
+		Ancestors: posts[1:ancestorsEnd],
+		Replies:   posts[ancestorsEnd:],
+		HasMore:   res.GetHasMore(),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+)
+
+func (ps *PostService) GetTrendingHashtags(ctx context.Context, limit int) (*models.TrendingHashtagsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	res, err := ps.PostClient.GetTrendingHashtags(ctx, &postpb.GetTrendingHashtagsRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.GetTrendingHashtags", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.TrendingHashtagsMapper(res), nil
+}
@@ -15,9 +15,10 @@ import (
 func (ps *PostService) GetUserPosts(
 	ctx context.Context,
 	targetUsername string,
+	req *postpb.GetUserPostsRequest,
 	reqUserID string,
 	reqUsername string,
-) ([]models.Post, error) {
+) (*models.GetFeedResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
 	defer cancel()
 
@@ -32,9 +33,9 @@ func (ps *PostService) GetUserPosts(
 		return nil, err
 	}
 
-	res, err := ps.PostClient.GetUserPosts(ctx, &postpb.GetUserPostsRequest{
-		UserId: user.GetUser().GetId(),
-	})
+	req.UserId = user.GetUser().GetId()
+
+	res, err := ps.PostClient.GetUserPosts(ctx, req)
 	if err != nil {
 		ps.Logger.Error("failed to call PostService.GetUserPosts", zap.Error(err))
 		return nil, err
@@ -45,5 +46,11 @@ func (ps *PostService) GetUserPosts(
 		return nil, err
 	}
 
-	return posts, nil
+	feed := &models.GetFeedResponse{
+		Posts:   posts,
+		HasMore: res.GetHasMore(),
+	}
+	utils.FeedCursorMapper(res, feed)
+
+	return feed, nil
 }
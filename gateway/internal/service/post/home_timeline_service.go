@@ -0,0 +1,58 @@
+package post
+
+import (
+	"context"
+	"fmt"
+
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+func fanOutWorkflowID(param temporal_dto.FanOutPostWorkflowParam) string {
+	if param.Repost {
+		return fmt.Sprintf("fan-out-repost-%d-%d", param.PostID, param.SourceID)
+	}
+	return fmt.Sprintf("fan-out-post-%d", param.PostID)
+}
+
+func rebuildTimelineWorkflowID(userID int64) string {
+	return fmt.Sprintf("rebuild-timeline-%d", userID)
+}
+
+// startFanOutWorkflow writes a new post or repost into the home timelines of
+// its source's followers in the background. Followers who miss it still see
+// it in the rest of the app, so a failure is only logged.
+func (ps *PostService) startFanOutWorkflow(ctx context.Context, param temporal_dto.FanOutPostWorkflowParam) {
+	options := client.StartWorkflowOptions{
+		ID:        fanOutWorkflowID(param),
+		TaskQueue: ps.TemporalService,
+	}
+
+	_, err := ps.TemporalClient.ExecuteWorkflow(ctx, options, temporal_constants.FanOutPostWorkflowName, param)
+	if err != nil {
+		ps.Logger.Error("failed to execute workflow", zap.Int64("postID", param.PostID), zap.Error(err))
+	}
+}
+
+// startRebuildTimelineWorkflow builds the home timeline of userID, reads
+// while it runs join the run already started.
+func (ps *PostService) startRebuildTimelineWorkflow(ctx context.Context, userID int64) {
+	param := temporal_dto.RebuildTimelineWorkflowParam{
+		UserID: userID,
+	}
+
+	options := client.StartWorkflowOptions{
+		ID:                       rebuildTimelineWorkflowID(userID),
+		TaskQueue:                ps.TemporalService,
+		WorkflowIDConflictPolicy: enums.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+	}
+
+	_, err := ps.TemporalClient.ExecuteWorkflow(ctx, options, temporal_constants.RebuildTimelineWorkflowName, param)
+	if err != nil {
+		ps.Logger.Error("failed to execute workflow", zap.Int64("userID", userID), zap.Error(err))
+	}
+}
@@ -0,0 +1,43 @@
+package post
+
+import (
+	"context"
+	"fmt"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+func linkPreviewWorkflowID(postID int64) string {
+	return fmt.Sprintf("link-preview-%d", postID)
+}
+
+// startLinkPreviewWorkflow unfurls the first link of post in the background.
+// A post without a preview is still a valid post, so a failure is only
+// logged.
+func (ps *PostService) startLinkPreviewWorkflow(ctx context.Context, post *postpb.Post) {
+	link := utils.FirstLinkURL(post.GetEntities())
+	if link == "" {
+		return
+	}
+
+	param := temporal_dto.FetchLinkPreviewWorkflowParam{
+		PostID: post.GetId(),
+		URL:    link,
+	}
+
+	options := client.StartWorkflowOptions{
+		ID:        linkPreviewWorkflowID(post.GetId()),
+		TaskQueue: ps.TemporalService,
+	}
+
+	_, err := ps.TemporalClient.ExecuteWorkflow(ctx, options, temporal_constants.FetchLinkPreviewWorkflowName, param)
+	if err != nil {
+		ps.Logger.Error("failed to execute workflow", zap.Int64("postID", post.GetId()), zap.Error(err))
+	}
+}
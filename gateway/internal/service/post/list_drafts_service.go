@@ -0,0 +1,33 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (ps *PostService) ListDrafts(ctx context.Context, userID, username string) ([]models.Draft, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.ListDrafts(ctx, &emptypb.Empty{})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ListDrafts", zap.Error(err))
+		return nil, err
+	}
+
+	drafts := make([]models.Draft, 0, len(res.GetDrafts()))
+	for _, d := range res.GetDrafts() {
+		drafts = append(drafts, *utils.DraftMapper(d))
+	}
+
+	return drafts, nil
+}
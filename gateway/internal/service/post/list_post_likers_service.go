@@ -0,0 +1,88 @@
+package post
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ps *PostService) ListPostLikers(ctx context.Context, req *postpb.ListPostLikersRequest, reqUserID string, reqUsername string) (*models.ListPostLikersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(reqUserID, reqUsername)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	// 1. Get a page of likers, the ones the viewer follows first
+	res, err := ps.PostClient.ListPostLikers(ctx, req)
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ListPostLikers", zap.Error(err))
+		return nil, err
+	}
+
+	likers := &models.ListPostLikersResponse{
+		Likers:  []models.PostLiker{},
+		HasMore: res.GetHasMore(),
+	}
+
+	if res.GetNextCursorTime() != nil {
+		cursor := res.GetNextCursorTime().AsTime()
+		likers.NextCursor = &cursor
+	}
+
+	if res.NextCursorId != nil {
+		cursorID := int(res.GetNextCursorId())
+		likers.NextCursorID = &cursorID
+	}
+
+	likers.NextCursorFollowed = res.NextCursorFollowed
+
+	if len(res.GetLikers()) == 0 {
+		return likers, nil
+	}
+
+	// 2. Enrich with user banners
+	userIDs := make([]int64, 0, len(res.GetLikers()))
+	for _, liker := range res.GetLikers() {
+		userIDs = append(userIDs, liker.GetUserId())
+	}
+
+	usersRes, err := ps.UserClient.GetUsers(ctx, &userpb.GetUsersRequest{
+		UserIds: userIDs,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call UserService.GetUsers", zap.Error(err))
+		return nil, err
+	}
+
+	userMap := make(map[int64]*userpb.UserProfile, len(usersRes.GetUsers()))
+	for _, u := range usersRes.GetUsers() {
+		userMap[u.GetId()] = u
+	}
+
+	for _, liker := range res.GetLikers() {
+		// deleted accounts are not returned by GetUsers
+		user, ok := userMap[liker.GetUserId()]
+		if !ok {
+			continue
+		}
+
+		likers.Likers = append(likers.Likers, models.PostLiker{
+			User: models.UserBanner{
+				ID:          int(user.GetId()),
+				Username:    user.GetUsername(),
+				DisplayName: user.GetDisplayName(),
+				AvatarURL:   user.GetAvatarUrl(),
+			},
+			LikedAt:    liker.GetLikedAt().AsTime(),
+			IsFollowed: liker.GetIsFollowed(),
+		})
+	}
+
+	return likers, nil
+}
@@ -0,0 +1,69 @@
+package post
+
+import (
+	"context"
+	"fmt"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func closePollWorkflowID(postID int64) string {
+	return fmt.Sprintf("close-poll-%d", postID)
+}
+
+// startClosePollWorkflow starts the timer that closes the poll on post. The
+// posts service already treats a poll past its end time as closed, so a
+// failure here only costs the voters their notification and is not returned.
+func (ps *PostService) startClosePollWorkflow(ctx context.Context, post *postpb.Post) {
+	param := temporal_dto.ClosePollWorkflowParam{
+		PostID: post.GetId(),
+		EndsAt: post.GetPoll().GetEndsAt().AsTime(),
+	}
+
+	options := client.StartWorkflowOptions{
+		ID:        closePollWorkflowID(post.GetId()),
+		TaskQueue: ps.TemporalService,
+	}
+
+	_, err := ps.TemporalClient.ExecuteWorkflow(ctx, options, temporal_constants.ClosePollWorkflowName, param)
+	if err != nil {
+		ps.Logger.Error("failed to execute workflow", zap.Int64("postID", post.GetId()), zap.Error(err))
+	}
+}
+
+func (ps *PostService) VotePoll(
+	ctx context.Context,
+	postID int64,
+	req *models.VotePollRequest,
+	userID, username string,
+) (*models.Poll, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	optionIDs := make([]int64, 0, len(req.OptionIDs))
+	for _, optionID := range req.OptionIDs {
+		optionIDs = append(optionIDs, int64(optionID))
+	}
+
+	res, err := ps.PostClient.VotePoll(ctx, &postpb.VotePollRequest{
+		PostId:    postID,
+		OptionIds: optionIDs,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.VotePoll", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.PollMapper(res), nil
+}
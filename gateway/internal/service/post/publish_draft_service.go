@@ -0,0 +1,40 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ps *PostService) PublishDraft(ctx context.Context, draftID int64, userID, username string) (*models.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.PublishDraft(ctx, &postpb.DraftRequest{DraftId: draftID})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.PublishDraft", zap.Error(err))
+		return nil, err
+	}
+
+	ps.startLinkPreviewWorkflow(ctx, res)
+	ps.startFanOutWorkflow(ctx, temporal_dto.FanOutPostWorkflowParam{
+		PostID:   res.GetId(),
+		SourceID: res.GetUserId(),
+	})
+
+	posts, err := utils.EnrichPosts(ctx, []*postpb.Post{res}, ps.UserClient, ps.CommentClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &posts[0], nil
+}
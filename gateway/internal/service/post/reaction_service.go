@@ -0,0 +1,66 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (ps *PostService) ReactToPost(ctx context.Context, postID int, req *models.ReactRequest, username, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := ps.PostClient.ReactToPost(ctx, &postpb.ReactToPostRequest{
+		PostId:   int64(postID),
+		Reaction: req.Reaction,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ReactToPost", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostService) RemoveReaction(ctx context.Context, postID int, username, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := ps.PostClient.RemoveReaction(ctx, &postpb.RemoveReactionRequest{
+		PostId: int64(postID),
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.RemoveReaction", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (ps *PostService) ListReactions(ctx context.Context) (*models.ListReactionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	res, err := ps.PostClient.ListReactions(ctx, &emptypb.Empty{})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ListReactions", zap.Error(err))
+		return nil, err
+	}
+
+	return &models.ListReactionsResponse{
+		Reactions:       res.GetReactions(),
+		DefaultReaction: res.GetDefaultReaction(),
+	}, nil
+}
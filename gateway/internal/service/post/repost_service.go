@@ -0,0 +1,43 @@
+package post
+
+import (
+	"context"
+	"strconv"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (ps *PostService) Repost(ctx context.Context, postID int, username string, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	sourceID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		ps.Logger.Error("failed to parse userID", zap.String("userID", userID), zap.Error(err))
+		return err
+	}
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err = ps.PostClient.Repost(ctx, &postpb.RepostRequest{
+		PostId: int64(postID),
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.Repost", zap.Error(err))
+		return err
+	}
+
+	ps.startFanOutWorkflow(ctx, temporal_dto.FanOutPostWorkflowParam{
+		PostID:   int64(postID),
+		SourceID: sourceID,
+		Repost:   true,
+	})
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package post
+
+import (
+	"context"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+// SaveDraft creates a draft, or replaces draftID when it is set. Mentions are
+// resolved now and kept for when the draft is published.
+func (ps *PostService) SaveDraft(
+	ctx context.Context,
+	draftID *int64,
+	req *models.SaveDraftRequest,
+	userID, username string,
+) (*models.Draft, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	postImages := make([]*postpb.PostImage, 0, len(req.PostImages))
+	for _, image := range req.PostImages {
+		postImages = append(postImages, &postpb.PostImage{
+			Url:       image.ImageURL,
+			Order:     int64(image.Order),
+			Width:     int64(image.Width),
+			Height:    int64(image.Height),
+			Sensitive: image.Sensitive,
+		})
+	}
+
+	mentions, err := utils.ResolveMentions(ctx, req.Content, ps.UserClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := ps.resolveVisibility(ctx, req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &postpb.SaveDraftRequest{
+		DraftId:        draftID,
+		Content:        req.Content,
+		Images:         postImages,
+		Mentions:       utils.PostMentionsMapper(mentions),
+		Visibility:     visibility,
+		ContentWarning: req.ContentWarning,
+	}
+
+	if req.ReplyTo != nil {
+		replyTo := int64(*req.ReplyTo)
+		data.ReplyTo = &replyTo
+	}
+
+	res, err := ps.PostClient.SaveDraft(ctx, data)
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.SaveDraft", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.DraftMapper(res), nil
+}
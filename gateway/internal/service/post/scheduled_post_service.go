@@ -0,0 +1,174 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func scheduledPostWorkflowID(scheduledPostID int64) string {
+	return fmt.Sprintf(temporal_constants.PublishScheduledPostWorkflowIDFormat, scheduledPostID)
+}
+
+func (ps *PostService) scheduledPostWorkflowOptions(scheduledPostID int64) client.StartWorkflowOptions {
+	return client.StartWorkflowOptions{
+		ID:        scheduledPostWorkflowID(scheduledPostID),
+		TaskQueue: ps.TemporalService,
+	}
+}
+
+// startPublishWorkflow starts the timer that publishes a scheduled post. If it
+// cannot start the scheduled post is cancelled so it does not sit in the list
+// without ever being published.
+func (ps *PostService) startPublishWorkflow(ctx context.Context, scheduled *postpb.Post) error {
+	param := temporal_dto.PublishScheduledPostWorkflowParam{
+		ScheduledPostID: scheduled.GetId(),
+		PublishAt:       scheduled.GetPublishAt().AsTime(),
+	}
+
+	_, err := ps.TemporalClient.ExecuteWorkflow(
+		ctx,
+		ps.scheduledPostWorkflowOptions(scheduled.GetId()),
+		temporal_constants.PublishScheduledPostWorkflowName,
+		param,
+	)
+	if err == nil {
+		return nil
+	}
+
+	ps.Logger.Error("failed to execute workflow", zap.Error(err))
+
+	_, cancelErr := ps.PostClient.CancelScheduledPost(ctx, &postpb.ScheduledPostRequest{
+		ScheduledPostId: scheduled.GetId(),
+	})
+	if cancelErr != nil {
+		ps.Logger.Error("failed to call PostService.CancelScheduledPost", zap.Error(cancelErr))
+	}
+
+	return err
+}
+
+func (ps *PostService) ListScheduled(ctx context.Context, userID, username string) ([]models.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := ps.PostClient.ListScheduledPosts(ctx, &emptypb.Empty{})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.ListScheduledPosts", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.EnrichPosts(ctx, res.GetPosts(), ps.UserClient, ps.CommentClient, ps.Logger)
+}
+
+// UpdateScheduled replaces a scheduled post and hands its publish time to the
+// workflow. Signal-with-start also brings back a workflow that already gave
+// up, for example after the account was deleted and restored.
+func (ps *PostService) UpdateScheduled(
+	ctx context.Context,
+	scheduledPostID int64,
+	req *models.UpdateScheduledPostRequest,
+	userID, username string,
+) (*postpb.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	postImages := make([]*postpb.PostImage, 0, len(req.PostImages))
+	for _, image := range req.PostImages {
+		postImages = append(postImages, &postpb.PostImage{
+			Url:       image.ImageURL,
+			Order:     int64(image.Order),
+			Width:     int64(image.Width),
+			Height:    int64(image.Height),
+			Sensitive: image.Sensitive,
+		})
+	}
+
+	mentions, err := utils.ResolveMentions(ctx, req.Content, ps.UserClient, ps.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &postpb.UpdateScheduledPostRequest{
+		ScheduledPostId: scheduledPostID,
+		Content:         req.Content,
+		Images:          postImages,
+		Mentions:        utils.PostMentionsMapper(mentions),
+	}
+
+	if req.PublishAt != nil {
+		data.PublishAt = timestamppb.New(*req.PublishAt)
+	}
+
+	res, err := ps.PostClient.UpdateScheduledPost(ctx, data)
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.UpdateScheduledPost", zap.Error(err))
+		return nil, err
+	}
+
+	publishAt := res.GetPublishAt().AsTime()
+	_, err = ps.TemporalClient.SignalWithStartWorkflow(
+		ctx,
+		scheduledPostWorkflowID(scheduledPostID),
+		temporal_constants.ReschedulePostSignal,
+		publishAt,
+		ps.scheduledPostWorkflowOptions(scheduledPostID),
+		temporal_constants.PublishScheduledPostWorkflowName,
+		temporal_dto.PublishScheduledPostWorkflowParam{
+			ScheduledPostID: scheduledPostID,
+			PublishAt:       publishAt,
+		},
+	)
+	if err != nil {
+		ps.Logger.Error("failed to signal workflow", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CancelScheduled deletes a scheduled post and stops its workflow. The post
+// is gone once the RPC succeeds, a workflow that cannot be cancelled finds
+// nothing to publish when its timer fires.
+func (ps *PostService) CancelScheduled(ctx context.Context, scheduledPostID int64, userID, username string) error {
+	ctx, cancel := context.WithTimeout(ctx, ps.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := ps.PostClient.CancelScheduledPost(ctx, &postpb.ScheduledPostRequest{
+		ScheduledPostId: scheduledPostID,
+	})
+	if err != nil {
+		ps.Logger.Error("failed to call PostService.CancelScheduledPost", zap.Error(err))
+		return err
+	}
+
+	err = ps.TemporalClient.CancelWorkflow(ctx, scheduledPostWorkflowID(scheduledPostID), "")
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		ps.Logger.Error("failed to cancel workflow", zap.Error(err))
+	}
+
+	return nil
+}
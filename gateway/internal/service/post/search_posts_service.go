@@ -95,7 +95,7 @@ func (s *PostService) SearchPosts(ctx context.Context, query string) ([]*models.
 		if c, ok := countMap[p.GetId()]; ok {
 			commentCount = int(c.GetCount())
 		}
-		hydratedPosts = append(hydratedPosts, utils.PostMapper(p, author, commentCount))
+		hydratedPosts = append(hydratedPosts, utils.PostMapper(p, author, commentCount, utils.SensitiveMediaBlur))
 	}
 
 	return hydratedPosts, nil
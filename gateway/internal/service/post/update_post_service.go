@@ -21,20 +21,27 @@ func (ps *PostService) Update(ctx context.Context, req *models.CreatePostRequest
 	postImages := make([]*postpb.PostImage, len(req.PostImages))
 	for _, image := range req.PostImages {
 		postImages = append(postImages, &postpb.PostImage{
-			Url:    image.ImageURL,
-			Order:  int64(image.Order),
-			Width:  int64(image.Width),
-			Height: int64(image.Height),
+			Url:       image.ImageURL,
+			Order:     int64(image.Order),
+			Width:     int64(image.Width),
+			Height:    int64(image.Height),
+			Sensitive: image.Sensitive,
 		})
 	}
 
+	mentions, err := utils.ResolveMentions(ctx, req.Content, ps.UserClient, ps.Logger)
+	if err != nil {
+		return err
+	}
+
 	data := &postpb.UpdatePostRequest{
-		PostId:  postID,
-		Content: req.Content,
-		Images:  postImages,
+		PostId:   postID,
+		Content:  req.Content,
+		Images:   postImages,
+		Mentions: utils.PostMentionsMapper(mentions),
 	}
 
-	_, err := ps.PostClient.UpdatePost(ctx, data)
+	_, err = ps.PostClient.UpdatePost(ctx, data)
 	if err != nil {
 		ps.Logger.Error("failed to call PostService.UpdatePost", zap.Error(err))
 		return err
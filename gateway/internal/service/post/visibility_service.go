@@ -0,0 +1,25 @@
+package post
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// resolveVisibility returns visibility when it is set and the author's
+// default_post_visibility setting otherwise. ctx must carry the author's
+// metadata.
+func (ps *PostService) resolveVisibility(ctx context.Context, visibility *string) (string, error) {
+	if visibility != nil {
+		return *visibility, nil
+	}
+
+	res, err := ps.UserClient.GetSettings(ctx, &emptypb.Empty{})
+	if err != nil {
+		ps.Logger.Error("failed to call UserService.GetSettings", zap.Error(err))
+		return "", err
+	}
+
+	return res.GetSettings().GetDefaultPostVisibility(), nil
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *UserService) CreateInvite(
+	ctx context.Context,
+	userID string,
+	username string,
+	req *models.CreateInviteRequest,
+) (*models.Invite, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := s.UserClient.CreateInvite(ctx, newCreateInviteRequest(req))
+	if err != nil {
+		s.Logger.Error("failed to call UserService.CreateInvite", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.InviteMapper(res.GetInvite()), nil
+}
+
+// AdminCreateInvite issues an invite that is not tied to any user.
+func (s *UserService) AdminCreateInvite(
+	ctx context.Context,
+	req *models.CreateInviteRequest,
+) (*models.Invite, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	res, err := s.UserClient.AdminCreateInvite(ctx, newCreateInviteRequest(req))
+	if err != nil {
+		s.Logger.Error("failed to call UserService.AdminCreateInvite", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.InviteMapper(res.GetInvite()), nil
+}
+
+func newCreateInviteRequest(req *models.CreateInviteRequest) *userpb.CreateInviteRequest {
+	pbReq := &userpb.CreateInviteRequest{
+		MaxUses: int32(req.MaxUses),
+	}
+
+	if req.ExpiresAt != nil {
+		pbReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	return pbReq
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"voidspaceGateway/internal/models"
 	temporal_constants "voidspaceGateway/temporal/constants"
 	temporal_dto "voidspaceGateway/temporal/dto"
 
@@ -12,7 +13,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func (s *UserService) DeleteUser(ctx context.Context, userID string, username string) error {
+func (s *UserService) DeleteUser(ctx context.Context, userID string, username string, clientInfo models.ClientInfo) error {
 	user, err := s.GetCurrentUser(ctx, userID, username)
 	if err != nil {
 		s.Logger.Error("failed to get user", zap.Error(err))
@@ -20,8 +21,10 @@ func (s *UserService) DeleteUser(ctx context.Context, userID string, username st
 	}
 
 	param := temporal_dto.DeleteUserWorkflowParam{
-		UserID:   strconv.Itoa(user.ID),
-		Username: username,
+		UserID:    strconv.Itoa(user.ID),
+		Username:  username,
+		IPAddress: clientInfo.IPAddress,
+		UserAgent: clientInfo.UserAgent,
 	}
 
 	run, err := s.TemporalClient.ExecuteWorkflow(
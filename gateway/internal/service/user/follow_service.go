@@ -30,5 +30,7 @@ func (s *UserService) Follow(ctx context.Context, userID string, username string
 		return err
 	}
 
+	s.startSyncFollowTimelineWorkflow(ctx, userID, targetUser.ID, true)
+
 	return nil
 }
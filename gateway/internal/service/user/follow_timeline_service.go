@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+// startSyncFollowTimelineWorkflow backfills or clears the home timeline of
+// userID after a follow change. Runs for the same pair share an ID and the
+// latest one terminates the others, so a quick follow and unfollow can't
+// land out of order. The follow itself went through, a failure is only
+// logged.
+func (s *UserService) startSyncFollowTimelineWorkflow(ctx context.Context, userID string, targetID int, following bool) {
+	followerID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		s.Logger.Error("failed to parse userID", zap.String("userID", userID), zap.Error(err))
+		return
+	}
+
+	param := temporal_dto.SyncFollowTimelineWorkflowParam{
+		UserID:    followerID,
+		TargetID:  int64(targetID),
+		Following: following,
+	}
+
+	_, err = s.TemporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                       fmt.Sprintf("sync-follow-timeline-%d-%d", followerID, targetID),
+			TaskQueue:                s.TemporalService,
+			WorkflowIDConflictPolicy: enums.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+		},
+		temporal_constants.SyncFollowTimelineWorkflowName,
+		param,
+	)
+	if err != nil {
+		s.Logger.Error("failed to execute workflow", zap.Error(err))
+	}
+}
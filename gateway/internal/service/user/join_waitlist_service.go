@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *UserService) JoinWaitlist(ctx context.Context, req *models.JoinWaitlistRequest, client models.ClientInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, utils.ClientMetaDataHandler(client))
+
+	_, err := s.UserClient.JoinWaitlist(ctx, &userpb.JoinWaitlistRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		s.Logger.Error("failed to call UserService.JoinWaitlist", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
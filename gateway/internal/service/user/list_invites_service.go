@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *UserService) ListInvites(
+	ctx context.Context,
+	userID string,
+	username string,
+	cursorID int64,
+) (*models.InviteListResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	req := &userpb.ListInvitesRequest{}
+	if cursorID > 0 {
+		req.CursorId = &cursorID
+	}
+
+	res, err := s.UserClient.ListInvites(ctx, req)
+	if err != nil {
+		s.Logger.Error("failed to call UserService.ListInvites", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.InviteListMapper(res), nil
+}
+
+func (s *UserService) AdminListInvites(
+	ctx context.Context,
+	req *userpb.ListInvitesRequest,
+) (*models.InviteListResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	res, err := s.UserClient.AdminListInvites(ctx, req)
+	if err != nil {
+		s.Logger.Error("failed to call UserService.AdminListInvites", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.InviteListMapper(res), nil
+}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *UserService) ListSecurityEvents(
+	ctx context.Context,
+	userID string,
+	username string,
+	cursorID int64,
+) (*models.SecurityLogResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	req := &userpb.ListSecurityEventsRequest{}
+	if cursorID > 0 {
+		req.CursorId = &cursorID
+	}
+
+	res, err := s.UserClient.ListSecurityEvents(ctx, req)
+	if err != nil {
+		s.Logger.Error("failed to call UserService.ListSecurityEvents", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.SecurityLogMapper(res), nil
+}
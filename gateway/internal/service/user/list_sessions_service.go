@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UserService) ListSessions(
+	ctx context.Context,
+	userID string,
+	username string,
+	sessionID string,
+) ([]*models.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.SessionMetaDataHandler(userID, username, sessionID)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := s.UserClient.ListSessions(ctx, &emptypb.Empty{})
+	if err != nil {
+		s.Logger.Error("failed to call UserService.ListSessions", zap.Error(err))
+		return nil, err
+	}
+
+	sessions := make([]*models.Session, 0, len(res.GetSessions()))
+	for _, session := range res.GetSessions() {
+		sessions = append(sessions, utils.SessionMapper(session))
+	}
+
+	return sessions, nil
+}
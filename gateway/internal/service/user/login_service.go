@@ -7,15 +7,19 @@ import (
 	"voidspaceGateway/utils"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 )
 
 func (s *UserService) Login(
 	ctx context.Context,
 	req *models.LoginRequest,
+	client models.ClientInfo,
 ) (*models.AuthResponseService, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
 	defer cancel()
 
+	ctx = metadata.NewOutgoingContext(ctx, utils.ClientMetaDataHandler(client))
+
 	res, err := s.UserClient.Login(ctx, &userpb.LoginRequest{
 		EmailOrUsername: req.UsernameOrEmail,
 		Password:        req.Password,
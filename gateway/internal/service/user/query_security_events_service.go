@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+)
+
+func (s *UserService) QuerySecurityEvents(
+	ctx context.Context,
+	req *userpb.QuerySecurityEventsRequest,
+) (*models.SecurityLogResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	res, err := s.UserClient.QuerySecurityEvents(ctx, req)
+	if err != nil {
+		s.Logger.Error("failed to call UserService.QuerySecurityEvents", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.SecurityLogMapper(res), nil
+}
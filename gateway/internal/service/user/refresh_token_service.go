@@ -14,11 +14,14 @@ func (s *UserService) RefreshToken(
 	ctx context.Context,
 	userID string,
 	username string,
+	sessionID string,
+	tokenFamily string,
 ) (*models.AuthResponseService, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
 	defer cancel()
 
-	md := utils.MetaDataHandler(userID, username)
+	md := utils.SessionMetaDataHandler(userID, username, sessionID)
+	md.Set("token_family", tokenFamily)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	res, err := s.UserClient.RefreshToken(ctx, &emptypb.Empty{})
@@ -7,19 +7,24 @@ import (
 	"voidspaceGateway/utils"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 )
 
 func (s *UserService) Register(
 	ctx context.Context,
 	req *models.RegisterRequest,
+	client models.ClientInfo,
 ) (*models.AuthResponseService, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
 	defer cancel()
 
+	ctx = metadata.NewOutgoingContext(ctx, utils.ClientMetaDataHandler(client))
+
 	res, err := s.UserClient.Register(ctx, &userpb.RegisterRequest{
-		Username: req.Username,
-		Email:    req.Email,
-		Password: req.Password,
+		Username:   req.Username,
+		Email:      req.Email,
+		Password:   req.Password,
+		InviteCode: req.InviteCode,
 	})
 	if err != nil {
 		s.Logger.Error("failed to call AuthService.Login", zap.Error(err))
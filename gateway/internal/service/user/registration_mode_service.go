@@ -0,0 +1,22 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UserService) GetRegistrationMode(ctx context.Context) (*models.RegistrationMode, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	res, err := s.UserClient.GetRegistrationMode(ctx, &emptypb.Empty{})
+	if err != nil {
+		s.Logger.Error("failed to call UserService.GetRegistrationMode", zap.Error(err))
+		return nil, err
+	}
+
+	return &models.RegistrationMode{Mode: res.GetMode()}, nil
+}
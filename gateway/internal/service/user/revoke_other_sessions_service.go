@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *UserService) RevokeAllOtherSessions(
+	ctx context.Context,
+	userID string,
+	username string,
+	sessionID string,
+) (*models.RevokeOtherSessionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.SessionMetaDataHandler(userID, username, sessionID)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := s.UserClient.RevokeAllOtherSessions(ctx, &emptypb.Empty{})
+	if err != nil {
+		s.Logger.Error("failed to call UserService.RevokeAllOtherSessions", zap.Error(err))
+		return nil, err
+	}
+
+	return &models.RevokeOtherSessionsResponse{
+		RevokedCount: int(res.GetRevokedCount()),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *UserService) RevokeSession(
+	ctx context.Context,
+	userID string,
+	username string,
+	targetSessionID int64,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	_, err := s.UserClient.RevokeSession(ctx, &userpb.RevokeSessionRequest{
+		SessionId: targetSessionID,
+	})
+	if err != nil {
+		s.Logger.Error("failed to call UserService.RevokeSession", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
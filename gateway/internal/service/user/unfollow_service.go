@@ -30,5 +30,7 @@ func (s *UserService) Unfollow(ctx context.Context, userID string, username stri
 		return err
 	}
 
+	s.startSyncFollowTimelineWorkflow(ctx, userID, targetUser.ID, false)
+
 	return nil
 }
@@ -10,21 +10,37 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-func (s *UserService) UpdateProfile(ctx context.Context, userID string, username string, req *models.UpdateProfileRequest) error {
+func (s *UserService) UpdateProfile(ctx context.Context, userID string, username string, req *models.UpdateProfileRequest, client models.ClientInfo) error {
 	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
 	defer cancel()
 
-	md := utils.MetaDataHandler(userID, username)
+	md := metadata.Join(utils.MetaDataHandler(userID, username), utils.ClientMetaDataHandler(client))
 
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	_, err := s.UserClient.UpdateProfile(ctx, &userpb.UpdateProfileRequest{
-		DisplayName: &req.DisplayName,
-		Bio:         &req.Bio,
-		AvatarUrl:   &req.AvatarURL,
-		BannerUrl:   &req.BannerURL,
-		Location:    &req.Location,
-	})
+	pbReq := &userpb.UpdateProfileRequest{
+		DisplayName:        &req.DisplayName,
+		Bio:                &req.Bio,
+		AvatarUrl:          &req.AvatarURL,
+		BannerUrl:          &req.BannerURL,
+		Location:           &req.Location,
+		Pronouns:           req.Pronouns,
+		Birthday:           req.Birthday,
+		BirthdayVisibility: req.BirthdayVisibility,
+	}
+
+	if req.Links != nil {
+		links := make([]*userpb.ProfileLink, 0, len(*req.Links))
+		for _, link := range *req.Links {
+			links = append(links, &userpb.ProfileLink{
+				Label: link.Label,
+				Url:   link.URL,
+			})
+		}
+		pbReq.Links = &userpb.ProfileLinks{Links: links}
+	}
+
+	_, err := s.UserClient.UpdateProfile(ctx, pbReq)
 	if err != nil {
 		s.Logger.Error("failed to call UserService.UpdateProfile", zap.Error(err))
 		return err
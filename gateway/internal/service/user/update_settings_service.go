@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *UserService) UpdateSettings(
+	ctx context.Context,
+	userID string,
+	username string,
+	req *models.UpdateSettingsRequest,
+) (*models.UserSettings, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ContextTimeout)
+	defer cancel()
+
+	md := utils.MetaDataHandler(userID, username)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	pbReq := &userpb.UpdateSettingsRequest{
+		DefaultPostVisibility: req.DefaultPostVisibility,
+		ReplyPolicy:           req.ReplyPolicy,
+		MentionPolicy:         req.MentionPolicy,
+		Language:              req.Language,
+		SensitiveMedia:        req.SensitiveMedia,
+	}
+
+	if req.Notifications != nil {
+		pbReq.NotifyLikes = req.Notifications.Likes
+		pbReq.NotifyComments = req.Notifications.Comments
+		pbReq.NotifyFollows = req.Notifications.Follows
+		pbReq.NotifyMentions = req.Notifications.Mentions
+	}
+
+	res, err := s.UserClient.UpdateSettings(ctx, pbReq)
+	if err != nil {
+		s.Logger.Error("failed to call UserService.UpdateSettings", zap.Error(err))
+		return nil, err
+	}
+
+	return utils.SettingsMapper(res.GetSettings()), nil
+}
@@ -21,6 +21,7 @@ type UserService struct {
 	CommentClient   commentpb.CommentServiceClient
 	TemporalClient  client.Client
 	TemporalService string
+	PublicURL       string
 }
 
 func NewUserService(
@@ -32,6 +33,7 @@ func NewUserService(
 	commentClient commentpb.CommentServiceClient,
 	temporalClient client.Client,
 	temporalService string,
+	publicURL string,
 ) *UserService {
 	return &UserService{
 		ContextTimeout:  timeout,
@@ -42,5 +44,6 @@ func NewUserService(
 		CommentClient:   commentClient,
 		TemporalClient:  temporalClient,
 		TemporalService: temporalService,
+		PublicURL:       publicURL,
 	}
 }
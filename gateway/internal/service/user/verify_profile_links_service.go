@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+// VerifyProfileLinks starts the rel=me check in the background; results land
+// on the profile once the workflow finishes.
+func (s *UserService) VerifyProfileLinks(ctx context.Context, userID string, username string) error {
+	user, err := s.GetCurrentUser(ctx, userID, username)
+	if err != nil {
+		s.Logger.Error("failed to get user", zap.Error(err))
+		return err
+	}
+
+	links := make([]string, 0, len(user.Profile.Links))
+	for _, link := range user.Profile.Links {
+		links = append(links, link.URL)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	param := temporal_dto.VerifyProfileLinksWorkflowParam{
+		UserID:     strconv.Itoa(user.ID),
+		Username:   user.Username,
+		ProfileURL: strings.TrimSuffix(s.PublicURL, "/") + "/profile/" + user.Username,
+		Links:      links,
+	}
+
+	_, err = s.TemporalClient.ExecuteWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                       "verify-profile-links-" + userID,
+			TaskQueue:                s.TemporalService,
+			WorkflowIDReusePolicy:    enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			WorkflowIDConflictPolicy: enums.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		},
+		temporal_constants.VerifyProfileLinksWorkflowName,
+		param,
+	)
+	if err != nil {
+		s.Logger.Error("failed to execute workflow", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"crypto/rsa"
+	"crypto/subtle"
 	"errors"
 	"net/http"
 	"strings"
@@ -39,6 +40,9 @@ func extractAuthUser(c echo.Context, publicKey *rsa.PublicKey) (*models.AuthUser
 	if username, ok := claims["Username"].(string); ok {
 		user.Username = username
 	}
+	if sessionID, ok := claims["SessionID"].(string); ok {
+		user.SessionID = sessionID
+	}
 
 	return user, nil
 }
@@ -55,6 +59,20 @@ func ApiMiddleware(apiKey string) echo.MiddlewareFunc {
 	}
 }
 
+// AdminMiddleware guards operator endpoints with a separate key. An empty key
+// disables the admin API entirely instead of leaving it open.
+func AdminMiddleware(adminKey string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			receivedKey := c.Request().Header.Get("x-admin-key")
+			if adminKey == "" || subtle.ConstantTimeCompare([]byte(receivedKey), []byte(adminKey)) != 1 {
+				return responses.ErrorResponseMessage(c, http.StatusUnauthorized, shared_constants.Unauthorized)
+			}
+			return next(c)
+		}
+	}
+}
+
 // AuthMiddleware creates an Echo middleware function that validates JWT tokens using RSA public key verification.
 // It extracts user information from valid tokens and makes it available to subsequent handlers.
 func AuthMiddleware(publicKey *rsa.PublicKey) echo.MiddlewareFunc {
@@ -24,12 +24,51 @@ const (
 	PostService_GetPost_FullMethodName                  = "/posts.v1.PostService/GetPost"
 	PostService_UpdatePost_FullMethodName               = "/posts.v1.PostService/UpdatePost"
 	PostService_DeletePost_FullMethodName               = "/posts.v1.PostService/DeletePost"
+	PostService_GetThread_FullMethodName                = "/posts.v1.PostService/GetThread"
+	PostService_GetPostRevisions_FullMethodName         = "/posts.v1.PostService/GetPostRevisions"
+	PostService_ListScheduledPosts_FullMethodName       = "/posts.v1.PostService/ListScheduledPosts"
+	PostService_UpdateScheduledPost_FullMethodName      = "/posts.v1.PostService/UpdateScheduledPost"
+	PostService_CancelScheduledPost_FullMethodName      = "/posts.v1.PostService/CancelScheduledPost"
+	PostService_PublishScheduledPost_FullMethodName     = "/posts.v1.PostService/PublishScheduledPost"
+	PostService_SaveDraft_FullMethodName                = "/posts.v1.PostService/SaveDraft"
+	PostService_ListDrafts_FullMethodName               = "/posts.v1.PostService/ListDrafts"
+	PostService_DeleteDraft_FullMethodName              = "/posts.v1.PostService/DeleteDraft"
+	PostService_PublishDraft_FullMethodName             = "/posts.v1.PostService/PublishDraft"
+	PostService_VotePoll_FullMethodName                 = "/posts.v1.PostService/VotePoll"
+	PostService_ClosePoll_FullMethodName                = "/posts.v1.PostService/ClosePoll"
+	PostService_ListPollVoters_FullMethodName           = "/posts.v1.PostService/ListPollVoters"
+	PostService_GetLinkPreview_FullMethodName           = "/posts.v1.PostService/GetLinkPreview"
+	PostService_SetLinkPreview_FullMethodName           = "/posts.v1.PostService/SetLinkPreview"
+	PostService_SetContentFlags_FullMethodName          = "/posts.v1.PostService/SetContentFlags"
+	PostService_AddBookmark_FullMethodName              = "/posts.v1.PostService/AddBookmark"
+	PostService_RemoveBookmark_FullMethodName           = "/posts.v1.PostService/RemoveBookmark"
+	PostService_ListBookmarks_FullMethodName            = "/posts.v1.PostService/ListBookmarks"
+	PostService_ListBookmarkFolders_FullMethodName      = "/posts.v1.PostService/ListBookmarkFolders"
 	PostService_GetUserPosts_FullMethodName             = "/posts.v1.PostService/GetUserPosts"
 	PostService_GetLikedPosts_FullMethodName            = "/posts.v1.PostService/GetLikedPosts"
 	PostService_GetGlobalFeed_FullMethodName            = "/posts.v1.PostService/GetGlobalFeed"
 	PostService_GetFollowingFeed_FullMethodName         = "/posts.v1.PostService/GetFollowingFeed"
+	PostService_GetForYouFeed_FullMethodName            = "/posts.v1.PostService/GetForYouFeed"
+	PostService_GetHomeTimeline_FullMethodName          = "/posts.v1.PostService/GetHomeTimeline"
+	PostService_FanOutPost_FullMethodName               = "/posts.v1.PostService/FanOutPost"
+	PostService_BackfillTimeline_FullMethodName         = "/posts.v1.PostService/BackfillTimeline"
+	PostService_ClearTimelineSource_FullMethodName      = "/posts.v1.PostService/ClearTimelineSource"
+	PostService_SetTimelineCelebrity_FullMethodName     = "/posts.v1.PostService/SetTimelineCelebrity"
+	PostService_GetHashtagFeed_FullMethodName           = "/posts.v1.PostService/GetHashtagFeed"
+	PostService_GetTrendingHashtags_FullMethodName      = "/posts.v1.PostService/GetTrendingHashtags"
+	PostService_GetMentions_FullMethodName              = "/posts.v1.PostService/GetMentions"
+	PostService_RefreshTrendingHashtags_FullMethodName  = "/posts.v1.PostService/RefreshTrendingHashtags"
 	PostService_LikePost_FullMethodName                 = "/posts.v1.PostService/LikePost"
 	PostService_UnlikePost_FullMethodName               = "/posts.v1.PostService/UnlikePost"
+	PostService_ReactToPost_FullMethodName              = "/posts.v1.PostService/ReactToPost"
+	PostService_RemoveReaction_FullMethodName           = "/posts.v1.PostService/RemoveReaction"
+	PostService_ListReactions_FullMethodName            = "/posts.v1.PostService/ListReactions"
+	PostService_ReconcileLikesCount_FullMethodName      = "/posts.v1.PostService/ReconcileLikesCount"
+	PostService_ListPostLikers_FullMethodName           = "/posts.v1.PostService/ListPostLikers"
+	PostService_Repost_FullMethodName                   = "/posts.v1.PostService/Repost"
+	PostService_UndoRepost_FullMethodName               = "/posts.v1.PostService/UndoRepost"
+	PostService_PinPost_FullMethodName                  = "/posts.v1.PostService/PinPost"
+	PostService_UnpinPost_FullMethodName                = "/posts.v1.PostService/UnpinPost"
 	PostService_HandleAccountDeletion_FullMethodName    = "/posts.v1.PostService/HandleAccountDeletion"
 	PostService_HandleAccountRestoration_FullMethodName = "/posts.v1.PostService/HandleAccountRestoration"
 	PostService_SearchPosts_FullMethodName              = "/posts.v1.PostService/SearchPosts"
@@ -46,15 +85,89 @@ type PostServiceClient interface {
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error)
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error)
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (*GetPostRevisionsResponse, error)
+	// ---------------------- SCHEDULED POSTS ----------------------
+	// scheduled posts come back as Post with publish_at set, id is the
+	// scheduled post id until it is published
+	ListScheduledPosts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	UpdateScheduledPost(ctx context.Context, in *UpdateScheduledPostRequest, opts ...grpc.CallOption) (*Post, error)
+	CancelScheduledPost(ctx context.Context, in *ScheduledPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// internal, called by the gateway when the publish timer fires
+	PublishScheduledPost(ctx context.Context, in *ScheduledPostRequest, opts ...grpc.CallOption) (*Post, error)
+	// ---------------------- DRAFTS ----------------------
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*Draft, error)
+	ListDrafts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDraftsResponse, error)
+	DeleteDraft(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PublishDraft(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*Post, error)
+	// ---------------------- POLLS ----------------------
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*Poll, error)
+	// internal, called by the gateway when the poll timer fires. Closing an
+	// already closed poll succeeds, so a retried close still notifies
+	ClosePoll(ctx context.Context, in *ClosePollRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// internal, pages the voters of a poll for the closed notification
+	ListPollVoters(ctx context.Context, in *ListPollVotersRequest, opts ...grpc.CallOption) (*ListPollVotersResponse, error)
+	// ---------------------- LINK PREVIEWS ----------------------
+	// internal, used by the gateway workflow that unfurls the first link of a
+	// new post. GetLinkPreview is NotFound when nothing fresh is cached
+	GetLinkPreview(ctx context.Context, in *GetLinkPreviewRequest, opts ...grpc.CallOption) (*LinkPreview, error)
+	SetLinkPreview(ctx context.Context, in *SetLinkPreviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ---------------------- MODERATION ----------------------
+	// admin, guarded by the gateway. Sets the content warning and sensitive
+	// images of a post without counting as an edit
+	SetContentFlags(ctx context.Context, in *SetContentFlagsRequest, opts ...grpc.CallOption) (*Post, error)
+	// ---------------------- BOOKMARKS ----------------------
+	// bookmarks are private, every call works on the caller's own bookmarks
+	AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveBookmark(ctx context.Context, in *BookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	ListBookmarkFolders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBookmarkFoldersResponse, error)
 	// ---------------------- USER POSTS ----------------------
-	GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
-	GetLikedPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
+	GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	// liked posts are ordered by like time, the cursor is on the like
+	GetLikedPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
 	// ---------------------- FEED ----------------------
 	GetGlobalFeed(ctx context.Context, in *GetGlobalFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
 	GetFollowingFeed(ctx context.Context, in *GetFollowingFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	// ranked, the first page freezes the ranking into a snapshot that the
+	// following pages are read from
+	GetForYouFeed(ctx context.Context, in *GetForYouFeedRequest, opts ...grpc.CallOption) (*GetForYouFeedResponse, error)
+	// ---------------------- HOME TIMELINE ----------------------
+	// the following feed read from the viewer's materialized timeline, fails
+	// with FAILED_PRECONDITION until the timeline has been built
+	GetHomeTimeline(ctx context.Context, in *GetHomeTimelineRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	// internal, the gateway fans posts out and keeps timelines in step with
+	// follows
+	FanOutPost(ctx context.Context, in *FanOutPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BackfillTimeline(ctx context.Context, in *BackfillTimelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ClearTimelineSource(ctx context.Context, in *ClearTimelineSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetTimelineCelebrity(ctx context.Context, in *SetTimelineCelebrityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ---------------------- HASHTAGS ----------------------
+	GetHashtagFeed(ctx context.Context, in *GetHashtagFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
+	// ---------------------- MENTIONS ----------------------
+	GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	// internal, run periodically by the gateway
+	RefreshTrendingHashtags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ---------------------- LIKE ----------------------
+	// a like is the default reaction, unlike removes any reaction
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// one reaction per user per post, reacting again replaces it
+	ReactToPost(ctx context.Context, in *ReactToPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListReactions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListReactionsResponse, error)
+	// internal, run nightly by the gateway to fix drifted likes_count
+	ReconcileLikesCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReconcileLikesCountResponse, error)
+	// likers the caller follows come first, then everyone else by like time
+	ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error)
+	// ---------------------- REPOST ----------------------
+	Repost(ctx context.Context, in *RepostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UndoRepost(ctx context.Context, in *RepostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ---------------------- PINS ----------------------
+	// a user pins up to three of their own posts to the top of GetUserPosts
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnpinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ---------------------- ACCOUNT LIFECYCLE ----------------------
 	HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	HandleAccountRestoration(ctx context.Context, in *HandleAccountRestorationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -109,330 +222,1451 @@ func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReques
 	return out, nil
 }
 
-func (c *postServiceClient) GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+func (c *postServiceClient) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPostsResponse)
-	err := c.cc.Invoke(ctx, PostService_GetUserPosts_FullMethodName, in, out, cOpts...)
+	out := new(GetThreadResponse)
+	err := c.cc.Invoke(ctx, PostService_GetThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (*GetPostRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPostRevisions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) GetLikedPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+func (c *postServiceClient) ListScheduledPosts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPostsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPostsResponse)
-	err := c.cc.Invoke(ctx, PostService_GetLikedPosts_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, PostService_ListScheduledPosts_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) GetGlobalFeed(ctx context.Context, in *GetGlobalFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+func (c *postServiceClient) UpdateScheduledPost(ctx context.Context, in *UpdateScheduledPostRequest, opts ...grpc.CallOption) (*Post, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFeedResponse)
-	err := c.cc.Invoke(ctx, PostService_GetGlobalFeed_FullMethodName, in, out, cOpts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, PostService_UpdateScheduledPost_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) GetFollowingFeed(ctx context.Context, in *GetFollowingFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+func (c *postServiceClient) CancelScheduledPost(ctx context.Context, in *ScheduledPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFeedResponse)
-	err := c.cc.Invoke(ctx, PostService_GetFollowingFeed_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_CancelScheduledPost_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *postServiceClient) PublishScheduledPost(ctx context.Context, in *ScheduledPostRequest, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, PostService_PublishScheduledPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*Draft, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Draft)
+	err := c.cc.Invoke(ctx, PostService_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListDrafts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDraftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDraftsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) DeleteDraft(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PostService_LikePost_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, PostService_DeleteDraft_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *postServiceClient) PublishDraft(ctx context.Context, in *DraftRequest, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, PostService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, PostService_VotePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ClosePoll(ctx context.Context, in *ClosePollRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PostService_UnlikePost_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, PostService_ClosePoll_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *postServiceClient) ListPollVoters(ctx context.Context, in *ListPollVotersRequest, opts ...grpc.CallOption) (*ListPollVotersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPollVotersResponse)
+	err := c.cc.Invoke(ctx, PostService_ListPollVoters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetLinkPreview(ctx context.Context, in *GetLinkPreviewRequest, opts ...grpc.CallOption) (*LinkPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkPreview)
+	err := c.cc.Invoke(ctx, PostService_GetLinkPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SetLinkPreview(ctx context.Context, in *SetLinkPreviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PostService_HandleAccountDeletion_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, PostService_SetLinkPreview_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) HandleAccountRestoration(ctx context.Context, in *HandleAccountRestorationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *postServiceClient) SetContentFlags(ctx context.Context, in *SetContentFlagsRequest, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, PostService_SetContentFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) AddBookmark(ctx context.Context, in *AddBookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PostService_HandleAccountRestoration_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, PostService_AddBookmark_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+func (c *postServiceClient) RemoveBookmark(ctx context.Context, in *BookmarkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SearchPostsResponse)
-	err := c.cc.Invoke(ctx, PostService_SearchPosts_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_RemoveBookmark_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PostServiceServer is the server API for PostService service.
-// All implementations must embed UnimplementedPostServiceServer
-// for forward compatibility.
-//
-// PostService provides operations for post creation, retrieval, and interactions like likes.
-type PostServiceServer interface {
-	// ---------------------- POST ----------------------
-	CreatePost(context.Context, *CreatePostRequest) (*Post, error)
-	GetPost(context.Context, *GetPostRequest) (*Post, error)
-	UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error)
-	DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error)
-	// ---------------------- USER POSTS ----------------------
-	GetUserPosts(context.Context, *GetUserPostsRequest) (*GetPostsResponse, error)
-	GetLikedPosts(context.Context, *GetUserPostsRequest) (*GetPostsResponse, error)
-	// ---------------------- FEED ----------------------
-	GetGlobalFeed(context.Context, *GetGlobalFeedRequest) (*GetFeedResponse, error)
-	GetFollowingFeed(context.Context, *GetFollowingFeedRequest) (*GetFeedResponse, error)
-	// ---------------------- LIKE ----------------------
-	LikePost(context.Context, *LikePostRequest) (*emptypb.Empty, error)
-	UnlikePost(context.Context, *UnlikePostRequest) (*emptypb.Empty, error)
-	// ---------------------- ACCOUNT LIFECYCLE ----------------------
-	HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error)
-	HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error)
-	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
-	mustEmbedUnimplementedPostServiceServer()
+func (c *postServiceClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_ListBookmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedPostServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPostServiceServer struct{}
+func (c *postServiceClient) ListBookmarkFolders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBookmarkFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookmarkFoldersResponse)
+	err := c.cc.Invoke(ctx, PostService_ListBookmarkFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedPostServiceServer) CreatePost(context.Context, *CreatePostRequest) (*Post, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+func (c *postServiceClient) GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetUserPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) GetPost(context.Context, *GetPostRequest) (*Post, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+
+func (c *postServiceClient) GetLikedPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetLikedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+
+func (c *postServiceClient) GetGlobalFeed(ctx context.Context, in *GetGlobalFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetGlobalFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+
+func (c *postServiceClient) GetFollowingFeed(ctx context.Context, in *GetFollowingFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetFollowingFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) GetUserPosts(context.Context, *GetUserPostsRequest) (*GetPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+
+func (c *postServiceClient) GetForYouFeed(ctx context.Context, in *GetForYouFeedRequest, opts ...grpc.CallOption) (*GetForYouFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForYouFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetForYouFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) GetLikedPosts(context.Context, *GetUserPostsRequest) (*GetPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLikedPosts not implemented")
+
+func (c *postServiceClient) GetHomeTimeline(ctx context.Context, in *GetHomeTimelineRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetHomeTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) GetGlobalFeed(context.Context, *GetGlobalFeedRequest) (*GetFeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGlobalFeed not implemented")
+
+func (c *postServiceClient) FanOutPost(ctx context.Context, in *FanOutPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_FanOutPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) GetFollowingFeed(context.Context, *GetFollowingFeedRequest) (*GetFeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingFeed not implemented")
+
+func (c *postServiceClient) BackfillTimeline(ctx context.Context, in *BackfillTimelineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_BackfillTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+
+func (c *postServiceClient) ClearTimelineSource(ctx context.Context, in *ClearTimelineSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_ClearTimelineSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) UnlikePost(context.Context, *UnlikePostRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
+
+func (c *postServiceClient) SetTimelineCelebrity(ctx context.Context, in *SetTimelineCelebrityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_SetTimelineCelebrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountDeletion not implemented")
+
+func (c *postServiceClient) GetHashtagFeed(ctx context.Context, in *GetHashtagFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetHashtagFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountRestoration not implemented")
+
+func (c *postServiceClient) GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingHashtagsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetTrendingHashtags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+
+func (c *postServiceClient) GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
-func (UnimplementedPostServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafePostServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PostServiceServer will
-// result in compilation errors.
-type UnsafePostServiceServer interface {
-	mustEmbedUnimplementedPostServiceServer()
+func (c *postServiceClient) RefreshTrendingHashtags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_RefreshTrendingHashtags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_LikePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnlikePost(ctx context.Context, in *UnlikePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_UnlikePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ReactToPost(ctx context.Context, in *ReactToPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_ReactToPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListReactions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListReactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactionsResponse)
+	err := c.cc.Invoke(ctx, PostService_ListReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ReconcileLikesCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReconcileLikesCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileLikesCountResponse)
+	err := c.cc.Invoke(ctx, PostService_ReconcileLikesCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListPostLikers(ctx context.Context, in *ListPostLikersRequest, opts ...grpc.CallOption) (*ListPostLikersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostLikersResponse)
+	err := c.cc.Invoke(ctx, PostService_ListPostLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) Repost(ctx context.Context, in *RepostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_Repost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UndoRepost(ctx context.Context, in *RepostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_UndoRepost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_PinPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnpinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_UnpinPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_HandleAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) HandleAccountRestoration(ctx context.Context, in *HandleAccountRestorationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PostService_HandleAccountRestoration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PostServiceServer is the server API for PostService service.
+// All implementations must embed UnimplementedPostServiceServer
+// for forward compatibility.
+//
+// PostService provides operations for post creation, retrieval, and interactions like likes.
+type PostServiceServer interface {
+	// ---------------------- POST ----------------------
+	CreatePost(context.Context, *CreatePostRequest) (*Post, error)
+	GetPost(context.Context, *GetPostRequest) (*Post, error)
+	UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error)
+	DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error)
+	GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error)
+	GetPostRevisions(context.Context, *GetPostRevisionsRequest) (*GetPostRevisionsResponse, error)
+	// ---------------------- SCHEDULED POSTS ----------------------
+	// scheduled posts come back as Post with publish_at set, id is the
+	// scheduled post id until it is published
+	ListScheduledPosts(context.Context, *emptypb.Empty) (*GetPostsResponse, error)
+	UpdateScheduledPost(context.Context, *UpdateScheduledPostRequest) (*Post, error)
+	CancelScheduledPost(context.Context, *ScheduledPostRequest) (*emptypb.Empty, error)
+	// internal, called by the gateway when the publish timer fires
+	PublishScheduledPost(context.Context, *ScheduledPostRequest) (*Post, error)
+	// ---------------------- DRAFTS ----------------------
+	SaveDraft(context.Context, *SaveDraftRequest) (*Draft, error)
+	ListDrafts(context.Context, *emptypb.Empty) (*ListDraftsResponse, error)
+	DeleteDraft(context.Context, *DraftRequest) (*emptypb.Empty, error)
+	PublishDraft(context.Context, *DraftRequest) (*Post, error)
+	// ---------------------- POLLS ----------------------
+	VotePoll(context.Context, *VotePollRequest) (*Poll, error)
+	// internal, called by the gateway when the poll timer fires. Closing an
+	// already closed poll succeeds, so a retried close still notifies
+	ClosePoll(context.Context, *ClosePollRequest) (*emptypb.Empty, error)
+	// internal, pages the voters of a poll for the closed notification
+	ListPollVoters(context.Context, *ListPollVotersRequest) (*ListPollVotersResponse, error)
+	// ---------------------- LINK PREVIEWS ----------------------
+	// internal, used by the gateway workflow that unfurls the first link of a
+	// new post. GetLinkPreview is NotFound when nothing fresh is cached
+	GetLinkPreview(context.Context, *GetLinkPreviewRequest) (*LinkPreview, error)
+	SetLinkPreview(context.Context, *SetLinkPreviewRequest) (*emptypb.Empty, error)
+	// ---------------------- MODERATION ----------------------
+	// admin, guarded by the gateway. Sets the content warning and sensitive
+	// images of a post without counting as an edit
+	SetContentFlags(context.Context, *SetContentFlagsRequest) (*Post, error)
+	// ---------------------- BOOKMARKS ----------------------
+	// bookmarks are private, every call works on the caller's own bookmarks
+	AddBookmark(context.Context, *AddBookmarkRequest) (*emptypb.Empty, error)
+	RemoveBookmark(context.Context, *BookmarkRequest) (*emptypb.Empty, error)
+	ListBookmarks(context.Context, *ListBookmarksRequest) (*GetFeedResponse, error)
+	ListBookmarkFolders(context.Context, *emptypb.Empty) (*ListBookmarkFoldersResponse, error)
+	// ---------------------- USER POSTS ----------------------
+	GetUserPosts(context.Context, *GetUserPostsRequest) (*GetFeedResponse, error)
+	// liked posts are ordered by like time, the cursor is on the like
+	GetLikedPosts(context.Context, *GetUserPostsRequest) (*GetFeedResponse, error)
+	// ---------------------- FEED ----------------------
+	GetGlobalFeed(context.Context, *GetGlobalFeedRequest) (*GetFeedResponse, error)
+	GetFollowingFeed(context.Context, *GetFollowingFeedRequest) (*GetFeedResponse, error)
+	// ranked, the first page freezes the ranking into a snapshot that the
+	// following pages are read from
+	GetForYouFeed(context.Context, *GetForYouFeedRequest) (*GetForYouFeedResponse, error)
+	// ---------------------- HOME TIMELINE ----------------------
+	// the following feed read from the viewer's materialized timeline, fails
+	// with FAILED_PRECONDITION until the timeline has been built
+	GetHomeTimeline(context.Context, *GetHomeTimelineRequest) (*GetFeedResponse, error)
+	// internal, the gateway fans posts out and keeps timelines in step with
+	// follows
+	FanOutPost(context.Context, *FanOutPostRequest) (*emptypb.Empty, error)
+	BackfillTimeline(context.Context, *BackfillTimelineRequest) (*emptypb.Empty, error)
+	ClearTimelineSource(context.Context, *ClearTimelineSourceRequest) (*emptypb.Empty, error)
+	SetTimelineCelebrity(context.Context, *SetTimelineCelebrityRequest) (*emptypb.Empty, error)
+	// ---------------------- HASHTAGS ----------------------
+	GetHashtagFeed(context.Context, *GetHashtagFeedRequest) (*GetFeedResponse, error)
+	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
+	// ---------------------- MENTIONS ----------------------
+	GetMentions(context.Context, *GetMentionsRequest) (*GetFeedResponse, error)
+	// internal, run periodically by the gateway
+	RefreshTrendingHashtags(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// ---------------------- LIKE ----------------------
+	// a like is the default reaction, unlike removes any reaction
+	LikePost(context.Context, *LikePostRequest) (*emptypb.Empty, error)
+	UnlikePost(context.Context, *UnlikePostRequest) (*emptypb.Empty, error)
+	// one reaction per user per post, reacting again replaces it
+	ReactToPost(context.Context, *ReactToPostRequest) (*emptypb.Empty, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*emptypb.Empty, error)
+	ListReactions(context.Context, *emptypb.Empty) (*ListReactionsResponse, error)
+	// internal, run nightly by the gateway to fix drifted likes_count
+	ReconcileLikesCount(context.Context, *emptypb.Empty) (*ReconcileLikesCountResponse, error)
+	// likers the caller follows come first, then everyone else by like time
+	ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error)
+	// ---------------------- REPOST ----------------------
+	Repost(context.Context, *RepostRequest) (*emptypb.Empty, error)
+	UndoRepost(context.Context, *RepostRequest) (*emptypb.Empty, error)
+	// ---------------------- PINS ----------------------
+	// a user pins up to three of their own posts to the top of GetUserPosts
+	PinPost(context.Context, *PinPostRequest) (*emptypb.Empty, error)
+	UnpinPost(context.Context, *PinPostRequest) (*emptypb.Empty, error)
+	// ---------------------- ACCOUNT LIFECYCLE ----------------------
+	HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error)
+	HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error)
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	mustEmbedUnimplementedPostServiceServer()
+}
+
+// UnimplementedPostServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPostServiceServer struct{}
+
+func (UnimplementedPostServiceServer) CreatePost(context.Context, *CreatePostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedPostServiceServer) GetPost(context.Context, *GetPostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedPostServiceServer) UpdatePost(context.Context, *UpdatePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedPostServiceServer) GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostRevisions(context.Context, *GetPostRevisionsRequest) (*GetPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
+}
+func (UnimplementedPostServiceServer) ListScheduledPosts(context.Context, *emptypb.Empty) (*GetPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledPosts not implemented")
+}
+func (UnimplementedPostServiceServer) UpdateScheduledPost(context.Context, *UpdateScheduledPostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledPost not implemented")
+}
+func (UnimplementedPostServiceServer) CancelScheduledPost(context.Context, *ScheduledPostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPost not implemented")
+}
+func (UnimplementedPostServiceServer) PublishScheduledPost(context.Context, *ScheduledPostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishScheduledPost not implemented")
+}
+func (UnimplementedPostServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*Draft, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedPostServiceServer) ListDrafts(context.Context, *emptypb.Empty) (*ListDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedPostServiceServer) DeleteDraft(context.Context, *DraftRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedPostServiceServer) PublishDraft(context.Context, *DraftRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedPostServiceServer) VotePoll(context.Context, *VotePollRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedPostServiceServer) ClosePoll(context.Context, *ClosePollRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePoll not implemented")
+}
+func (UnimplementedPostServiceServer) ListPollVoters(context.Context, *ListPollVotersRequest) (*ListPollVotersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPollVoters not implemented")
+}
+func (UnimplementedPostServiceServer) GetLinkPreview(context.Context, *GetLinkPreviewRequest) (*LinkPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkPreview not implemented")
+}
+func (UnimplementedPostServiceServer) SetLinkPreview(context.Context, *SetLinkPreviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkPreview not implemented")
+}
+func (UnimplementedPostServiceServer) SetContentFlags(context.Context, *SetContentFlagsRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContentFlags not implemented")
+}
+func (UnimplementedPostServiceServer) AddBookmark(context.Context, *AddBookmarkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookmark not implemented")
+}
+func (UnimplementedPostServiceServer) RemoveBookmark(context.Context, *BookmarkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookmark not implemented")
+}
+func (UnimplementedPostServiceServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
+}
+func (UnimplementedPostServiceServer) ListBookmarkFolders(context.Context, *emptypb.Empty) (*ListBookmarkFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarkFolders not implemented")
+}
+func (UnimplementedPostServiceServer) GetUserPosts(context.Context, *GetUserPostsRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+}
+func (UnimplementedPostServiceServer) GetLikedPosts(context.Context, *GetUserPostsRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikedPosts not implemented")
+}
+func (UnimplementedPostServiceServer) GetGlobalFeed(context.Context, *GetGlobalFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGlobalFeed not implemented")
+}
+func (UnimplementedPostServiceServer) GetFollowingFeed(context.Context, *GetFollowingFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingFeed not implemented")
+}
+func (UnimplementedPostServiceServer) GetForYouFeed(context.Context, *GetForYouFeedRequest) (*GetForYouFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForYouFeed not implemented")
+}
+func (UnimplementedPostServiceServer) GetHomeTimeline(context.Context, *GetHomeTimelineRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeTimeline not implemented")
+}
+func (UnimplementedPostServiceServer) FanOutPost(context.Context, *FanOutPostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FanOutPost not implemented")
+}
+func (UnimplementedPostServiceServer) BackfillTimeline(context.Context, *BackfillTimelineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillTimeline not implemented")
+}
+func (UnimplementedPostServiceServer) ClearTimelineSource(context.Context, *ClearTimelineSourceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearTimelineSource not implemented")
+}
+func (UnimplementedPostServiceServer) SetTimelineCelebrity(context.Context, *SetTimelineCelebrityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimelineCelebrity not implemented")
+}
+func (UnimplementedPostServiceServer) GetHashtagFeed(context.Context, *GetHashtagFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashtagFeed not implemented")
+}
+func (UnimplementedPostServiceServer) GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (UnimplementedPostServiceServer) GetMentions(context.Context, *GetMentionsRequest) (*GetFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentions not implemented")
+}
+func (UnimplementedPostServiceServer) RefreshTrendingHashtags(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTrendingHashtags not implemented")
+}
+func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedPostServiceServer) UnlikePost(context.Context, *UnlikePostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
+}
+func (UnimplementedPostServiceServer) ReactToPost(context.Context, *ReactToPostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToPost not implemented")
+}
+func (UnimplementedPostServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedPostServiceServer) ListReactions(context.Context, *emptypb.Empty) (*ListReactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactions not implemented")
+}
+func (UnimplementedPostServiceServer) ReconcileLikesCount(context.Context, *emptypb.Empty) (*ReconcileLikesCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileLikesCount not implemented")
+}
+func (UnimplementedPostServiceServer) ListPostLikers(context.Context, *ListPostLikersRequest) (*ListPostLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostLikers not implemented")
+}
+func (UnimplementedPostServiceServer) Repost(context.Context, *RepostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repost not implemented")
+}
+func (UnimplementedPostServiceServer) UndoRepost(context.Context, *RepostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoRepost not implemented")
+}
+func (UnimplementedPostServiceServer) PinPost(context.Context, *PinPostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedPostServiceServer) UnpinPost(context.Context, *PinPostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedPostServiceServer) HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountDeletion not implemented")
+}
+func (UnimplementedPostServiceServer) HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountRestoration not implemented")
+}
+func (UnimplementedPostServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
+func (UnimplementedPostServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafePostServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PostServiceServer will
+// result in compilation errors.
+type UnsafePostServiceServer interface {
+	mustEmbedUnimplementedPostServiceServer()
+}
+
+func RegisterPostServiceServer(s grpc.ServiceRegistrar, srv PostServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPostServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PostService_ServiceDesc, srv)
+}
+
+func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetThread(ctx, req.(*GetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostRevisions(ctx, req.(*GetPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListScheduledPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListScheduledPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListScheduledPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListScheduledPosts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UpdateScheduledPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UpdateScheduledPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UpdateScheduledPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UpdateScheduledPost(ctx, req.(*UpdateScheduledPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CancelScheduledPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CancelScheduledPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CancelScheduledPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CancelScheduledPost(ctx, req.(*ScheduledPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_PublishScheduledPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PublishScheduledPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PublishScheduledPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PublishScheduledPost(ctx, req.(*ScheduledPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SaveDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListDrafts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeleteDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DeleteDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeleteDraft(ctx, req.(*DraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PublishDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PublishDraft(ctx, req.(*DraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ClosePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ClosePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ClosePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ClosePoll(ctx, req.(*ClosePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListPollVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPollVotersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPollVoters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListPollVoters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPollVoters(ctx, req.(*ListPollVotersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetLinkPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetLinkPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetLinkPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetLinkPreview(ctx, req.(*GetLinkPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SetLinkPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SetLinkPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SetLinkPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SetLinkPreview(ctx, req.(*SetLinkPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SetContentFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContentFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SetContentFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SetContentFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SetContentFlags(ctx, req.(*SetContentFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_AddBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).AddBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_AddBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).AddBookmark(ctx, req.(*AddBookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RemoveBookmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RemoveBookmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RemoveBookmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RemoveBookmark(ctx, req.(*BookmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListBookmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListBookmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListBookmarks(ctx, req.(*ListBookmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListBookmarkFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListBookmarkFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListBookmarkFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListBookmarkFolders(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetUserPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetUserPosts(ctx, req.(*GetUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetLikedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetLikedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetLikedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetLikedPosts(ctx, req.(*GetUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetGlobalFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGlobalFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetGlobalFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetGlobalFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetGlobalFeed(ctx, req.(*GetGlobalFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetFollowingFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetFollowingFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetFollowingFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetFollowingFeed(ctx, req.(*GetFollowingFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetForYouFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForYouFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetForYouFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetForYouFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetForYouFeed(ctx, req.(*GetForYouFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPostServiceServer(s grpc.ServiceRegistrar, srv PostServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPostServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _PostService_GetHomeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PostService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetHomeTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetHomeTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetHomeTimeline(ctx, req.(*GetHomeTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _PostService_FanOutPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FanOutPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).CreatePost(ctx, in)
+		return srv.(PostServiceServer).FanOutPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_CreatePost_FullMethodName,
+		FullMethod: PostService_FanOutPost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(PostServiceServer).FanOutPost(ctx, req.(*FanOutPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostRequest)
+func _PostService_BackfillTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetPost(ctx, in)
+		return srv.(PostServiceServer).BackfillTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetPost_FullMethodName,
+		FullMethod: PostService_BackfillTimeline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPost(ctx, req.(*GetPostRequest))
+		return srv.(PostServiceServer).BackfillTimeline(ctx, req.(*BackfillTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePostRequest)
+func _PostService_ClearTimelineSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearTimelineSourceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).UpdatePost(ctx, in)
+		return srv.(PostServiceServer).ClearTimelineSource(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_UpdatePost_FullMethodName,
+		FullMethod: PostService_ClearTimelineSource_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+		return srv.(PostServiceServer).ClearTimelineSource(ctx, req.(*ClearTimelineSourceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostRequest)
+func _PostService_SetTimelineCelebrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTimelineCelebrityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).DeletePost(ctx, in)
+		return srv.(PostServiceServer).SetTimelineCelebrity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_DeletePost_FullMethodName,
+		FullMethod: PostService_SetTimelineCelebrity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+		return srv.(PostServiceServer).SetTimelineCelebrity(ctx, req.(*SetTimelineCelebrityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserPostsRequest)
+func _PostService_GetHashtagFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHashtagFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetUserPosts(ctx, in)
+		return srv.(PostServiceServer).GetHashtagFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetUserPosts_FullMethodName,
+		FullMethod: PostService_GetHashtagFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetUserPosts(ctx, req.(*GetUserPostsRequest))
+		return srv.(PostServiceServer).GetHashtagFeed(ctx, req.(*GetHashtagFeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetLikedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserPostsRequest)
+func _PostService_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingHashtagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetLikedPosts(ctx, in)
+		return srv.(PostServiceServer).GetTrendingHashtags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetLikedPosts_FullMethodName,
+		FullMethod: PostService_GetTrendingHashtags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetLikedPosts(ctx, req.(*GetUserPostsRequest))
+		return srv.(PostServiceServer).GetTrendingHashtags(ctx, req.(*GetTrendingHashtagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetGlobalFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGlobalFeedRequest)
+func _PostService_GetMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMentionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetGlobalFeed(ctx, in)
+		return srv.(PostServiceServer).GetMentions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetGlobalFeed_FullMethodName,
+		FullMethod: PostService_GetMentions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetGlobalFeed(ctx, req.(*GetGlobalFeedRequest))
+		return srv.(PostServiceServer).GetMentions(ctx, req.(*GetMentionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetFollowingFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowingFeedRequest)
+func _PostService_RefreshTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetFollowingFeed(ctx, in)
+		return srv.(PostServiceServer).RefreshTrendingHashtags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetFollowingFeed_FullMethodName,
+		FullMethod: PostService_RefreshTrendingHashtags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetFollowingFeed(ctx, req.(*GetFollowingFeedRequest))
+		return srv.(PostServiceServer).RefreshTrendingHashtags(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -473,6 +1707,168 @@ func _PostService_UnlikePost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_ReactToPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ReactToPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ReactToPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ReactToPost(ctx, req.(*ReactToPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListReactions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ReconcileLikesCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ReconcileLikesCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ReconcileLikesCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ReconcileLikesCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListPostLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListPostLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListPostLikers(ctx, req.(*ListPostLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_Repost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).Repost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_Repost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).Repost(ctx, req.(*RepostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UndoRepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UndoRepost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UndoRepost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UndoRepost(ctx, req.(*RepostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PinPost(ctx, req.(*PinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnpinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnpinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnpinPost(ctx, req.(*PinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_HandleAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HandleAccountDeletionRequest)
 	if err := dec(in); err != nil {
@@ -550,6 +1946,86 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
 		},
+		{
+			MethodName: "GetThread",
+			Handler:    _PostService_GetThread_Handler,
+		},
+		{
+			MethodName: "GetPostRevisions",
+			Handler:    _PostService_GetPostRevisions_Handler,
+		},
+		{
+			MethodName: "ListScheduledPosts",
+			Handler:    _PostService_ListScheduledPosts_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledPost",
+			Handler:    _PostService_UpdateScheduledPost_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPost",
+			Handler:    _PostService_CancelScheduledPost_Handler,
+		},
+		{
+			MethodName: "PublishScheduledPost",
+			Handler:    _PostService_PublishScheduledPost_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _PostService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _PostService_ListDrafts_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _PostService_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _PostService_PublishDraft_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _PostService_VotePoll_Handler,
+		},
+		{
+			MethodName: "ClosePoll",
+			Handler:    _PostService_ClosePoll_Handler,
+		},
+		{
+			MethodName: "ListPollVoters",
+			Handler:    _PostService_ListPollVoters_Handler,
+		},
+		{
+			MethodName: "GetLinkPreview",
+			Handler:    _PostService_GetLinkPreview_Handler,
+		},
+		{
+			MethodName: "SetLinkPreview",
+			Handler:    _PostService_SetLinkPreview_Handler,
+		},
+		{
+			MethodName: "SetContentFlags",
+			Handler:    _PostService_SetContentFlags_Handler,
+		},
+		{
+			MethodName: "AddBookmark",
+			Handler:    _PostService_AddBookmark_Handler,
+		},
+		{
+			MethodName: "RemoveBookmark",
+			Handler:    _PostService_RemoveBookmark_Handler,
+		},
+		{
+			MethodName: "ListBookmarks",
+			Handler:    _PostService_ListBookmarks_Handler,
+		},
+		{
+			MethodName: "ListBookmarkFolders",
+			Handler:    _PostService_ListBookmarkFolders_Handler,
+		},
 		{
 			MethodName: "GetUserPosts",
 			Handler:    _PostService_GetUserPosts_Handler,
@@ -566,6 +2042,46 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowingFeed",
 			Handler:    _PostService_GetFollowingFeed_Handler,
 		},
+		{
+			MethodName: "GetForYouFeed",
+			Handler:    _PostService_GetForYouFeed_Handler,
+		},
+		{
+			MethodName: "GetHomeTimeline",
+			Handler:    _PostService_GetHomeTimeline_Handler,
+		},
+		{
+			MethodName: "FanOutPost",
+			Handler:    _PostService_FanOutPost_Handler,
+		},
+		{
+			MethodName: "BackfillTimeline",
+			Handler:    _PostService_BackfillTimeline_Handler,
+		},
+		{
+			MethodName: "ClearTimelineSource",
+			Handler:    _PostService_ClearTimelineSource_Handler,
+		},
+		{
+			MethodName: "SetTimelineCelebrity",
+			Handler:    _PostService_SetTimelineCelebrity_Handler,
+		},
+		{
+			MethodName: "GetHashtagFeed",
+			Handler:    _PostService_GetHashtagFeed_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _PostService_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "GetMentions",
+			Handler:    _PostService_GetMentions_Handler,
+		},
+		{
+			MethodName: "RefreshTrendingHashtags",
+			Handler:    _PostService_RefreshTrendingHashtags_Handler,
+		},
 		{
 			MethodName: "LikePost",
 			Handler:    _PostService_LikePost_Handler,
@@ -574,6 +2090,42 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlikePost",
 			Handler:    _PostService_UnlikePost_Handler,
 		},
+		{
+			MethodName: "ReactToPost",
+			Handler:    _PostService_ReactToPost_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _PostService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ListReactions",
+			Handler:    _PostService_ListReactions_Handler,
+		},
+		{
+			MethodName: "ReconcileLikesCount",
+			Handler:    _PostService_ReconcileLikesCount_Handler,
+		},
+		{
+			MethodName: "ListPostLikers",
+			Handler:    _PostService_ListPostLikers_Handler,
+		},
+		{
+			MethodName: "Repost",
+			Handler:    _PostService_Repost_Handler,
+		},
+		{
+			MethodName: "UndoRepost",
+			Handler:    _PostService_UndoRepost_Handler,
+		},
+		{
+			MethodName: "PinPost",
+			Handler:    _PostService_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _PostService_UnpinPost_Handler,
+		},
 		{
 			MethodName: "HandleAccountDeletion",
 			Handler:    _PostService_HandleAccountDeletion_Handler,
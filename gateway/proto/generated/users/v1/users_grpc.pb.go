@@ -20,21 +20,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Register_FullMethodName       = "/users.v1.UserService/Register"
-	UserService_Login_FullMethodName          = "/users.v1.UserService/Login"
-	UserService_RefreshToken_FullMethodName   = "/users.v1.UserService/RefreshToken"
-	UserService_GetCurrentUser_FullMethodName = "/users.v1.UserService/GetCurrentUser"
-	UserService_GetUser_FullMethodName        = "/users.v1.UserService/GetUser"
-	UserService_GetUserById_FullMethodName    = "/users.v1.UserService/GetUserById"
-	UserService_GetUsers_FullMethodName       = "/users.v1.UserService/GetUsers"
-	UserService_UpdateProfile_FullMethodName  = "/users.v1.UserService/UpdateProfile"
-	UserService_ListFollowers_FullMethodName  = "/users.v1.UserService/ListFollowers"
-	UserService_ListFollowing_FullMethodName  = "/users.v1.UserService/ListFollowing"
-	UserService_Follow_FullMethodName         = "/users.v1.UserService/Follow"
-	UserService_Unfollow_FullMethodName       = "/users.v1.UserService/Unfollow"
-	UserService_DeleteUser_FullMethodName     = "/users.v1.UserService/DeleteUser"
-	UserService_RestoreUser_FullMethodName    = "/users.v1.UserService/RestoreUser"
-	UserService_SearchUsers_FullMethodName    = "/users.v1.UserService/SearchUsers"
+	UserService_Register_FullMethodName               = "/users.v1.UserService/Register"
+	UserService_Login_FullMethodName                  = "/users.v1.UserService/Login"
+	UserService_RefreshToken_FullMethodName           = "/users.v1.UserService/RefreshToken"
+	UserService_GetCurrentUser_FullMethodName         = "/users.v1.UserService/GetCurrentUser"
+	UserService_GetUser_FullMethodName                = "/users.v1.UserService/GetUser"
+	UserService_GetUserById_FullMethodName            = "/users.v1.UserService/GetUserById"
+	UserService_GetUsers_FullMethodName               = "/users.v1.UserService/GetUsers"
+	UserService_GetUsersByUsernames_FullMethodName    = "/users.v1.UserService/GetUsersByUsernames"
+	UserService_UpdateProfile_FullMethodName          = "/users.v1.UserService/UpdateProfile"
+	UserService_SetProfileLinkVerified_FullMethodName = "/users.v1.UserService/SetProfileLinkVerified"
+	UserService_ListFollowers_FullMethodName          = "/users.v1.UserService/ListFollowers"
+	UserService_ListFollowing_FullMethodName          = "/users.v1.UserService/ListFollowing"
+	UserService_Follow_FullMethodName                 = "/users.v1.UserService/Follow"
+	UserService_Unfollow_FullMethodName               = "/users.v1.UserService/Unfollow"
+	UserService_FilterFollowing_FullMethodName        = "/users.v1.UserService/FilterFollowing"
+	UserService_DeleteUser_FullMethodName             = "/users.v1.UserService/DeleteUser"
+	UserService_RestoreUser_FullMethodName            = "/users.v1.UserService/RestoreUser"
+	UserService_SearchUsers_FullMethodName            = "/users.v1.UserService/SearchUsers"
+	UserService_ListSessions_FullMethodName           = "/users.v1.UserService/ListSessions"
+	UserService_RevokeSession_FullMethodName          = "/users.v1.UserService/RevokeSession"
+	UserService_RevokeAllOtherSessions_FullMethodName = "/users.v1.UserService/RevokeAllOtherSessions"
+	UserService_ListSecurityEvents_FullMethodName     = "/users.v1.UserService/ListSecurityEvents"
+	UserService_QuerySecurityEvents_FullMethodName    = "/users.v1.UserService/QuerySecurityEvents"
+	UserService_GetSettings_FullMethodName            = "/users.v1.UserService/GetSettings"
+	UserService_UpdateSettings_FullMethodName         = "/users.v1.UserService/UpdateSettings"
+	UserService_GetUsersSettings_FullMethodName       = "/users.v1.UserService/GetUsersSettings"
+	UserService_GetRegistrationMode_FullMethodName    = "/users.v1.UserService/GetRegistrationMode"
+	UserService_JoinWaitlist_FullMethodName           = "/users.v1.UserService/JoinWaitlist"
+	UserService_CreateInvite_FullMethodName           = "/users.v1.UserService/CreateInvite"
+	UserService_ListInvites_FullMethodName            = "/users.v1.UserService/ListInvites"
+	UserService_AdminCreateInvite_FullMethodName      = "/users.v1.UserService/AdminCreateInvite"
+	UserService_AdminListInvites_FullMethodName       = "/users.v1.UserService/AdminListInvites"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -52,14 +69,36 @@ type UserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	GetUserById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
+	GetUsersByUsernames(ctx context.Context, in *GetUsersByUsernamesRequest, opts ...grpc.CallOption) (*GetUsersByUsernamesResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	SetProfileLinkVerified(ctx context.Context, in *SetProfileLinkVerifiedRequest, opts ...grpc.CallOption) (*SetProfileLinkVerifiedResponse, error)
 	ListFollowers(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error)
 	ListFollowing(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error)
 	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
 	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)
+	// internal, used by the posts service to check post audiences
+	FilterFollowing(ctx context.Context, in *FilterFollowingRequest, opts ...grpc.CallOption) (*FilterFollowingResponse, error)
 	DeleteUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	// ---------------------- SESSION ----------------------
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResponse, error)
+	// ---------------------- SECURITY ----------------------
+	ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsResponse, error)
+	QuerySecurityEvents(ctx context.Context, in *QuerySecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsResponse, error)
+	// ---------------------- SETTINGS ----------------------
+	GetSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSettingsResponse, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
+	GetUsersSettings(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersSettingsResponse, error)
+	// ---------------------- INVITES ----------------------
+	GetRegistrationMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RegistrationModeResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	AdminCreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
+	AdminListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
 }
 
 type userServiceClient struct {
@@ -140,6 +179,16 @@ func (c *userServiceClient) GetUsers(ctx context.Context, in *GetUsersRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) GetUsersByUsernames(ctx context.Context, in *GetUsersByUsernamesRequest, opts ...grpc.CallOption) (*GetUsersByUsernamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByUsernamesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUsersByUsernames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateProfileResponse)
@@ -150,6 +199,16 @@ func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfile
 	return out, nil
 }
 
+func (c *userServiceClient) SetProfileLinkVerified(ctx context.Context, in *SetProfileLinkVerifiedRequest, opts ...grpc.CallOption) (*SetProfileLinkVerifiedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProfileLinkVerifiedResponse)
+	err := c.cc.Invoke(ctx, UserService_SetProfileLinkVerified_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListFollowers(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListFollowersResponse)
@@ -190,6 +249,16 @@ func (c *userServiceClient) Unfollow(ctx context.Context, in *UnfollowRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) FilterFollowing(ctx context.Context, in *FilterFollowingRequest, opts ...grpc.CallOption) (*FilterFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterFollowingResponse)
+	err := c.cc.Invoke(ctx, UserService_FilterFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) DeleteUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteUserResponse)
@@ -220,6 +289,146 @@ func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequ
 	return out, nil
 }
 
+func (c *userServiceClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAllOtherSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllOtherSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSecurityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) QuerySecurityEvents(ctx context.Context, in *QuerySecurityEventsRequest, opts ...grpc.CallOption) (*SecurityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_QuerySecurityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUsersSettings(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUsersSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRegistrationMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RegistrationModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegistrationModeResponse)
+	err := c.cc.Invoke(ctx, UserService_GetRegistrationMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, UserService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AdminCreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteResponse)
+	err := c.cc.Invoke(ctx, UserService_AdminCreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AdminListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, UserService_AdminListInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -235,14 +444,36 @@ type UserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	GetUserById(context.Context, *GetUserByIdRequest) (*GetUserResponse, error)
 	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
+	GetUsersByUsernames(context.Context, *GetUsersByUsernamesRequest) (*GetUsersByUsernamesResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	SetProfileLinkVerified(context.Context, *SetProfileLinkVerifiedRequest) (*SetProfileLinkVerifiedResponse, error)
 	ListFollowers(context.Context, *GetUserByIdRequest) (*ListFollowersResponse, error)
 	ListFollowing(context.Context, *GetUserByIdRequest) (*ListFollowingResponse, error)
 	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
 	Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error)
+	// internal, used by the posts service to check post audiences
+	FilterFollowing(context.Context, *FilterFollowingRequest) (*FilterFollowingResponse, error)
 	DeleteUser(context.Context, *emptypb.Empty) (*DeleteUserResponse, error)
 	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// ---------------------- SESSION ----------------------
+	ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllOtherSessions(context.Context, *emptypb.Empty) (*RevokeAllOtherSessionsResponse, error)
+	// ---------------------- SECURITY ----------------------
+	ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*SecurityEventsResponse, error)
+	QuerySecurityEvents(context.Context, *QuerySecurityEventsRequest) (*SecurityEventsResponse, error)
+	// ---------------------- SETTINGS ----------------------
+	GetSettings(context.Context, *emptypb.Empty) (*GetSettingsResponse, error)
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*GetSettingsResponse, error)
+	GetUsersSettings(context.Context, *GetUsersRequest) (*GetUsersSettingsResponse, error)
+	// ---------------------- INVITES ----------------------
+	GetRegistrationMode(context.Context, *emptypb.Empty) (*RegistrationModeResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	AdminCreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
+	AdminListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -274,9 +505,15 @@ func (UnimplementedUserServiceServer) GetUserById(context.Context, *GetUserByIdR
 func (UnimplementedUserServiceServer) GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
 }
+func (UnimplementedUserServiceServer) GetUsersByUsernames(context.Context, *GetUsersByUsernamesRequest) (*GetUsersByUsernamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByUsernames not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedUserServiceServer) SetProfileLinkVerified(context.Context, *SetProfileLinkVerifiedRequest) (*SetProfileLinkVerifiedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfileLinkVerified not implemented")
+}
 func (UnimplementedUserServiceServer) ListFollowers(context.Context, *GetUserByIdRequest) (*ListFollowersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
 }
@@ -289,6 +526,9 @@ func (UnimplementedUserServiceServer) Follow(context.Context, *FollowRequest) (*
 func (UnimplementedUserServiceServer) Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
 }
+func (UnimplementedUserServiceServer) FilterFollowing(context.Context, *FilterFollowingRequest) (*FilterFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterFollowing not implemented")
+}
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *emptypb.Empty) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
@@ -298,6 +538,48 @@ func (UnimplementedUserServiceServer) RestoreUser(context.Context, *RestoreUserR
 func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
 }
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAllOtherSessions(context.Context, *emptypb.Empty) (*RevokeAllOtherSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedUserServiceServer) ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*SecurityEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityEvents not implemented")
+}
+func (UnimplementedUserServiceServer) QuerySecurityEvents(context.Context, *QuerySecurityEventsRequest) (*SecurityEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySecurityEvents not implemented")
+}
+func (UnimplementedUserServiceServer) GetSettings(context.Context, *emptypb.Empty) (*GetSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*GetSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedUserServiceServer) GetUsersSettings(context.Context, *GetUsersRequest) (*GetUsersSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersSettings not implemented")
+}
+func (UnimplementedUserServiceServer) GetRegistrationMode(context.Context, *emptypb.Empty) (*RegistrationModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationMode not implemented")
+}
+func (UnimplementedUserServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedUserServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedUserServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedUserServiceServer) AdminCreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateInvite not implemented")
+}
+func (UnimplementedUserServiceServer) AdminListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListInvites not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -445,6 +727,24 @@ func _UserService_GetUsers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUsersByUsernames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByUsernamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByUsernames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersByUsernames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByUsernames(ctx, req.(*GetUsersByUsernamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProfileRequest)
 	if err := dec(in); err != nil {
@@ -463,6 +763,24 @@ func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetProfileLinkVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileLinkVerifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetProfileLinkVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetProfileLinkVerified_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetProfileLinkVerified(ctx, req.(*SetProfileLinkVerifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserByIdRequest)
 	if err := dec(in); err != nil {
@@ -535,6 +853,24 @@ func _UserService_Unfollow_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_FilterFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FilterFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FilterFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FilterFollowing(ctx, req.(*FilterFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -589,6 +925,258 @@ func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAllOtherSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSecurityEvents(ctx, req.(*ListSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_QuerySecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).QuerySecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_QuerySecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).QuerySecurityEvents(ctx, req.(*QuerySecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUsersSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersSettings(ctx, req.(*GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRegistrationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRegistrationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetRegistrationMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRegistrationMode(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AdminCreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AdminCreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AdminCreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AdminCreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AdminListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AdminListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AdminListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AdminListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -624,10 +1212,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsers",
 			Handler:    _UserService_GetUsers_Handler,
 		},
+		{
+			MethodName: "GetUsersByUsernames",
+			Handler:    _UserService_GetUsersByUsernames_Handler,
+		},
 		{
 			MethodName: "UpdateProfile",
 			Handler:    _UserService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "SetProfileLinkVerified",
+			Handler:    _UserService_SetProfileLinkVerified_Handler,
+		},
 		{
 			MethodName: "ListFollowers",
 			Handler:    _UserService_ListFollowers_Handler,
@@ -644,6 +1240,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unfollow",
 			Handler:    _UserService_Unfollow_Handler,
 		},
+		{
+			MethodName: "FilterFollowing",
+			Handler:    _UserService_FilterFollowing_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
@@ -656,6 +1256,62 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchUsers",
 			Handler:    _UserService_SearchUsers_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _UserService_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "ListSecurityEvents",
+			Handler:    _UserService_ListSecurityEvents_Handler,
+		},
+		{
+			MethodName: "QuerySecurityEvents",
+			Handler:    _UserService_QuerySecurityEvents_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _UserService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _UserService_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "GetUsersSettings",
+			Handler:    _UserService_GetUsersSettings_Handler,
+		},
+		{
+			MethodName: "GetRegistrationMode",
+			Handler:    _UserService_GetRegistrationMode_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _UserService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _UserService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _UserService_ListInvites_Handler,
+		},
+		{
+			MethodName: "AdminCreateInvite",
+			Handler:    _UserService_AdminCreateInvite_Handler,
+		},
+		{
+			MethodName: "AdminListInvites",
+			Handler:    _UserService_AdminListInvites_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users/v1/users.proto",
@@ -16,7 +16,21 @@ func RegisterActivities(t *bootstrap.TemporalService, ua *user.UserActivities, p
 	t.RegisterActivity(ua.DeleteUserCommentsCompensateActivity, user.DeleteUserCommentsCompensateActivity)
 	t.RegisterActivity(ua.DeleteUserPostsCompensateActivity, user.DeleteUserPostsCompensateActivity)
 
+	// Profile Activities
+	t.RegisterActivity(ua.VerifyProfileLinkActivity, user.VerifyProfileLinkActivity)
+
 	// Post Activities
 	t.RegisterActivity(pa.DeletePostActivity, post.DeletePostActivity)
 	t.RegisterActivity(pa.DeletePostCommentsActivity, post.DeletePostCommentsActivity)
+	t.RegisterActivity(pa.RefreshTrendingHashtagsActivity, post.RefreshTrendingHashtagsActivity)
+	t.RegisterActivity(pa.ReconcileLikesCountActivity, post.ReconcileLikesCountActivity)
+	t.RegisterActivity(pa.PublishScheduledPostActivity, post.PublishScheduledPostActivity)
+	t.RegisterActivity(pa.ClosePollActivity, post.ClosePollActivity)
+	t.RegisterActivity(pa.NotifyPollVotersActivity, post.NotifyPollVotersActivity)
+	t.RegisterActivity(pa.FetchLinkPreviewActivity, post.FetchLinkPreviewActivity)
+
+	// Home Timeline Activities
+	t.RegisterActivity(pa.FanOutPostActivity, post.FanOutPostActivity)
+	t.RegisterActivity(pa.SyncFollowTimelineActivity, post.SyncFollowTimelineActivity)
+	t.RegisterActivity(pa.RebuildTimelineActivity, post.RebuildTimelineActivity)
 }
@@ -0,0 +1,42 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ClosePollActivity = "ClosePollActivity"
+
+// ClosePollActivity closes the poll. The posts service treats closing a
+// closed poll as done, so a retry after a lost reply succeeds.
+func (pa *PostActivities) ClosePollActivity(
+	ctx context.Context,
+	req temporal_dto.ClosePollReq,
+) error {
+	pa.Logger.Info("Starting Close Poll Activity", zap.Int64("postID", req.PostID))
+
+	_, err := pa.PostClient.ClosePoll(ctx, &postpb.ClosePollRequest{
+		PostId: req.PostID,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.ClosePoll", zap.Error(err))
+		st, ok := status.FromError(err)
+		if !ok {
+			return err
+		}
+		// the post was deleted before the poll ended
+		if st.Code() == codes.NotFound {
+			return temporal.NewNonRetryableApplicationError(st.Message(), st.Code().String(), err)
+		}
+		return temporal.NewApplicationErrorWithCause(st.Message(), st.Code().String(), err)
+	}
+
+	return nil
+}
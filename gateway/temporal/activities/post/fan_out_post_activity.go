@@ -0,0 +1,105 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FanOutPostActivity = "FanOutPostActivity"
+
+	// fanOutBatchSize is how many timelines one PostService.FanOutPost call
+	// writes to
+	fanOutBatchSize = 1000
+)
+
+// FanOutPostActivity adds the post to the home timeline of every follower of
+// its source. Sources with CelebrityFollowers or more followers are marked
+// as celebrities instead and pulled into timelines at read time. Writes are
+// upserts, so a retry redoes the batches already written.
+func (pa *PostActivities) FanOutPostActivity(
+	ctx context.Context,
+	req temporal_dto.FanOutPostReq,
+) error {
+	pa.Logger.Info(
+		"Starting Fan Out Post Activity",
+		zap.Int64("postID", req.PostID),
+		zap.Int64("sourceID", req.SourceID))
+
+	source, err := pa.UserClient.GetUserById(ctx, &userpb.GetUserByIdRequest{
+		UserId: req.SourceID,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call UserService.GetUserById", zap.Error(err))
+		return timelineActivityError(err)
+	}
+
+	celebrity := pa.CelebrityFollowers > 0 && source.GetUser().GetFollowers() >= int64(pa.CelebrityFollowers)
+
+	_, err = pa.PostClient.SetTimelineCelebrity(ctx, &postpb.SetTimelineCelebrityRequest{
+		UserId:    req.SourceID,
+		Celebrity: celebrity,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.SetTimelineCelebrity", zap.Error(err))
+		return timelineActivityError(err)
+	}
+
+	if celebrity {
+		return nil
+	}
+
+	followers, err := pa.UserClient.ListFollowers(ctx, &userpb.GetUserByIdRequest{
+		UserId: req.SourceID,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call UserService.ListFollowers", zap.Error(err))
+		return timelineActivityError(err)
+	}
+
+	followerIDs := make([]int64, 0, len(followers.GetUsers()))
+	for _, follower := range followers.GetUsers() {
+		followerIDs = append(followerIDs, follower.GetId())
+	}
+
+	for start := 0; start < len(followerIDs); start += fanOutBatchSize {
+		fanOut := &postpb.FanOutPostRequest{
+			PostId:  req.PostID,
+			UserIds: followerIDs[start:min(start+fanOutBatchSize, len(followerIDs))],
+		}
+		if req.Repost {
+			fanOut.RepostedBy = &req.SourceID
+		}
+
+		_, err = pa.PostClient.FanOutPost(ctx, fanOut)
+		if err != nil {
+			pa.Logger.Error("failed to call PostService.FanOutPost", zap.Error(err))
+			return timelineActivityError(err)
+		}
+	}
+
+	return nil
+}
+
+// timelineActivityError wraps err for Temporal, a user or post that is gone
+// won't come back on a retry.
+func timelineActivityError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound, codes.InvalidArgument:
+		return temporal.NewNonRetryableApplicationError(st.Message(), st.Code().String(), err)
+	}
+	return temporal.NewApplicationErrorWithCause(st.Message(), st.Code().String(), err)
+}
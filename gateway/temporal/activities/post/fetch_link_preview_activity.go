@@ -0,0 +1,119 @@
+package post
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const FetchLinkPreviewActivity = "FetchLinkPreviewActivity"
+
+// FetchLinkPreviewActivity attaches the preview of req.URL to the post,
+// reusing the cached one when it is fresh. Pages that can't be fetched or
+// have no metadata leave the post without a preview.
+func (pa *PostActivities) FetchLinkPreviewActivity(
+	ctx context.Context,
+	req temporal_dto.FetchLinkPreviewReq,
+) error {
+	pa.Logger.Info(
+		"Starting Fetch Link Preview Activity",
+		zap.Int64("postID", req.PostID),
+		zap.String("url", req.URL))
+
+	preview, err := pa.PostClient.GetLinkPreview(ctx, &postpb.GetLinkPreviewRequest{
+		Url: req.URL,
+	})
+	if status.Code(err) == codes.NotFound {
+		preview, err = pa.fetchLinkPreview(ctx, req.URL)
+		if err != nil {
+			// the link stays plain text
+			pa.Logger.Warn("failed to fetch link preview", zap.String("url", req.URL), zap.Error(err))
+			return nil
+		}
+	}
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.GetLinkPreview", zap.Error(err))
+		return err
+	}
+
+	if preview.GetTitle() == "" && preview.GetDescription() == "" {
+		return nil
+	}
+
+	_, err = pa.PostClient.SetLinkPreview(ctx, &postpb.SetLinkPreviewRequest{
+		PostId:  req.PostID,
+		Preview: preview,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.SetLinkPreview", zap.Error(err))
+		st, ok := status.FromError(err)
+		if !ok {
+			return err
+		}
+		// the post was deleted in the meantime
+		if st.Code() == codes.NotFound || st.Code() == codes.InvalidArgument {
+			return temporal.NewNonRetryableApplicationError(st.Message(), st.Code().String(), err)
+		}
+		return temporal.NewApplicationErrorWithCause(st.Message(), st.Code().String(), err)
+	}
+
+	return nil
+}
+
+func (pa *PostActivities) fetchLinkPreview(ctx context.Context, linkURL string) (*postpb.LinkPreview, error) {
+	parsed, err := url.Parse(linkURL)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme %q", parsed.Scheme)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, utils.LinkPreviewFetchTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", "text/html")
+
+	res, err := pa.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	if !utils.IsHTMLResponse(res) {
+		return nil, fmt.Errorf("unexpected content type %q", res.Header.Get("Content-Type"))
+	}
+
+	// relative images resolve against the page we ended up on
+	preview, err := utils.ParseLinkPreview(io.LimitReader(res.Body, utils.LinkPreviewMaxBodySize), res.Request.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postpb.LinkPreview{
+		Url:         linkURL,
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+		SiteName:    preview.SiteName,
+	}, nil
+}
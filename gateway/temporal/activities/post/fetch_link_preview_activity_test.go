@@ -0,0 +1,212 @@
+package post
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakePostClient struct {
+	postpb.PostServiceClient
+	cache map[string]*postpb.LinkPreview
+	calls []*postpb.SetLinkPreviewRequest
+}
+
+func (f *fakePostClient) GetLinkPreview(
+	ctx context.Context,
+	in *postpb.GetLinkPreviewRequest,
+	opts ...grpc.CallOption,
+) (*postpb.LinkPreview, error) {
+	if preview, ok := f.cache[in.GetUrl()]; ok {
+		return preview, nil
+	}
+	return nil, status.Error(codes.NotFound, "link preview not found")
+}
+
+func (f *fakePostClient) SetLinkPreview(
+	ctx context.Context,
+	in *postpb.SetLinkPreviewRequest,
+	opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	f.calls = append(f.calls, in)
+	return &emptypb.Empty{}, nil
+}
+
+func TestFetchLinkPreviewActivity(t *testing.T) {
+	var hits atomic.Int32
+
+	page := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(body))
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/og", page(`<html><head>
+		<title>Fallback title</title>
+		<meta property="og:title" content="OpenGraph title">
+		<meta property="og:description" content="OpenGraph description">
+		<meta property="og:image" content="/images/cover.png">
+		<meta property="og:site_name" content="Fixture">
+		<meta name="twitter:title" content="Twitter title">
+		</head><body><meta property="og:title" content="ignored"></body></html>`))
+	mux.HandleFunc("/twitter", page(`<html><head>
+		<meta name="twitter:card" content="summary">
+		<meta name="twitter:title" content="Twitter title">
+		<meta name="twitter:description" content="Twitter description">
+		<meta name="twitter:image" content="https://cdn.example.com/card.jpg">
+		</head></html>`))
+	mux.HandleFunc("/plain", page(`<html><head>
+		<title>  Plain page  </title>
+		<meta name="description" content="Plain description">
+		</head></html>`))
+	mux.HandleFunc("/empty", page(`<html><head></head><body><h1>Nothing</h1></body></html>`))
+	mux.HandleFunc("/huge", page(`<html><head><!--`+strings.Repeat("x", utils.LinkPreviewMaxBodySize)+
+		`--><meta property="og:title" content="Too far"></head></html>`))
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"title": "not html"}`))
+	})
+	mux.HandleFunc("/missing", http.NotFound)
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/redirect", http.StatusFound)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/assets/page", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/assets/page", page(`<html><head>
+		<meta property="og:title" content="Moved">
+		<meta property="og:image" content="cover.png">
+		</head></html>`))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	cached := &postpb.LinkPreview{Url: server.URL + "/cached", Title: "Cached title"}
+
+	tests := []struct {
+		name    string
+		link    string
+		client  *http.Client
+		want    *postpb.LinkPreview
+		wantHit bool
+	}{
+		{
+			name: "opengraph wins over twitter and title",
+			link: server.URL + "/og",
+			want: &postpb.LinkPreview{
+				Url:         server.URL + "/og",
+				Title:       "OpenGraph title",
+				Description: "OpenGraph description",
+				ImageUrl:    server.URL + "/images/cover.png",
+				SiteName:    "Fixture",
+			},
+			wantHit: true,
+		},
+		{
+			name: "twitter card",
+			link: server.URL + "/twitter",
+			want: &postpb.LinkPreview{
+				Url:         server.URL + "/twitter",
+				Title:       "Twitter title",
+				Description: "Twitter description",
+				ImageUrl:    "https://cdn.example.com/card.jpg",
+			},
+			wantHit: true,
+		},
+		{
+			name: "title and description fallback",
+			link: server.URL + "/plain",
+			want: &postpb.LinkPreview{
+				Url:         server.URL + "/plain",
+				Title:       "Plain page",
+				Description: "Plain description",
+			},
+			wantHit: true,
+		},
+		{
+			name: "image resolved against redirect target",
+			link: server.URL + "/moved",
+			want: &postpb.LinkPreview{
+				Url:      server.URL + "/moved",
+				Title:    "Moved",
+				ImageUrl: server.URL + "/assets/cover.png",
+			},
+			wantHit: true,
+		},
+		{"no metadata", server.URL + "/empty", nil, nil, true},
+		{"metadata past the size limit", server.URL + "/huge", nil, nil, true},
+		{"not html", server.URL + "/json", nil, nil, true},
+		{"not found", server.URL + "/missing", nil, nil, true},
+		{"redirect loop", server.URL + "/redirect", nil, nil, true},
+		{"unsupported scheme", "ftp://example.com/", nil, nil, false},
+		{"cached preview is reused", cached.GetUrl(), nil, cached, false},
+		{"loopback is blocked", server.URL + "/og", utils.NewLinkPreviewHTTPClient(false), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits.Store(0)
+
+			httpClient := tt.client
+			if httpClient == nil {
+				httpClient = utils.NewLinkPreviewHTTPClient(true)
+			}
+
+			client := &fakePostClient{cache: map[string]*postpb.LinkPreview{cached.GetUrl(): cached}}
+			pa := NewPostActivities(time.Second, zap.NewNop(), client, nil, nil, httpClient, 0)
+
+			err := pa.FetchLinkPreviewActivity(context.Background(), temporal_dto.FetchLinkPreviewReq{
+				PostID: 7,
+				URL:    tt.link,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := hits.Load() > 0; got != tt.wantHit {
+				t.Fatalf("fixture server hit = %v, want %v", got, tt.wantHit)
+			}
+
+			if tt.want == nil {
+				if len(client.calls) != 0 {
+					t.Fatalf("expected no SetLinkPreview call, got %+v", client.calls[0])
+				}
+				return
+			}
+
+			if len(client.calls) != 1 {
+				t.Fatalf("expected 1 SetLinkPreview call, got %d", len(client.calls))
+			}
+
+			got := client.calls[0]
+			if got.GetPostId() != 7 {
+				t.Fatalf("post id = %d, want 7", got.GetPostId())
+			}
+			if got.GetPreview().GetUrl() != tt.want.GetUrl() ||
+				got.GetPreview().GetTitle() != tt.want.GetTitle() ||
+				got.GetPreview().GetDescription() != tt.want.GetDescription() ||
+				got.GetPreview().GetImageUrl() != tt.want.GetImageUrl() ||
+				got.GetPreview().GetSiteName() != tt.want.GetSiteName() {
+				t.Fatalf("preview = %+v, want %+v", got.GetPreview(), tt.want)
+			}
+		})
+	}
+}
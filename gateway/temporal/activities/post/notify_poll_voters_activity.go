@@ -0,0 +1,60 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/activity"
+	"go.uber.org/zap"
+)
+
+const NotifyPollVotersActivity = "NotifyPollVotersActivity"
+
+// NotifyPollVotersActivity pages through the voters of a closed poll and logs
+// how many there were. Delivering the notifications is out of scope until
+// there is a notification service to hand them to, the paging is where that
+// hook goes. The last voter handled is recorded as heartbeat so a retry
+// resumes after it instead of starting over.
+func (pa *PostActivities) NotifyPollVotersActivity(
+	ctx context.Context,
+	req temporal_dto.NotifyPollVotersReq,
+) error {
+	pa.Logger.Info("Starting Notify Poll Voters Activity", zap.Int64("postID", req.PostID))
+
+	var cursorID int64
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &cursorID); err != nil {
+			return err
+		}
+	}
+
+	// counts the voters of this attempt, a retry starts after the heartbeat
+	voters := 0
+
+	for {
+		res, err := pa.PostClient.ListPollVoters(ctx, &postpb.ListPollVotersRequest{
+			PostId:   req.PostID,
+			CursorId: &cursorID,
+		})
+		if err != nil {
+			pa.Logger.Error("failed to call PostService.ListPollVoters", zap.Error(err))
+			return err
+		}
+
+		if len(res.GetVoterIds()) > 0 {
+			voters += len(res.GetVoterIds())
+			cursorID = res.GetVoterIds()[len(res.GetVoterIds())-1]
+		}
+		activity.RecordHeartbeat(ctx, cursorID)
+
+		if !res.GetHasMore() {
+			pa.Logger.Info("poll closed, voters not notified",
+				zap.Int64("postID", req.PostID),
+				zap.Int("voters", voters),
+			)
+			return nil
+		}
+	}
+}
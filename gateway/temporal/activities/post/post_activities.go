@@ -1,10 +1,12 @@
 package post
 
 import (
+	"net/http"
 	"time"
 
 	commentpb "voidspaceGateway/proto/generated/comments/v1"
 	postpb "voidspaceGateway/proto/generated/posts/v1"
+	userpb "voidspaceGateway/proto/generated/users/v1"
 
 	"go.uber.org/zap"
 )
@@ -13,19 +15,30 @@ type PostActivities struct {
 	ContextTimeout time.Duration
 	Logger         *zap.Logger
 	PostClient     postpb.PostServiceClient
+	UserClient     userpb.UserServiceClient
 	CommentClient  commentpb.CommentServiceClient
+	HTTPClient     *http.Client
+	// CelebrityFollowers is the follower count from which posts are no
+	// longer fanned out
+	CelebrityFollowers int
 }
 
 func NewPostActivities(
 	contextTimeout time.Duration,
 	logger *zap.Logger,
 	postClient postpb.PostServiceClient,
+	userClient userpb.UserServiceClient,
 	commentClient commentpb.CommentServiceClient,
+	httpClient *http.Client,
+	celebrityFollowers int,
 ) *PostActivities {
 	return &PostActivities{
-		ContextTimeout: contextTimeout,
-		Logger:         logger,
-		PostClient:     postClient,
-		CommentClient:  commentClient,
+		ContextTimeout:     contextTimeout,
+		Logger:             logger,
+		PostClient:         postClient,
+		UserClient:         userClient,
+		CommentClient:      commentClient,
+		HTTPClient:         httpClient,
+		CelebrityFollowers: celebrityFollowers,
 	}
 }
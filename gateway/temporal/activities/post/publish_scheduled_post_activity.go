@@ -0,0 +1,54 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	shared_constants "github.com/vhysxl/voidspace/shared/utils/constants"
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const PublishScheduledPostActivity = "PublishScheduledPostActivity"
+
+// PublishScheduledPostActivity publishes the post and returns what its link
+// preview and fan-out need.
+func (pa *PostActivities) PublishScheduledPostActivity(
+	ctx context.Context,
+	req temporal_dto.PublishScheduledPostReq,
+) (*temporal_dto.PublishScheduledPostRes, error) {
+	pa.Logger.Info("Starting Publish Scheduled Post Activity", zap.Int64("scheduledPostID", req.ScheduledPostID))
+
+	post, err := pa.PostClient.PublishScheduledPost(ctx, &postpb.ScheduledPostRequest{
+		ScheduledPostId: req.ScheduledPostID,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.PublishScheduledPost", zap.Error(err))
+		st, ok := status.FromError(err)
+		if !ok {
+			return nil, err
+		}
+		// the scheduled post was cancelled, the workflow is done
+		if st.Code() == codes.NotFound && st.Message() == shared_constants.ErrScheduledPostNotFound.Error() {
+			return nil, temporal.NewNonRetryableApplicationError(st.Message(), temporal_constants.ScheduledPostNotFoundError, err)
+		}
+		// the post can no longer be published, retrying will not change that
+		switch st.Code() {
+		case codes.NotFound, codes.InvalidArgument:
+			return nil, temporal.NewNonRetryableApplicationError(st.Message(), st.Code().String(), err)
+		}
+		return nil, temporal.NewApplicationErrorWithCause(st.Message(), st.Code().String(), err)
+	}
+
+	return &temporal_dto.PublishScheduledPostRes{
+		PostID: post.GetId(),
+		UserID: post.GetUserId(),
+		URL:    utils.FirstLinkURL(post.GetEntities()),
+	}, nil
+}
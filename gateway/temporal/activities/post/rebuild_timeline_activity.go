@@ -0,0 +1,62 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.uber.org/zap"
+)
+
+const (
+	RebuildTimelineActivity = "RebuildTimelineActivity"
+
+	// rebuildBatchSize is how many followed users one
+	// PostService.BackfillTimeline call backfills
+	rebuildBatchSize = 100
+)
+
+// RebuildTimelineActivity backfills the home timeline of req.UserID from
+// every user they follow, the last batch marks the timeline as built so it
+// is read from then on.
+func (pa *PostActivities) RebuildTimelineActivity(
+	ctx context.Context,
+	req temporal_dto.RebuildTimelineReq,
+) error {
+	pa.Logger.Info("Starting Rebuild Timeline Activity", zap.Int64("userID", req.UserID))
+
+	following, err := pa.UserClient.ListFollowing(ctx, &userpb.GetUserByIdRequest{
+		UserId: req.UserID,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call UserService.ListFollowing", zap.Error(err))
+		return timelineActivityError(err)
+	}
+
+	followedIDs := make([]int64, 0, len(following.GetUsers()))
+	for _, user := range following.GetUsers() {
+		followedIDs = append(followedIDs, user.GetId())
+	}
+
+	// runs once with no authors when the user follows no one, to mark the
+	// timeline as built
+	for start := 0; ; start += rebuildBatchSize {
+		end := min(start+rebuildBatchSize, len(followedIDs))
+
+		_, err = pa.PostClient.BackfillTimeline(ctx, &postpb.BackfillTimelineRequest{
+			UserId:    req.UserID,
+			AuthorIds: followedIDs[start:end],
+			MarkBuilt: end == len(followedIDs),
+		})
+		if err != nil {
+			pa.Logger.Error("failed to call PostService.BackfillTimeline", zap.Error(err))
+			return timelineActivityError(err)
+		}
+
+		if end == len(followedIDs) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package post
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const RefreshTrendingHashtagsActivity = "RefreshTrendingHashtagsActivity"
+
+func (pa *PostActivities) RefreshTrendingHashtagsActivity(ctx context.Context) error {
+	_, err := pa.PostClient.RefreshTrendingHashtags(ctx, &emptypb.Empty{})
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.RefreshTrendingHashtags", zap.Error(err))
+		st, ok := status.FromError(err)
+		if ok {
+			return temporal.NewApplicationErrorWithCause(st.Message(), st.Code().String(), err)
+		}
+		return err
+	}
+
+	return nil
+}
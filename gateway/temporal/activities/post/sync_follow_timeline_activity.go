@@ -0,0 +1,49 @@
+package post
+
+import (
+	"context"
+
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.uber.org/zap"
+)
+
+const SyncFollowTimelineActivity = "SyncFollowTimelineActivity"
+
+// SyncFollowTimelineActivity backfills the home timeline of req.UserID with
+// the recent posts of a newly followed user, or clears what an unfollowed
+// user brought into it.
+func (pa *PostActivities) SyncFollowTimelineActivity(
+	ctx context.Context,
+	req temporal_dto.SyncFollowTimelineReq,
+) error {
+	pa.Logger.Info(
+		"Starting Sync Follow Timeline Activity",
+		zap.Int64("userID", req.UserID),
+		zap.Int64("targetID", req.TargetID),
+		zap.Bool("following", req.Following))
+
+	if req.Following {
+		_, err := pa.PostClient.BackfillTimeline(ctx, &postpb.BackfillTimelineRequest{
+			UserId:    req.UserID,
+			AuthorIds: []int64{req.TargetID},
+		})
+		if err != nil {
+			pa.Logger.Error("failed to call PostService.BackfillTimeline", zap.Error(err))
+			return timelineActivityError(err)
+		}
+		return nil
+	}
+
+	_, err := pa.PostClient.ClearTimelineSource(ctx, &postpb.ClearTimelineSourceRequest{
+		UserId:   req.UserID,
+		SourceId: req.TargetID,
+	})
+	if err != nil {
+		pa.Logger.Error("failed to call PostService.ClearTimelineSource", zap.Error(err))
+		return timelineActivityError(err)
+	}
+
+	return nil
+}
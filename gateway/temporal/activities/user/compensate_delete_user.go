@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"voidspaceGateway/internal/models"
 	userpb "voidspaceGateway/proto/generated/users/v1"
 	temporal_dto "voidspaceGateway/temporal/dto"
 	"voidspaceGateway/utils"
@@ -22,7 +23,10 @@ func (ua *UserActivities) DeleteUserCompensateActivity(
 		zap.String("userID", req.UserID),
 	)
 
-	md := utils.MetaDataHandler(req.UserID, req.Username)
+	md := metadata.Join(
+		utils.MetaDataHandler(req.UserID, req.Username),
+		utils.ClientMetaDataHandler(models.ClientInfo{IPAddress: req.IPAddress, UserAgent: req.UserAgent}),
+	)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	_, err := ua.UserClient.RestoreUser(ctx, &userpb.RestoreUserRequest{
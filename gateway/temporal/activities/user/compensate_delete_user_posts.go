@@ -9,14 +9,18 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const DeleteUserPostsCompensateActivity = "DeleteUserPostsCompensateActivity"
 
+// DeleteUserPostsCompensateActivity restores the user's posts and returns
+// their scheduled posts, whose publish workflows may have given up while the
+// account was deleted.
 func (ua *UserActivities) DeleteUserPostsCompensateActivity(
 	ctx context.Context,
 	req temporal_dto.DeleteUserReq,
-) error {
+) ([]temporal_dto.PublishScheduledPostWorkflowParam, error) {
 	ua.Logger.Info(
 		"Starting Delete User's Posts Activity",
 		zap.String("userID", req.UserID),
@@ -30,9 +34,23 @@ func (ua *UserActivities) DeleteUserPostsCompensateActivity(
 	})
 	if err != nil {
 		ua.Logger.Error("Failed to compensate user's posts", zap.Error(err))
-		return err
+		return nil, err
+	}
+
+	res, err := ua.PostClient.ListScheduledPosts(ctx, &emptypb.Empty{})
+	if err != nil {
+		ua.Logger.Error("failed to call PostService.ListScheduledPosts", zap.Error(err))
+		return nil, err
+	}
+
+	scheduled := make([]temporal_dto.PublishScheduledPostWorkflowParam, 0, len(res.GetPosts()))
+	for _, post := range res.GetPosts() {
+		scheduled = append(scheduled, temporal_dto.PublishScheduledPostWorkflowParam{
+			ScheduledPostID: post.GetId(),
+			PublishAt:       post.GetPublishAt().AsTime(),
+		})
 	}
 
 	ua.Logger.Info("User's posts compensated successfully")
-	return nil
+	return scheduled, nil
 }
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"voidspaceGateway/internal/models"
 	temporal_dto "voidspaceGateway/temporal/dto"
 	"voidspaceGateway/utils"
 
@@ -21,7 +22,10 @@ func (ua *UserActivities) DeleteUserActivity(
 		zap.String("userID", req.UserID),
 		zap.String("username", req.Username))
 
-	md := utils.MetaDataHandler(req.UserID, req.Username)
+	md := metadata.Join(
+		utils.MetaDataHandler(req.UserID, req.Username),
+		utils.ClientMetaDataHandler(models.ClientInfo{IPAddress: req.IPAddress, UserAgent: req.UserAgent}),
+	)
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	_, err := ua.UserClient.DeleteUser(ctx, &emptypb.Empty{})
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"net/http"
 	"time"
 
 	commentpb "voidspaceGateway/proto/generated/comments/v1"
@@ -16,6 +17,7 @@ type UserActivities struct {
 	UserClient     userpb.UserServiceClient
 	PostClient     postpb.PostServiceClient
 	CommentClient  commentpb.CommentServiceClient
+	HTTPClient     *http.Client
 }
 
 func NewUserActivities(
@@ -24,6 +26,7 @@ func NewUserActivities(
 	userClient userpb.UserServiceClient,
 	postClient postpb.PostServiceClient,
 	commentClient commentpb.CommentServiceClient,
+	httpClient *http.Client,
 ) *UserActivities {
 	return &UserActivities{
 		ContextTimeout: contextTimeout,
@@ -31,5 +34,6 @@ func NewUserActivities(
 		UserClient:     userClient,
 		PostClient:     postClient,
 		CommentClient:  commentClient,
+		HTTPClient:     httpClient,
 	}
 }
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const VerifyProfileLinkActivity = "VerifyProfileLinkActivity"
+
+func (ua *UserActivities) VerifyProfileLinkActivity(
+	ctx context.Context,
+	req temporal_dto.VerifyProfileLinkReq,
+) (bool, error) {
+	ua.Logger.Info(
+		"Starting Verify Profile Link Activity",
+		zap.String("userID", req.UserID),
+		zap.String("link", req.LinkURL))
+
+	verified, err := ua.fetchRelMe(ctx, req.LinkURL, req.ProfileURL)
+	if err != nil {
+		// unreachable or malformed pages simply stay unverified
+		ua.Logger.Warn("failed to fetch profile link", zap.String("link", req.LinkURL), zap.Error(err))
+		verified = false
+	}
+
+	ctx = metadata.NewOutgoingContext(ctx, utils.MetaDataHandler(req.UserID, req.Username))
+
+	_, err = ua.UserClient.SetProfileLinkVerified(ctx, &userpb.SetProfileLinkVerifiedRequest{
+		Url:      req.LinkURL,
+		Verified: verified,
+	})
+	if status.Code(err) == codes.NotFound {
+		// the link was removed while it was fetched, retrying won't bring it back
+		ua.Logger.Info("profile link removed before it was verified", zap.String("link", req.LinkURL))
+		return false, nil
+	}
+	if err != nil {
+		ua.Logger.Error("failed to call UserService.SetProfileLinkVerified", zap.Error(err))
+		return false, err
+	}
+
+	return verified, nil
+}
+
+func (ua *UserActivities) fetchRelMe(ctx context.Context, linkURL, profileURL string) (bool, error) {
+	parsed, err := url.Parse(linkURL)
+	if err != nil {
+		return false, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return false, fmt.Errorf("unsupported scheme %q", parsed.Scheme)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, utils.RelMeFetchTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	httpReq.Header.Set("Accept", "text/html")
+
+	res, err := ua.HTTPClient.Do(httpReq)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	return utils.HasRelMeLink(io.LimitReader(res.Body, utils.RelMeMaxBodySize), profileURL)
+}
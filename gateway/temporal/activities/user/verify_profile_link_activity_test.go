@@ -0,0 +1,146 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+	temporal_dto "voidspaceGateway/temporal/dto"
+	"voidspaceGateway/utils"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeUserClient struct {
+	userpb.UserServiceClient
+	calls []*userpb.SetProfileLinkVerifiedRequest
+	err   error
+}
+
+func (f *fakeUserClient) SetProfileLinkVerified(
+	ctx context.Context,
+	in *userpb.SetProfileLinkVerifiedRequest,
+	opts ...grpc.CallOption,
+) (*userpb.SetProfileLinkVerifiedResponse, error) {
+	f.calls = append(f.calls, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &userpb.SetProfileLinkVerifiedResponse{}, nil
+}
+
+const testProfileURL = "https://voidspace.example/profile/alice"
+
+func TestVerifyProfileLinkActivity(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/anchor", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><body><a rel="nofollow me" href="https://voidspace.example/profile/alice/">me</a></body></html>`))
+	})
+	mux.HandleFunc("/head-link", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><link rel="me" href="http://VOIDSPACE.example/profile/alice"/></head></html>`))
+	})
+	mux.HandleFunc("/no-rel", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<a href="https://voidspace.example/profile/alice">me</a>`))
+	})
+	mux.HandleFunc("/other-user", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<a rel="me" href="https://voidspace.example/profile/mallory">me</a>`))
+	})
+	mux.HandleFunc("/missing", http.NotFound)
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/redirect", http.StatusFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		link     string
+		client   *http.Client
+		verified bool
+	}{
+		{"anchor with rel me", server.URL + "/anchor", nil, true},
+		{"link element in head", server.URL + "/head-link", nil, true},
+		{"anchor without rel me", server.URL + "/no-rel", nil, false},
+		{"rel me to another profile", server.URL + "/other-user", nil, false},
+		{"not found", server.URL + "/missing", nil, false},
+		{"redirect loop", server.URL + "/redirect", nil, false},
+		{"unsupported scheme", "ftp://example.com/", nil, false},
+		{"loopback is blocked", server.URL + "/anchor", utils.NewRelMeHTTPClient(false), false},
+		{"link local is blocked", "http://169.254.169.254/latest/meta-data/", utils.NewRelMeHTTPClient(false), false},
+		{"private range is blocked", "http://10.0.0.1/", utils.NewRelMeHTTPClient(false), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := tt.client
+			if httpClient == nil {
+				httpClient = utils.NewRelMeHTTPClient(true)
+			}
+
+			client := &fakeUserClient{}
+			ua := NewUserActivities(time.Second, zap.NewNop(), client, nil, nil, httpClient)
+
+			verified, err := ua.VerifyProfileLinkActivity(context.Background(), temporal_dto.VerifyProfileLinkReq{
+				UserID:     "1",
+				Username:   "alice",
+				ProfileURL: testProfileURL,
+				LinkURL:    tt.link,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if verified != tt.verified {
+				t.Fatalf("verified = %v, want %v", verified, tt.verified)
+			}
+
+			if len(client.calls) != 1 {
+				t.Fatalf("expected 1 SetProfileLinkVerified call, got %d", len(client.calls))
+			}
+			if client.calls[0].GetUrl() != tt.link || client.calls[0].GetVerified() != tt.verified {
+				t.Fatalf("unexpected request: %+v", client.calls[0])
+			}
+		})
+	}
+}
+
+func TestVerifyProfileLinkActivityLinkRemoved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<a rel="me" href="https://voidspace.example/profile/alice">me</a>`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{"removed while fetched", status.Error(codes.NotFound, "profile link not found"), false},
+		{"users service down", status.Error(codes.Unavailable, "unavailable"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeUserClient{err: tt.err}
+			ua := NewUserActivities(time.Second, zap.NewNop(), client, nil, nil, utils.NewRelMeHTTPClient(true))
+
+			verified, err := ua.VerifyProfileLinkActivity(context.Background(), temporal_dto.VerifyProfileLinkReq{
+				UserID:     "1",
+				Username:   "alice",
+				ProfileURL: testProfileURL,
+				LinkURL:    server.URL,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if verified {
+				t.Fatal("a link that was not stored must not be reported verified")
+			}
+		})
+	}
+}
@@ -3,4 +3,32 @@ package temporal_constants
 const (
 	DeleteUserWorkflowName = "DeleteUserWorkflow"
 	DeletePostWorkflowName = "DeletePostWorkflow"
+
+	VerifyProfileLinksWorkflowName = "VerifyProfileLinksWorkflow"
+
+	PublishScheduledPostWorkflowName = "PublishScheduledPostWorkflow"
+	// PublishScheduledPostWorkflowIDFormat takes the scheduled post id
+	PublishScheduledPostWorkflowIDFormat = "publish-scheduled-post-%d"
+	// ReschedulePostSignal carries a new publish time to a waiting
+	// PublishScheduledPostWorkflow
+	ReschedulePostSignal = "reschedule-post"
+	// ScheduledPostNotFoundError is the application error type of a publish
+	// whose scheduled post was cancelled, any other failure is not a cancel
+	ScheduledPostNotFoundError = "ScheduledPostNotFound"
+
+	ClosePollWorkflowName = "ClosePollWorkflow"
+
+	FetchLinkPreviewWorkflowName = "FetchLinkPreviewWorkflow"
+
+	FanOutPostWorkflowName         = "FanOutPostWorkflow"
+	SyncFollowTimelineWorkflowName = "SyncFollowTimelineWorkflow"
+	RebuildTimelineWorkflowName    = "RebuildTimelineWorkflow"
+
+	RefreshTrendingHashtagsWorkflowName = "RefreshTrendingHashtagsWorkflow"
+	RefreshTrendingHashtagsWorkflowID   = "refresh-trending-hashtags"
+	RefreshTrendingHashtagsCron         = "*/5 * * * *"
+
+	ReconcileLikesCountWorkflowName = "ReconcileLikesCountWorkflow"
+	ReconcileLikesCountWorkflowID   = "reconcile-likes-count"
+	ReconcileLikesCountCron         = "0 4 * * *"
 )
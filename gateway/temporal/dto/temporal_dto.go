@@ -1,8 +1,12 @@
 package temporal_dto
 
+import "time"
+
 type DeleteUserWorkflowParam struct {
-	UserID   string
-	Username string
+	UserID    string
+	Username  string
+	IPAddress string
+	UserAgent string
 }
 
 type DeleteUserWorkflowResult struct {
@@ -14,6 +18,8 @@ type DeleteUserReq struct {
 	UserIDInt int
 	UserID    string
 	Username  string
+	IPAddress string
+	UserAgent string
 }
 
 // ===================================== Delete Post DTOs =====================================
@@ -32,3 +38,97 @@ type DeletePostReq struct {
 	Username string
 	UserID   string
 }
+
+// ===================================== Scheduled Post DTOs =====================================
+type PublishScheduledPostWorkflowParam struct {
+	ScheduledPostID int64
+	PublishAt       time.Time
+}
+
+type PublishScheduledPostReq struct {
+	ScheduledPostID int64
+}
+
+// PublishScheduledPostRes is the published post, URL is its first link and
+// empty when it has none.
+type PublishScheduledPostRes struct {
+	PostID int64
+	UserID int64
+	URL    string
+}
+
+// ===================================== Poll DTOs =====================================
+type ClosePollWorkflowParam struct {
+	PostID int64
+	EndsAt time.Time
+}
+
+type ClosePollReq struct {
+	PostID int64
+}
+
+type NotifyPollVotersReq struct {
+	PostID int64
+}
+
+// ===================================== Link Preview DTOs =====================================
+type FetchLinkPreviewWorkflowParam struct {
+	PostID int64
+	URL    string
+}
+
+type FetchLinkPreviewReq struct {
+	PostID int64
+	URL    string
+}
+
+// ===================================== Home Timeline DTOs =====================================
+// SourceID is the author of the post, or its reposter when Repost is set.
+type FanOutPostWorkflowParam struct {
+	PostID   int64
+	SourceID int64
+	Repost   bool
+}
+
+type FanOutPostReq struct {
+	PostID   int64
+	SourceID int64
+	Repost   bool
+}
+
+// Following is the follow state of UserID towards TargetID once the follow
+// or unfollow went through.
+type SyncFollowTimelineWorkflowParam struct {
+	UserID    int64
+	TargetID  int64
+	Following bool
+}
+
+type SyncFollowTimelineReq struct {
+	UserID    int64
+	TargetID  int64
+	Following bool
+}
+
+type RebuildTimelineWorkflowParam struct {
+	UserID int64
+}
+
+type RebuildTimelineReq struct {
+	UserID int64
+}
+
+// ===================================== Verify Profile Links DTOs =====================================
+type VerifyProfileLinksWorkflowParam struct {
+	UserID     string
+	Username   string
+	ProfileURL string
+	Links      []string
+}
+
+type VerifyProfileLinkReq struct {
+	UserID     string
+	Username   string
+	ProfileURL string
+	LinkURL    string
+}
@@ -0,0 +1,62 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"voidspaceGateway/bootstrap"
+	temporal_constants "voidspaceGateway/temporal/constants"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+// schedule is a cron workflow started on boot.
+type schedule struct {
+	name         string
+	workflowID   string
+	workflowName string
+	cron         string
+}
+
+var schedules = []schedule{
+	{
+		name:         "trending hashtags",
+		workflowID:   temporal_constants.RefreshTrendingHashtagsWorkflowID,
+		workflowName: temporal_constants.RefreshTrendingHashtagsWorkflowName,
+		cron:         temporal_constants.RefreshTrendingHashtagsCron,
+	},
+	{
+		name:         "likes count reconciliation",
+		workflowID:   temporal_constants.ReconcileLikesCountWorkflowID,
+		workflowName: temporal_constants.ReconcileLikesCountWorkflowName,
+		cron:         temporal_constants.ReconcileLikesCountCron,
+	},
+}
+
+// StartSchedules starts the cron workflows. Every gateway instance calls it on
+// boot, the fixed workflow IDs keep a single run of each schedule. A schedule
+// that fails to start is logged and doesn't keep the others from starting,
+// the returned error joins every failure.
+func StartSchedules(app *bootstrap.Application) error {
+	var errs []error
+
+	for _, s := range schedules {
+		_, err := app.TemporalService.Client.ExecuteWorkflow(
+			context.Background(),
+			client.StartWorkflowOptions{
+				ID:                       s.workflowID,
+				TaskQueue:                app.TemporalService.Service,
+				CronSchedule:             s.cron,
+				WorkflowIDConflictPolicy: enums.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+			},
+			s.workflowName,
+		)
+		if err != nil {
+			app.Logger.Error("failed to start schedule", zap.String("schedule", s.name), zap.Error(err))
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
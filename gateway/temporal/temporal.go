@@ -6,6 +6,7 @@ import (
 	post_activities "voidspaceGateway/temporal/activities/post"
 	user_activities "voidspaceGateway/temporal/activities/user"
 	workflow "voidspaceGateway/temporal/workflows"
+	"voidspaceGateway/utils"
 )
 
 func RegisterTemporal(app *bootstrap.Application) {
@@ -15,13 +16,17 @@ func RegisterTemporal(app *bootstrap.Application) {
 		app.UserService.UserClient,
 		app.PostService.PostClient,
 		app.CommentService.CommentClient,
+		utils.NewRelMeHTTPClient(false),
 	)
 
 	postActivities := post_activities.NewPostActivities(
 		app.ContextTimeout,
 		app.Logger,
 		app.PostService.PostClient,
+		app.UserService.UserClient,
 		app.CommentService.CommentClient,
+		utils.NewLinkPreviewHTTPClient(false),
+		app.Config.CelebrityFollowers,
 	)
 
 	// registers
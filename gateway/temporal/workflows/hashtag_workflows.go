@@ -0,0 +1,26 @@
+package workflow
+
+import (
+	"time"
+	"voidspaceGateway/temporal/activities/post"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// RefreshTrendingHashtagsWorkflow rebuilds the trending hashtags snapshot, it
+// runs on a cron schedule started by StartSchedules.
+func RefreshTrendingHashtagsWorkflow(ctx workflow.Context) error {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Second,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	return workflow.ExecuteActivity(ctx, post.RefreshTrendingHashtagsActivity).Get(ctx, nil)
+}
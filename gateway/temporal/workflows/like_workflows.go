@@ -0,0 +1,27 @@
+package workflow
+
+import (
+	"time"
+	"voidspaceGateway/temporal/activities/post"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ReconcileLikesCountWorkflow recounts posts.likes_count from the likes, it
+// runs on a cron schedule started by StartSchedules.
+func ReconcileLikesCountWorkflow(ctx workflow.Context) error {
+	ao := workflow.ActivityOptions{
+		// the whole posts table is walked in batches
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	return workflow.ExecuteActivity(ctx, post.ReconcileLikesCountActivity).Get(ctx, nil)
+}
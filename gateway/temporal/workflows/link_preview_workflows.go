@@ -0,0 +1,30 @@
+package workflow
+
+import (
+	"time"
+	"voidspaceGateway/temporal/activities/post"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// FetchLinkPreviewWorkflow unfurls the first link of a new post. Retries only
+// cover the posts service, a page that fails to load is not fetched again.
+func FetchLinkPreviewWorkflow(ctx workflow.Context, param temporal_dto.FetchLinkPreviewWorkflowParam) error {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Second,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	return workflow.ExecuteActivity(ctx, post.FetchLinkPreviewActivity, temporal_dto.FetchLinkPreviewReq{
+		PostID: param.PostID,
+		URL:    param.URL,
+	}).Get(ctx, nil)
+}
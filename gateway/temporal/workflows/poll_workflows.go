@@ -0,0 +1,65 @@
+package workflow
+
+import (
+	"errors"
+	"time"
+	"voidspaceGateway/temporal/activities/post"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// notifyPollVotersActivityOptions leave room for paging through a large poll,
+// the heartbeat carries the last voter handled across retries.
+var notifyPollVotersActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: 10 * time.Minute,
+	HeartbeatTimeout:    30 * time.Second,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+		MaximumAttempts:    5,
+	},
+}
+
+// ClosePollWorkflow sleeps on a durable timer until the poll ends, closes it
+// and hands its voters to NotifyPollVotersActivity, which does not deliver
+// anything yet.
+func ClosePollWorkflow(ctx workflow.Context, param temporal_dto.ClosePollWorkflowParam) error {
+	// a run started after the poll ended closes it right away
+	err := workflow.Sleep(ctx, max(param.EndsAt.Sub(workflow.Now(ctx)), 0))
+	if err != nil {
+		return err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	err = workflow.ExecuteActivity(ctx, post.ClosePollActivity, temporal_dto.ClosePollReq{
+		PostID: param.PostID,
+	}).Get(ctx, nil)
+
+	// the post was deleted before the poll ended, nobody to notify
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() == "NotFound" {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, notifyPollVotersActivityOptions),
+		post.NotifyPollVotersActivity,
+		temporal_dto.NotifyPollVotersReq{PostID: param.PostID},
+	).Get(ctx, nil)
+}
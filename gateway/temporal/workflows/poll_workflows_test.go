@@ -0,0 +1,43 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+	post_activities "voidspaceGateway/temporal/activities/post"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestClosePollWorkflowAfterEndsAt(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+
+	var calls []string
+	env.RegisterActivityWithOptions(func(ctx context.Context, req temporal_dto.ClosePollReq) error {
+		calls = append(calls, post_activities.ClosePollActivity)
+		return nil
+	}, activity.RegisterOptions{Name: post_activities.ClosePollActivity})
+	env.RegisterActivityWithOptions(func(ctx context.Context, req temporal_dto.NotifyPollVotersReq) error {
+		calls = append(calls, post_activities.NotifyPollVotersActivity)
+		return nil
+	}, activity.RegisterOptions{Name: post_activities.NotifyPollVotersActivity})
+
+	// the worker was down when the poll ended
+	env.ExecuteWorkflow(ClosePollWorkflow, temporal_dto.ClosePollWorkflowParam{
+		PostID: 7,
+		EndsAt: env.Now().Add(-time.Hour),
+	})
+
+	if !env.IsWorkflowCompleted() {
+		t.Fatal("workflow did not complete")
+	}
+	if err := env.GetWorkflowError(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(calls) != 2 || calls[0] != post_activities.ClosePollActivity || calls[1] != post_activities.NotifyPollVotersActivity {
+		t.Fatalf("calls = %v, want close then notify", calls)
+	}
+}
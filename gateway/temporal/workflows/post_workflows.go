@@ -25,6 +25,8 @@ func DeletePostWorkflow(ctx workflow.Context, param temporal_dto.DeletePostWorkf
 
 	actParam := temporal_dto.DeletePostReq(param)
 	// 1. Delete Post (Hard Delete)
+	// likes, reposts, bookmarks, pins and the poll are removed with it by
+	// cascade
 	errPost := workflow.ExecuteActivity(ctx, post.DeletePostActivity, actParam).Get(ctx, nil)
 	if errPost != nil {
 		// If post deletion fails, fail the workflow
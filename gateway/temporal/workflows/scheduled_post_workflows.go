@@ -0,0 +1,105 @@
+package workflow
+
+import (
+	"errors"
+	"time"
+	"voidspaceGateway/temporal/activities/post"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// PublishScheduledPostWorkflow sleeps on a durable timer until the post's
+// publish time and then publishes it. A ReschedulePostSignal restarts the
+// timer with the new time, cancelling the workflow drops the publish.
+func PublishScheduledPostWorkflow(ctx workflow.Context, param temporal_dto.PublishScheduledPostWorkflowParam) error {
+	rescheduleCh := workflow.GetSignalChannel(ctx, temporal_constants.ReschedulePostSignal)
+	publishAt := param.PublishAt
+
+	for {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		// a run started after publishAt, e.g. on account restore, publishes
+		// right away
+		timer := workflow.NewTimer(timerCtx, max(publishAt.Sub(workflow.Now(ctx)), 0))
+
+		var timerErr error
+		rescheduled := false
+
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(timer, func(f workflow.Future) {
+			timerErr = f.Get(ctx, nil)
+		})
+		selector.AddReceive(rescheduleCh, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, &publishAt)
+			rescheduled = true
+		})
+		selector.Select(ctx)
+		cancelTimer()
+
+		if rescheduled {
+			continue
+		}
+
+		if temporal.IsCanceledError(timerErr) {
+			// the workflow itself was cancelled, the post is dropped
+			return timerErr
+		}
+		if timerErr != nil {
+			return temporal.NewApplicationErrorWithCause("publish timer failed", "TimerError", timerErr)
+		}
+		break
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var published *temporal_dto.PublishScheduledPostRes
+	err := workflow.ExecuteActivity(ctx, post.PublishScheduledPostActivity, temporal_dto.PublishScheduledPostReq{
+		ScheduledPostID: param.ScheduledPostID,
+	}).Get(ctx, &published)
+
+	// a scheduled post cancelled just before the timer fired is not an error,
+	// a reply or quote whose target is gone is
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() == temporal_constants.ScheduledPostNotFoundError {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the post is out, a missing preview must not fail the publish
+	if published.URL != "" {
+		err = workflow.ExecuteActivity(ctx, post.FetchLinkPreviewActivity, temporal_dto.FetchLinkPreviewReq{
+			PostID: published.PostID,
+			URL:    published.URL,
+		}).Get(ctx, nil)
+		if err != nil {
+			workflow.GetLogger(ctx).Error("fetch link preview failed", "error", err)
+		}
+	}
+
+	err = workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, timelineActivityOptions),
+		post.FanOutPostActivity,
+		temporal_dto.FanOutPostReq{
+			PostID:   published.PostID,
+			SourceID: published.UserID,
+		},
+	).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("fan out post failed", "error", err)
+	}
+
+	return nil
+}
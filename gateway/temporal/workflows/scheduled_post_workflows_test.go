@@ -0,0 +1,52 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+	post_activities "voidspaceGateway/temporal/activities/post"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestPublishScheduledPostWorkflowPublishFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		errType string
+		wantErr bool
+	}{
+		{"cancelled before the timer fired", temporal_constants.ScheduledPostNotFoundError, false},
+		{"reply target deleted", "NotFound", true},
+		{"content rejected", "InvalidArgument", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var suite testsuite.WorkflowTestSuite
+			env := suite.NewTestWorkflowEnvironment()
+
+			env.RegisterActivityWithOptions(
+				func(ctx context.Context, req temporal_dto.PublishScheduledPostReq) (*temporal_dto.PublishScheduledPostRes, error) {
+					return nil, temporal.NewNonRetryableApplicationError("publish failed", tt.errType, nil)
+				},
+				activity.RegisterOptions{Name: post_activities.PublishScheduledPostActivity},
+			)
+
+			env.ExecuteWorkflow(PublishScheduledPostWorkflow, temporal_dto.PublishScheduledPostWorkflowParam{
+				ScheduledPostID: 7,
+				PublishAt:       env.Now().Add(time.Hour),
+			})
+
+			if !env.IsWorkflowCompleted() {
+				t.Fatal("workflow did not complete")
+			}
+			if gotErr := env.GetWorkflowError() != nil; gotErr != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", env.GetWorkflowError(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package workflow
+
+import (
+	"time"
+	"voidspaceGateway/temporal/activities/post"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// timelineActivityOptions fit a fan-out to just under the celebrity
+// threshold, one posts service call per thousand followers.
+var timelineActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: 2 * time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+		MaximumAttempts:    5,
+	},
+}
+
+// FanOutPostWorkflow writes a new post or repost into the home timelines of
+// the followers of its source.
+func FanOutPostWorkflow(ctx workflow.Context, param temporal_dto.FanOutPostWorkflowParam) error {
+	ctx = workflow.WithActivityOptions(ctx, timelineActivityOptions)
+
+	return workflow.ExecuteActivity(ctx, post.FanOutPostActivity, temporal_dto.FanOutPostReq{
+		PostID:   param.PostID,
+		SourceID: param.SourceID,
+		Repost:   param.Repost,
+	}).Get(ctx, nil)
+}
+
+// SyncFollowTimelineWorkflow brings a home timeline in step with a follow or
+// unfollow. Its ID is per pair of users and a newer run terminates an older
+// one, so the last change wins.
+func SyncFollowTimelineWorkflow(ctx workflow.Context, param temporal_dto.SyncFollowTimelineWorkflowParam) error {
+	ctx = workflow.WithActivityOptions(ctx, timelineActivityOptions)
+
+	return workflow.ExecuteActivity(ctx, post.SyncFollowTimelineActivity, temporal_dto.SyncFollowTimelineReq{
+		UserID:    param.UserID,
+		TargetID:  param.TargetID,
+		Following: param.Following,
+	}).Get(ctx, nil)
+}
+
+// RebuildTimelineWorkflow builds a home timeline from the user's follows,
+// it runs the first time the timeline is read.
+func RebuildTimelineWorkflow(ctx workflow.Context, param temporal_dto.RebuildTimelineWorkflowParam) error {
+	ctx = workflow.WithActivityOptions(ctx, timelineActivityOptions)
+
+	return workflow.ExecuteActivity(ctx, post.RebuildTimelineActivity, temporal_dto.RebuildTimelineReq{
+		UserID: param.UserID,
+	}).Get(ctx, nil)
+}
@@ -1,11 +1,15 @@
 package workflow
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 	user_activities "voidspaceGateway/temporal/activities/user"
+	temporal_constants "voidspaceGateway/temporal/constants"
 	temporal_dto "voidspaceGateway/temporal/dto"
 
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
@@ -36,6 +40,8 @@ func DeleteUserWorkflow(
 		UserID:    param.UserID,
 		Username:  param.Username,
 		UserIDInt: userIDInt,
+		IPAddress: param.IPAddress,
+		UserAgent: param.UserAgent,
 	}
 
 	// ── 1. Jalankan semua PARALEL ────────────────────────────
@@ -64,6 +70,7 @@ func DeleteUserWorkflow(
 	// ── 4. Ada yang gagal → compensate yang SUKSES ──────────
 	// Paralel juga, tapi HARUS di-await sebelum return
 	var compensateFutures []workflow.Future
+	var postsCompensate workflow.Future
 
 	if errUser == nil {
 		f := workflow.ExecuteActivity(ctx, user_activities.DeleteUserCompensateActivity, actParam)
@@ -74,8 +81,8 @@ func DeleteUserWorkflow(
 		compensateFutures = append(compensateFutures, f)
 	}
 	if errPosts == nil {
-		f := workflow.ExecuteActivity(ctx, user_activities.DeleteUserPostsCompensateActivity, actParam)
-		compensateFutures = append(compensateFutures, f)
+		postsCompensate = workflow.ExecuteActivity(ctx, user_activities.DeleteUserPostsCompensateActivity, actParam)
+		compensateFutures = append(compensateFutures, postsCompensate)
 	}
 
 	// ── 5. Tunggu SEMUA compensate selesai ──────────────────
@@ -86,7 +93,74 @@ func DeleteUserWorkflow(
 		}
 	}
 
+	if postsCompensate != nil {
+		restartScheduledPosts(ctx, postsCompensate)
+	}
+
 	// ── 6. Return error ke user ──────────────────────────────
 	return &temporal_dto.DeleteUserWorkflowResult{Success: false},
 		temporal.NewApplicationError("delete account failed, please try again", "DeleteUserError")
 }
+
+// restartScheduledPosts starts the publish workflow of every restored
+// scheduled post again. A post restored before its publish time still has its
+// workflow, one whose time has passed is published right away.
+func restartScheduledPosts(ctx workflow.Context, postsCompensate workflow.Future) {
+	var scheduled []temporal_dto.PublishScheduledPostWorkflowParam
+	if err := postsCompensate.Get(ctx, &scheduled); err != nil {
+		return
+	}
+
+	for _, param := range scheduled {
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:        fmt.Sprintf(temporal_constants.PublishScheduledPostWorkflowIDFormat, param.ScheduledPostID),
+			ParentClosePolicy: enums.PARENT_CLOSE_POLICY_ABANDON,
+		})
+
+		err := workflow.ExecuteChildWorkflow(childCtx, temporal_constants.PublishScheduledPostWorkflowName, param).
+			GetChildWorkflowExecution().Get(ctx, nil)
+
+		var started *temporal.ChildWorkflowExecutionAlreadyStartedError
+		if err != nil && !errors.As(err, &started) {
+			workflow.GetLogger(ctx).Error("restart scheduled post failed", "error", err)
+		}
+	}
+}
+
+func VerifyProfileLinksWorkflow(
+	ctx workflow.Context,
+	param temporal_dto.VerifyProfileLinksWorkflowParam,
+) error {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout:    30 * time.Second,
+		ScheduleToStartTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Second,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	futures := make([]workflow.Future, 0, len(param.Links))
+	for _, link := range param.Links {
+		futures = append(futures, workflow.ExecuteActivity(ctx,
+			user_activities.VerifyProfileLinkActivity,
+			temporal_dto.VerifyProfileLinkReq{
+				UserID:     param.UserID,
+				Username:   param.Username,
+				ProfileURL: param.ProfileURL,
+				LinkURL:    link,
+			}))
+	}
+
+	// one bad link must not stop the others from being checked
+	for _, f := range futures {
+		if err := f.Get(ctx, nil); err != nil {
+			workflow.GetLogger(ctx).Error("verify profile link failed", "error", err)
+		}
+	}
+
+	return nil
+}
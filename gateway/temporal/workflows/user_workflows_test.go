@@ -0,0 +1,71 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	post_activities "voidspaceGateway/temporal/activities/post"
+	user_activities "voidspaceGateway/temporal/activities/user"
+	temporal_constants "voidspaceGateway/temporal/constants"
+	temporal_dto "voidspaceGateway/temporal/dto"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestDeleteUserWorkflowRestartsScheduledPosts(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+
+	deleteStep := func(ctx context.Context, req temporal_dto.DeleteUserReq) error {
+		return nil
+	}
+	register := func(fn any, name string) {
+		env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+	}
+
+	register(func(ctx context.Context, req temporal_dto.DeleteUserReq) error {
+		return errors.New("users service is down")
+	}, user_activities.DeleteUserActivity)
+	register(deleteStep, user_activities.DeleteUserCommentsActivity)
+	register(deleteStep, user_activities.DeleteUserPostsActivity)
+	register(deleteStep, user_activities.DeleteUserCommentsCompensateActivity)
+
+	// the restore came after the post was due, its workflow already gave up
+	publishAt := env.Now().Add(-time.Hour)
+	register(func(ctx context.Context, req temporal_dto.DeleteUserReq) ([]temporal_dto.PublishScheduledPostWorkflowParam, error) {
+		return []temporal_dto.PublishScheduledPostWorkflowParam{
+			{ScheduledPostID: 7, PublishAt: publishAt},
+		}, nil
+	}, user_activities.DeleteUserPostsCompensateActivity)
+
+	var published []int64
+	register(func(ctx context.Context, req temporal_dto.PublishScheduledPostReq) (*temporal_dto.PublishScheduledPostRes, error) {
+		published = append(published, req.ScheduledPostID)
+		return &temporal_dto.PublishScheduledPostRes{PostID: 70, UserID: 1}, nil
+	}, post_activities.PublishScheduledPostActivity)
+	register(func(ctx context.Context, req temporal_dto.FanOutPostReq) error {
+		return nil
+	}, post_activities.FanOutPostActivity)
+
+	env.RegisterWorkflowWithOptions(PublishScheduledPostWorkflow, workflow.RegisterOptions{
+		Name: temporal_constants.PublishScheduledPostWorkflowName,
+	})
+
+	env.ExecuteWorkflow(DeleteUserWorkflow, temporal_dto.DeleteUserWorkflowParam{
+		UserID:   "1",
+		Username: "void",
+	})
+
+	if !env.IsWorkflowCompleted() {
+		t.Fatal("workflow did not complete")
+	}
+	if env.GetWorkflowError() == nil {
+		t.Fatal("expected the failed deletion to be reported")
+	}
+	if len(published) != 1 || published[0] != 7 {
+		t.Fatalf("published = %v, want [7]", published)
+	}
+}
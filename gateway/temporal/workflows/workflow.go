@@ -8,4 +8,13 @@ import (
 func RegisterWorkflows(t *bootstrap.TemporalService) {
 	t.RegisterWorkflow(DeleteUserWorkflow, temporal_constants.DeleteUserWorkflowName)
 	t.RegisterWorkflow(DeletePostWorkflow, temporal_constants.DeletePostWorkflowName)
+	t.RegisterWorkflow(PublishScheduledPostWorkflow, temporal_constants.PublishScheduledPostWorkflowName)
+	t.RegisterWorkflow(ClosePollWorkflow, temporal_constants.ClosePollWorkflowName)
+	t.RegisterWorkflow(FetchLinkPreviewWorkflow, temporal_constants.FetchLinkPreviewWorkflowName)
+	t.RegisterWorkflow(FanOutPostWorkflow, temporal_constants.FanOutPostWorkflowName)
+	t.RegisterWorkflow(SyncFollowTimelineWorkflow, temporal_constants.SyncFollowTimelineWorkflowName)
+	t.RegisterWorkflow(RebuildTimelineWorkflow, temporal_constants.RebuildTimelineWorkflowName)
+	t.RegisterWorkflow(VerifyProfileLinksWorkflow, temporal_constants.VerifyProfileLinksWorkflowName)
+	t.RegisterWorkflow(RefreshTrendingHashtagsWorkflow, temporal_constants.RefreshTrendingHashtagsWorkflowName)
+	t.RegisterWorkflow(ReconcileLikesCountWorkflow, temporal_constants.ReconcileLikesCountWorkflowName)
 }
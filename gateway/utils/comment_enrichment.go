@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	commentpb "voidspaceGateway/proto/generated/comments/v1"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+
+	"go.uber.org/zap"
+)
+
+// EnrichComments fetches the authors and mentioned users of comments in one
+// batch.
+func EnrichComments(
+	ctx context.Context,
+	comments []*commentpb.Comment,
+	userClient userpb.UserServiceClient,
+	logger *zap.Logger,
+) ([]models.Comment, error) {
+	if len(comments) == 0 {
+		return []models.Comment{}, nil
+	}
+
+	userIDSet := make(map[int64]struct{})
+	for _, c := range comments {
+		userIDSet[c.GetUserId()] = struct{}{}
+		for _, m := range c.GetMentions() {
+			userIDSet[m.GetUserId()] = struct{}{}
+		}
+	}
+
+	userIDs := make([]int64, 0, len(userIDSet))
+	for id := range userIDSet {
+		userIDs = append(userIDs, id)
+	}
+
+	usersRes, err := userClient.GetUsers(ctx, &userpb.GetUsersRequest{
+		UserIds: userIDs,
+	})
+	if err != nil {
+		logger.Error("failed to call UserService.GetUsers", zap.Error(err))
+		return nil, err
+	}
+
+	userMap := make(map[int64]*models.User, len(usersRes.GetUsers()))
+	for _, u := range usersRes.GetUsers() {
+		userMap[u.GetId()] = UserMapper(u)
+	}
+
+	enriched := make([]models.Comment, 0, len(comments))
+	for _, c := range comments {
+		comment := CommentMapper(c, userMap[c.GetUserId()])
+		comment.Mentions = MentionsMapper(c.GetMentions(), userMap)
+		enriched = append(enriched, *comment)
+	}
+
+	return enriched, nil
+}
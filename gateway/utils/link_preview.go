@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"voidspaceGateway/internal/models"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	LinkPreviewMaxBodySize  = 512 << 10
+	LinkPreviewMaxRedirects = 3
+	LinkPreviewFetchTimeout = 5 * time.Second
+
+	linkPreviewMaxTitle       = 300
+	linkPreviewMaxDescription = 1000
+)
+
+// NewLinkPreviewHTTPClient returns a client for fetching pages linked from
+// posts, see NewPublicHTTPTransport for allowPrivate.
+func NewLinkPreviewHTTPClient(allowPrivate bool) *http.Client {
+	return &http.Client{
+		Timeout:   LinkPreviewFetchTimeout,
+		Transport: NewPublicHTTPTransport(LinkPreviewFetchTimeout, allowPrivate),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= LinkPreviewMaxRedirects {
+				return errors.New("too many redirects")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return errors.New("unsupported redirect scheme")
+			}
+			return nil
+		},
+	}
+}
+
+// FirstLinkURL returns the first url entity of a post as an absolute URL,
+// links written without a scheme are fetched over https. Empty when the post
+// has no link.
+func FirstLinkURL(entities []*postpb.Entity) string {
+	links := make([]*postpb.Entity, 0, len(entities))
+	for _, entity := range entities {
+		if entity.GetType() == "url" {
+			links = append(links, entity)
+		}
+	}
+
+	if len(links) == 0 {
+		return ""
+	}
+
+	sort.Slice(links, func(i, j int) bool { return links[i].GetStart() < links[j].GetStart() })
+
+	link := links[0].GetText()
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ""
+	}
+
+	return parsed.String()
+}
+
+// IsHTMLResponse reports whether res declares an html document.
+func IsHTMLResponse(res *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// ParseLinkPreview reads the OpenGraph and Twitter card tags from the head of
+// an html document, OpenGraph wins over Twitter and both over <title> and the
+// description meta tag. Relative image URLs are resolved against pageURL.
+// The result has no URL set.
+func ParseLinkPreview(body io.Reader, pageURL *url.URL) (*models.LinkPreview, error) {
+	tags := make(map[string]string)
+	var title string
+	inTitle := false
+
+	tokenizer := html.NewTokenizer(body)
+
+parse:
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if errors.Is(tokenizer.Err(), io.EOF) {
+				break parse
+			}
+			return nil, tokenizer.Err()
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "body":
+				break parse
+			case "title":
+				inTitle = true
+			case "meta":
+				var key, content string
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "property", "name":
+						if key == "" {
+							key = strings.ToLower(strings.TrimSpace(attr.Val))
+						}
+					case "content":
+						content = strings.TrimSpace(attr.Val)
+					}
+				}
+				// the first value of a repeated tag wins
+				if _, ok := tags[key]; key != "" && content != "" && !ok {
+					tags[key] = content
+				}
+			}
+
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = strings.TrimSpace(string(tokenizer.Text()))
+			}
+
+		case html.EndTagToken:
+			switch tokenizer.Token().Data {
+			case "title":
+				inTitle = false
+			case "head":
+				break parse
+			}
+		}
+	}
+
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if tags[key] != "" {
+				return tags[key]
+			}
+		}
+		return ""
+	}
+
+	preview := &models.LinkPreview{
+		Title:       truncateRunes(first("og:title", "twitter:title"), linkPreviewMaxTitle),
+		Description: truncateRunes(first("og:description", "twitter:description", "description"), linkPreviewMaxDescription),
+		SiteName:    truncateRunes(first("og:site_name"), linkPreviewMaxTitle),
+		ImageURL:    resolveImageURL(first("og:image:secure_url", "og:image", "og:image:url", "twitter:image", "twitter:image:src"), pageURL),
+	}
+
+	if preview.Title == "" {
+		preview.Title = truncateRunes(title, linkPreviewMaxTitle)
+	}
+
+	return preview, nil
+}
+
+func resolveImageURL(raw string, pageURL *url.URL) string {
+	if raw == "" {
+		return ""
+	}
+
+	ref, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+
+	resolved := pageURL.ResolveReference(ref)
+	if resolved.Scheme != "http" && resolved.Scheme != "https" {
+		return ""
+	}
+
+	return resolved.String()
+}
+
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+
+	return strings.TrimSpace(string(runes[:max]))
+}
@@ -13,14 +13,27 @@ func ProfileMapper(profile *userpb.UserProfile) *models.Profile {
 		return nil
 	}
 
+	links := make([]models.ProfileLink, 0, len(profile.GetLinks()))
+	for _, link := range profile.GetLinks() {
+		links = append(links, models.ProfileLink{
+			Label:    link.GetLabel(),
+			URL:      link.GetUrl(),
+			Verified: link.GetVerified(),
+		})
+	}
+
 	return &models.Profile{
-		Bio:         profile.GetBio(),
-		DisplayName: profile.GetDisplayName(),
-		AvatarURL:   profile.GetAvatarUrl(),
-		BannerURL:   profile.GetBannerUrl(),
-		Location:    profile.GetLocation(),
-		Followers:   int(profile.GetFollowers()),
-		Following:   int(profile.GetFollowing()),
+		Bio:                profile.GetBio(),
+		DisplayName:        profile.GetDisplayName(),
+		AvatarURL:          profile.GetAvatarUrl(),
+		BannerURL:          profile.GetBannerUrl(),
+		Location:           profile.GetLocation(),
+		Followers:          int(profile.GetFollowers()),
+		Following:          int(profile.GetFollowing()),
+		Pronouns:           profile.GetPronouns(),
+		Birthday:           profile.GetBirthday(),
+		BirthdayVisibility: profile.GetBirthdayVisibility(),
+		Links:              links,
 	}
 }
 
@@ -52,31 +65,174 @@ func UserBannerMapper(user *userpb.UserBanner) *models.UserBanner {
 	}
 }
 
-func PostMapper(postRes *postpb.Post, author *models.User, commentCount int) *models.Post {
+func PostImagesMapper(pbImages []*postpb.PostImage) []models.PostImage {
+	images := make([]models.PostImage, 0, len(pbImages))
+	for _, img := range pbImages {
+		images = append(images, models.PostImage{
+			ImageURL:  img.GetUrl(),
+			Order:     int(img.GetOrder()),
+			Width:     int(img.GetWidth()),
+			Height:    int(img.GetHeight()),
+			Sensitive: img.GetSensitive(),
+		})
+	}
+	return images
+}
+
+func EntitiesMapper(pbEntities []*postpb.Entity) []models.Entity {
+	entities := make([]models.Entity, 0, len(pbEntities))
+	for _, entity := range pbEntities {
+		e := models.Entity{
+			Type:       entity.GetType(),
+			Text:       entity.GetText(),
+			Start:      int(entity.GetStart()),
+			End:        int(entity.GetEnd()),
+			Utf16Start: int(entity.GetUtf16Start()),
+			Utf16End:   int(entity.GetUtf16End()),
+		}
+		if entity.UserId != nil {
+			userID := int(entity.GetUserId())
+			e.UserID = &userID
+		}
+		entities = append(entities, e)
+	}
+	return entities
+}
+
+// PostMapper maps a post for a viewer whose sensitive_media setting is
+// sensitiveMedia, authors always see their own images.
+func PostMapper(postRes *postpb.Post, author *models.User, commentCount int, sensitiveMedia string) *models.Post {
 	if postRes == nil {
 		return nil
 	}
 
-	images := make([]models.PostImage, 0, len(postRes.GetImages()))
-	for _, img := range postRes.GetImages() {
-		images = append(images, models.PostImage{
-			ImageURL: img.GetUrl(),
-			Order:    int(img.GetOrder()),
-			Width:    int(img.GetWidth()),
-			Height:   int(img.GetHeight()),
+	post := &models.Post{
+		ID:             int(postRes.GetId()),
+		Content:        postRes.GetContent(),
+		PostImages:     PostImagesMapper(postRes.GetImages()),
+		LikesCount:     int(postRes.GetLikesCount()),
+		CommentsCount:  commentCount,
+		RepliesCount:   int(postRes.GetRepliesCount()),
+		RepostsCount:   int(postRes.GetRepostsCount()),
+		QuotesCount:    int(postRes.GetQuotesCount()),
+		CreatedAt:      postRes.GetCreatedAt().AsTime(),
+		UpdatedAt:      postRes.GetUpdatedAt().AsTime(),
+		IsLiked:        postRes.GetIsLiked(),
+		IsReposted:     postRes.GetIsReposted(),
+		IsBookmarked:   postRes.GetIsBookmarked(),
+		IsPinned:       postRes.GetIsPinned(),
+		Author:         author,
+		Mentions:       []models.Mention{},
+		Entities:       EntitiesMapper(postRes.GetEntities()),
+		Edited:         postRes.GetEdited(),
+		EditCount:      int(postRes.GetEditCount()),
+		Visibility:     postRes.GetVisibility(),
+		Reactions:      ReactionCountsMapper(postRes.GetReactions()),
+		ViewerReaction: postRes.GetViewerReaction(),
+		ContentWarning: postRes.ContentWarning,
+	}
+
+	if !postRes.GetIsOwner() {
+		post.PostImages = SensitiveImagesMapper(post.PostImages, postRes.ContentWarning != nil, sensitiveMedia)
+	}
+
+	if postRes.ReplyToPostId != nil {
+		replyTo := int(postRes.GetReplyToPostId())
+		post.ReplyToPostID = &replyTo
+	}
+
+	if postRes.RootPostId != nil {
+		rootID := int(postRes.GetRootPostId())
+		post.RootPostID = &rootID
+	}
+
+	if postRes.QuotePostId != nil {
+		quoteID := int(postRes.GetQuotePostId())
+		post.QuotePostID = &quoteID
+	}
+
+	if postRes.RepostedAt != nil {
+		repostedAt := postRes.GetRepostedAt().AsTime()
+		post.RepostedAt = &repostedAt
+	}
+
+	if postRes.PublishAt != nil {
+		publishAt := postRes.GetPublishAt().AsTime()
+		post.PublishAt = &publishAt
+	}
+
+	if postRes.Poll != nil {
+		post.Poll = PollMapper(postRes.GetPoll())
+	}
+
+	if postRes.LinkPreview != nil {
+		post.LinkPreview = &models.LinkPreview{
+			URL:         postRes.GetLinkPreview().GetUrl(),
+			Title:       postRes.GetLinkPreview().GetTitle(),
+			Description: postRes.GetLinkPreview().GetDescription(),
+			ImageURL:    postRes.GetLinkPreview().GetImageUrl(),
+			SiteName:    postRes.GetLinkPreview().GetSiteName(),
+		}
+	}
+
+	if postRes.BookmarkedAt != nil {
+		bookmarkedAt := postRes.GetBookmarkedAt().AsTime()
+		post.BookmarkedAt = &bookmarkedAt
+		post.BookmarkFolder = postRes.BookmarkFolder
+	}
+
+	return post
+}
+
+func ReactionCountsMapper(pbReactions []*postpb.ReactionCount) []models.ReactionCount {
+	reactions := make([]models.ReactionCount, 0, len(pbReactions))
+	for _, reaction := range pbReactions {
+		reactions = append(reactions, models.ReactionCount{
+			Reaction: reaction.GetReaction(),
+			Count:    int(reaction.GetCount()),
 		})
 	}
+	return reactions
+}
+
+// PollMapper maps a poll, option tallies stay nil while they are hidden.
+func PollMapper(pollRes *postpb.Poll) *models.Poll {
+	poll := &models.Poll{
+		Options:        make([]models.PollOption, len(pollRes.GetOptions())),
+		MultipleChoice: pollRes.GetMultipleChoice(),
+		EndsAt:         pollRes.GetEndsAt().AsTime(),
+		Closed:         pollRes.GetClosed(),
+		Voted:          pollRes.GetVoted(),
+		TalliesVisible: pollRes.GetTalliesVisible(),
+		VotersCount:    int(pollRes.GetVotersCount()),
+	}
 
-	return &models.Post{
-		ID:            int(postRes.GetId()),
-		Content:       postRes.GetContent(),
-		PostImages:    images,
-		LikesCount:    int(postRes.GetLikesCount()),
-		CommentsCount: commentCount,
-		CreatedAt:     postRes.GetCreatedAt().AsTime(),
-		UpdatedAt:     postRes.GetUpdatedAt().AsTime(),
-		IsLiked:       postRes.GetIsLiked(),
-		Author:        author,
+	for i, option := range pollRes.GetOptions() {
+		poll.Options[i] = models.PollOption{
+			ID:      int(option.GetId()),
+			Label:   option.GetLabel(),
+			IsVoted: option.GetIsVoted(),
+		}
+
+		if option.VotesCount != nil {
+			votes := int(option.GetVotesCount())
+			poll.Options[i].VotesCount = &votes
+		}
+	}
+
+	return poll
+}
+
+// FeedCursorMapper copies the next page cursor from a feed response.
+func FeedCursorMapper(res *postpb.GetFeedResponse, feed *models.GetFeedResponse) {
+	if res.GetNextCursorTime() != nil {
+		cursor := res.GetNextCursorTime().AsTime()
+		feed.NextCursor = &cursor
+	}
+
+	if res.NextCursorId != nil {
+		cursorID := int(res.GetNextCursorId())
+		feed.NextCursorID = &cursorID
 	}
 }
 
@@ -93,10 +249,38 @@ func CommentMapper(
 		PostID:    int(commentRes.GetPostId()),
 		Content:   commentRes.GetContent(),
 		Author:    user,
+		Mentions:  []models.Mention{},
 		CreatedAt: commentRes.GetCreatedAt().AsTime(),
 	}
 }
 
+type mentionEntity interface {
+	GetUserId() int64
+	GetStart() int32
+	GetEnd() int32
+}
+
+// MentionsMapper links mentions to users, mentions of users missing from users
+// (deleted accounts) are dropped and render as plain text.
+func MentionsMapper[M mentionEntity](mentions []M, users map[int64]*models.User) []models.Mention {
+	result := make([]models.Mention, 0, len(mentions))
+	for _, m := range mentions {
+		user := users[m.GetUserId()]
+		if user == nil {
+			continue
+		}
+
+		result = append(result, models.Mention{
+			UserID:   user.ID,
+			Username: user.Username,
+			Start:    int(m.GetStart()),
+			End:      int(m.GetEnd()),
+		})
+	}
+
+	return result
+}
+
 func AuthMapper(authRes *userpb.AuthResponse) *models.AuthResponseService {
 	return &models.AuthResponseService{
 		AccessToken:  authRes.GetAccessToken(),
@@ -104,3 +288,165 @@ func AuthMapper(authRes *userpb.AuthResponse) *models.AuthResponseService {
 		ExpiresIn:    int64(authRes.GetExpiresIn()),
 	}
 }
+
+func SessionMapper(session *userpb.Session) *models.Session {
+	if session == nil {
+		return nil
+	}
+
+	return &models.Session{
+		ID:         int(session.GetId()),
+		UserAgent:  session.GetUserAgent(),
+		IPAddress:  session.GetIpAddress(),
+		CreatedAt:  session.GetCreatedAt().AsTime(),
+		LastSeenAt: session.GetLastSeenAt().AsTime(),
+		IsCurrent:  session.GetIsCurrent(),
+	}
+}
+
+func SecurityLogMapper(res *userpb.SecurityEventsResponse) *models.SecurityLogResponse {
+	events := make([]*models.SecurityEvent, 0, len(res.GetEvents()))
+	for _, event := range res.GetEvents() {
+		events = append(events, &models.SecurityEvent{
+			ID:        int(event.GetId()),
+			UserID:    int(event.GetUserId()),
+			EventType: event.GetEventType(),
+			IPAddress: event.GetIpAddress(),
+			UserAgent: event.GetUserAgent(),
+			Detail:    event.GetDetail(),
+			CreatedAt: event.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return &models.SecurityLogResponse{
+		Events:  events,
+		HasMore: res.GetHasMore(),
+	}
+}
+
+func SettingsMapper(settings *userpb.UserSettings) *models.UserSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &models.UserSettings{
+		DefaultPostVisibility: settings.GetDefaultPostVisibility(),
+		ReplyPolicy:           settings.GetReplyPolicy(),
+		MentionPolicy:         settings.GetMentionPolicy(),
+		Notifications: models.NotificationSettings{
+			Likes:    settings.GetNotifications().GetLikes(),
+			Comments: settings.GetNotifications().GetComments(),
+			Follows:  settings.GetNotifications().GetFollows(),
+			Mentions: settings.GetNotifications().GetMentions(),
+		},
+		Language:       settings.GetLanguage(),
+		SensitiveMedia: settings.GetSensitiveMedia(),
+	}
+}
+
+func InviteMapper(invite *userpb.Invite) *models.Invite {
+	if invite == nil {
+		return nil
+	}
+
+	res := &models.Invite{
+		ID:          int(invite.GetId()),
+		Code:        invite.GetCode(),
+		MaxUses:     int(invite.GetMaxUses()),
+		Uses:        int(invite.GetUses()),
+		CreatedAt:   invite.GetCreatedAt().AsTime(),
+		Redemptions: make([]models.InviteRedemption, 0, len(invite.GetRedemptions())),
+	}
+
+	if invite.IssuerId != nil {
+		issuerID := int(invite.GetIssuerId())
+		res.IssuerID = &issuerID
+	}
+
+	if invite.ExpiresAt != nil {
+		expiresAt := invite.GetExpiresAt().AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+
+	for _, redemption := range invite.GetRedemptions() {
+		res.Redemptions = append(res.Redemptions, models.InviteRedemption{
+			UserID:     int(redemption.GetUserId()),
+			Username:   redemption.GetUsername(),
+			RedeemedAt: redemption.GetRedeemedAt().AsTime(),
+		})
+	}
+
+	return res
+}
+
+func InviteListMapper(res *userpb.ListInvitesResponse) *models.InviteListResponse {
+	invites := make([]*models.Invite, 0, len(res.GetInvites()))
+	for _, invite := range res.GetInvites() {
+		invites = append(invites, InviteMapper(invite))
+	}
+
+	return &models.InviteListResponse{
+		Invites: invites,
+		HasMore: res.GetHasMore(),
+	}
+}
+
+func DraftMapper(d *postpb.Draft) *models.Draft {
+	draft := &models.Draft{
+		ID:             int(d.GetId()),
+		Content:        d.GetContent(),
+		PostImages:     PostImagesMapper(d.GetImages()),
+		CreatedAt:      d.GetCreatedAt().AsTime(),
+		UpdatedAt:      d.GetUpdatedAt().AsTime(),
+		Visibility:     d.GetVisibility(),
+		ContentWarning: d.ContentWarning,
+	}
+
+	if d.ReplyToPostId != nil {
+		replyTo := int(d.GetReplyToPostId())
+		draft.ReplyToPostID = &replyTo
+	}
+
+	return draft
+}
+
+// PostRevisionsMapper applies sensitiveMedia to the images of every revision,
+// the post's content warning makes all of them sensitive.
+func PostRevisionsMapper(res *postpb.GetPostRevisionsResponse, sensitiveMedia string) *models.PostHistoryResponse {
+	contentWarning := res.ContentWarning != nil
+
+	revisions := make([]models.PostRevision, 0, len(res.GetRevisions()))
+	for _, revision := range res.GetRevisions() {
+		revisions = append(revisions, models.PostRevision{
+			Version:    int(revision.GetVersion()),
+			Content:    revision.GetContent(),
+			PostImages: SensitiveImagesMapper(PostImagesMapper(revision.GetImages()), contentWarning, sensitiveMedia),
+			Entities:   EntitiesMapper(revision.GetEntities()),
+			CreatedAt:  revision.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return &models.PostHistoryResponse{Revisions: revisions}
+}
+
+func TrendingHashtagsMapper(res *postpb.GetTrendingHashtagsResponse) *models.TrendingHashtagsResponse {
+	hashtags := make([]models.TrendingHashtag, 0, len(res.GetHashtags()))
+	for _, h := range res.GetHashtags() {
+		hashtags = append(hashtags, models.TrendingHashtag{
+			Tag:   h.GetTag(),
+			Uses:  int(h.GetUses()),
+			Score: h.GetScore(),
+		})
+	}
+
+	trending := &models.TrendingHashtagsResponse{
+		Hashtags: hashtags,
+	}
+
+	if res.GetComputedAt() != nil {
+		computedAt := res.GetComputedAt().AsTime()
+		trending.ComputedAt = &computedAt
+	}
+
+	return trending
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"testing"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+)
+
+func TestPostRevisionsMapper(t *testing.T) {
+	warning := "spoilers"
+	revision := &postpb.PostRevision{
+		Version: 1,
+		Content: "before the edit",
+		Images: []*postpb.PostImage{
+			{Url: "https://cdn.example.com/a.jpg", Order: 1, Width: 10, Height: 10},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		contentWarning *string
+		sensitiveMedia string
+		wantImages     int
+		wantBlurred    bool
+	}{
+		{"no warning is shown", nil, SensitiveMediaBlur, 1, false},
+		{"warning blurs", &warning, SensitiveMediaBlur, 1, true},
+		{"warning hides", &warning, SensitiveMediaHide, 0, false},
+		{"warning shown on request", &warning, SensitiveMediaShow, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := PostRevisionsMapper(&postpb.GetPostRevisionsResponse{
+				Revisions:      []*postpb.PostRevision{revision},
+				ContentWarning: tt.contentWarning,
+			}, tt.sensitiveMedia)
+
+			images := history.Revisions[0].PostImages
+			if len(images) != tt.wantImages {
+				t.Fatalf("got %d images, want %d", len(images), tt.wantImages)
+			}
+			if len(images) == 0 {
+				return
+			}
+
+			if images[0].Blurred != tt.wantBlurred {
+				t.Errorf("blurred = %v, want %v", images[0].Blurred, tt.wantBlurred)
+			}
+			if tt.wantBlurred && images[0].ImageURL != "" {
+				t.Errorf("blurred image kept its url %q", images[0].ImageURL)
+			}
+		})
+	}
+}
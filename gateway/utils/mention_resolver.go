@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	commentpb "voidspaceGateway/proto/generated/comments/v1"
+	postpb "voidspaceGateway/proto/generated/posts/v1"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"go.uber.org/zap"
+)
+
+// ResolvedMention is an @username in content that belongs to an existing
+// user, Start and End are byte offsets.
+type ResolvedMention struct {
+	UserID int64
+	Start  int32
+	End    int32
+}
+
+// ResolveMentions finds the @usernames in content and resolves them with one
+// GetUsersByUsernames call, unknown usernames stay plain text.
+func ResolveMentions(
+	ctx context.Context,
+	content string,
+	userClient userpb.UserServiceClient,
+	logger *zap.Logger,
+) ([]ResolvedMention, error) {
+	spans := helper.ExtractMentions(content)
+	if len(spans) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]struct{}, len(spans))
+	usernames := make([]string, 0, len(spans))
+	for _, span := range spans {
+		if _, ok := seen[span.Username]; ok {
+			continue
+		}
+		seen[span.Username] = struct{}{}
+		usernames = append(usernames, span.Username)
+	}
+
+	res, err := userClient.GetUsersByUsernames(ctx, &userpb.GetUsersByUsernamesRequest{
+		Usernames: usernames,
+	})
+	if err != nil {
+		logger.Error("failed to call UserService.GetUsersByUsernames", zap.Error(err))
+		return nil, err
+	}
+
+	userIDs := make(map[string]int64, len(res.GetUsers()))
+	for _, u := range res.GetUsers() {
+		userIDs[u.GetUsername()] = u.GetId()
+	}
+
+	mentions := make([]ResolvedMention, 0, len(spans))
+	for _, span := range spans {
+		userID, ok := userIDs[span.Username]
+		if !ok {
+			continue
+		}
+
+		mentions = append(mentions, ResolvedMention{
+			UserID: userID,
+			Start:  int32(span.Start),
+			End:    int32(span.End),
+		})
+	}
+
+	return mentions, nil
+}
+
+func PostMentionsMapper(mentions []ResolvedMention) []*postpb.Mention {
+	result := make([]*postpb.Mention, len(mentions))
+	for i, m := range mentions {
+		result[i] = &postpb.Mention{UserId: m.UserID, Start: m.Start, End: m.End}
+	}
+	return result
+}
+
+func CommentMentionsMapper(mentions []ResolvedMention) []*commentpb.Mention {
+	result := make([]*commentpb.Mention, len(mentions))
+	for i, m := range mentions {
+		result[i] = &commentpb.Mention{UserId: m.UserID, Start: m.Start, End: m.End}
+	}
+	return result
+}
@@ -1,6 +1,11 @@
 package utils
 
-import "google.golang.org/grpc/metadata"
+import (
+	"voidspaceGateway/internal/models"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+)
 
 func MetaDataHandler(userID string, username string) metadata.MD {
 	md := metadata.New(map[string]string{
@@ -10,3 +15,28 @@ func MetaDataHandler(userID string, username string) metadata.MD {
 
 	return md
 }
+
+// SessionMetaDataHandler is MetaDataHandler plus the session the request was
+// authenticated with, used by session management endpoints.
+func SessionMetaDataHandler(userID string, username string, sessionID string) metadata.MD {
+	md := MetaDataHandler(userID, username)
+	md.Set("session_id", sessionID)
+
+	return md
+}
+
+func ClientMetaDataHandler(client models.ClientInfo) metadata.MD {
+	md := metadata.New(map[string]string{
+		"client_ip":         client.IPAddress,
+		"client_user_agent": client.UserAgent,
+	})
+
+	return md
+}
+
+func ExtractClientInfo(c echo.Context) models.ClientInfo {
+	return models.ClientInfo{
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
@@ -12,7 +12,10 @@ import (
 )
 
 // EnrichPosts takes a slice of proto posts and enriches them with user data
-// and comment counts by batch-fetching both in parallel.
+// and comment counts by batch-fetching both in parallel. Quoted posts and
+// repost attribution are enriched from the same batches. Scheduled posts have
+// no comments, their ids are not post ids and are left out of the count.
+// Sensitive images follow the viewer's sensitive_media setting.
 func EnrichPosts(
 	ctx context.Context,
 	posts []*postpb.Post,
@@ -29,7 +32,26 @@ func EnrichPosts(
 	postIDs := make([]int64, 0, len(posts))
 	for _, p := range posts {
 		userIDSet[p.GetUserId()] = struct{}{}
-		postIDs = append(postIDs, p.GetId())
+		if p.PublishAt == nil {
+			postIDs = append(postIDs, p.GetId())
+		}
+
+		if p.RepostedBy != nil {
+			userIDSet[p.GetRepostedBy()] = struct{}{}
+		}
+
+		for _, m := range p.GetMentions() {
+			userIDSet[m.GetUserId()] = struct{}{}
+		}
+
+		if quoted := p.GetQuotedPost(); quoted != nil {
+			userIDSet[quoted.GetUserId()] = struct{}{}
+			postIDs = append(postIDs, quoted.GetId())
+
+			for _, m := range quoted.GetMentions() {
+				userIDSet[m.GetUserId()] = struct{}{}
+			}
+		}
 	}
 
 	userIDs := make([]int64, 0, len(userIDSet))
@@ -54,6 +76,10 @@ func EnrichPosts(
 
 	var commentCountRes *commentpb.GetFeedCommentCountResponse
 	g.Go(func() error {
+		if len(postIDs) == 0 {
+			return nil
+		}
+
 		var err error
 		commentCountRes, err = commentClient.GetFeedCommentCount(gCtx, &commentpb.GetFeedCommentCountRequest{
 			PostIds: postIDs,
@@ -64,6 +90,12 @@ func EnrichPosts(
 		return err
 	})
 
+	sensitiveMedia := SensitiveMediaBlur
+	g.Go(func() error {
+		sensitiveMedia = ViewerSensitiveMedia(gCtx, userClient, logger)
+		return nil
+	})
+
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
@@ -85,7 +117,19 @@ func EnrichPosts(
 	for _, p := range posts {
 		author := userMap[p.GetUserId()]
 		commentCount := int(commentCountMap[p.GetId()])
-		enriched = append(enriched, *PostMapper(p, author, commentCount))
+		post := PostMapper(p, author, commentCount, sensitiveMedia)
+		post.Mentions = MentionsMapper(p.GetMentions(), userMap)
+
+		if p.RepostedBy != nil {
+			post.RepostedBy = userMap[p.GetRepostedBy()]
+		}
+
+		if quoted := p.GetQuotedPost(); quoted != nil {
+			post.QuotedPost = PostMapper(quoted, userMap[quoted.GetUserId()], int(commentCountMap[quoted.GetId()]), sensitiveMedia)
+			post.QuotedPost.Mentions = MentionsMapper(quoted.GetMentions(), userMap)
+		}
+
+		enriched = append(enriched, *post)
 	}
 
 	return enriched, nil
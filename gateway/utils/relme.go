@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	RelMeMaxBodySize  = 1 << 20
+	RelMeMaxRedirects = 3
+	RelMeFetchTimeout = 10 * time.Second
+)
+
+// NewRelMeHTTPClient returns a client suited for fetching user supplied pages:
+// short timeout, few redirects, http(s) only and public addresses only, see
+// NewPublicHTTPTransport for allowPrivate. Bodies are read up to
+// RelMeMaxBodySize.
+func NewRelMeHTTPClient(allowPrivate bool) *http.Client {
+	return &http.Client{
+		Timeout:   RelMeFetchTimeout,
+		Transport: NewPublicHTTPTransport(RelMeFetchTimeout, allowPrivate),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= RelMeMaxRedirects {
+				return errors.New("too many redirects")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return errors.New("unsupported redirect scheme")
+			}
+			return nil
+		},
+	}
+}
+
+// HasRelMeLink reports whether the html document contains an <a> or <link>
+// element with rel="me" pointing back to target.
+func HasRelMeLink(body io.Reader, target string) (bool, error) {
+	want := normalizeRelMeURL(target)
+	if want == "" {
+		return false, errors.New("invalid target url")
+	}
+
+	tokenizer := html.NewTokenizer(body)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if errors.Is(tokenizer.Err(), io.EOF) {
+				return false, nil
+			}
+			return false, tokenizer.Err()
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data != "a" && token.Data != "link" {
+				continue
+			}
+
+			var rel, href string
+			for _, attr := range token.Attr {
+				switch attr.Key {
+				case "rel":
+					rel = attr.Val
+				case "href":
+					href = attr.Val
+				}
+			}
+
+			if hasRelMe(rel) && normalizeRelMeURL(href) == want {
+				return true, nil
+			}
+		}
+	}
+}
+
+func hasRelMe(rel string) bool {
+	for _, value := range strings.Fields(rel) {
+		if strings.EqualFold(value, "me") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeRelMeURL(raw string) string {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+
+	return strings.ToLower(parsed.Host) + strings.TrimSuffix(parsed.EscapedPath(), "/")
+}
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"context"
+	"voidspaceGateway/internal/models"
+	userpb "voidspaceGateway/proto/generated/users/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Values of the sensitive_media user setting, how images flagged sensitive
+// are shown to the viewer.
+const (
+	SensitiveMediaShow = "show"
+	SensitiveMediaBlur = "blur"
+	SensitiveMediaHide = "hide"
+)
+
+type revealSensitiveMediaKey struct{}
+
+// WithSensitiveMediaRevealed marks ctx as the viewer opting in to the
+// sensitive media of the posts loaded with it, whatever their setting.
+func WithSensitiveMediaRevealed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, revealSensitiveMediaKey{}, true)
+}
+
+// ViewerSensitiveMedia returns the sensitive_media setting of the viewer in
+// the outgoing metadata of ctx. Guests get blur, and so does a viewer whose
+// settings can't be loaded, a feed shouldn't fail over it.
+func ViewerSensitiveMedia(
+	ctx context.Context,
+	userClient userpb.UserServiceClient,
+	logger *zap.Logger,
+) string {
+	if revealed, _ := ctx.Value(revealSensitiveMediaKey{}).(bool); revealed {
+		return SensitiveMediaShow
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if userID := md.Get("user_id"); len(userID) == 0 || userID[0] == "" {
+		return SensitiveMediaBlur
+	}
+
+	res, err := userClient.GetSettings(ctx, &emptypb.Empty{})
+	if err != nil {
+		logger.Warn("failed to call UserService.GetSettings", zap.Error(err))
+		return SensitiveMediaBlur
+	}
+
+	switch mode := res.GetSettings().GetSensitiveMedia(); mode {
+	case SensitiveMediaShow, SensitiveMediaHide:
+		return mode
+	default:
+		return SensitiveMediaBlur
+	}
+}
+
+// SensitiveImagesMapper applies sensitiveMedia to images. Show returns them
+// as they are, blur keeps a placeholder of the same size without the URL and
+// hide leaves them out. Every image of a post with a content warning counts
+// as sensitive.
+func SensitiveImagesMapper(
+	images []models.PostImage,
+	contentWarning bool,
+	sensitiveMedia string,
+) []models.PostImage {
+	if sensitiveMedia == SensitiveMediaShow {
+		return images
+	}
+
+	mapped := make([]models.PostImage, 0, len(images))
+	for _, image := range images {
+		if !image.Sensitive && !contentWarning {
+			mapped = append(mapped, image)
+			continue
+		}
+
+		if sensitiveMedia == SensitiveMediaHide {
+			continue
+		}
+
+		image.ImageURL = ""
+		image.Blurred = true
+		mapped = append(mapped, image)
+	}
+
+	return mapped
+}
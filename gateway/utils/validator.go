@@ -2,11 +2,24 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/vhysxl/voidspace/shared/utils/helper"
 )
 
+// MaxGraphemes backs the maxgraphemes tag, it limits a string by
+// user-perceived characters instead of bytes or runes.
+func MaxGraphemes(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		return false
+	}
+
+	return helper.GraphemeCount(fl.Field().String()) <= limit
+}
+
 func FormatValidationError(err error) string {
 	var fieldLabels = map[string]string{
 		"usernameoremail": "Username or Email",
@@ -33,7 +46,7 @@ func FormatValidationError(err error) string {
 				messages = append(messages, fmt.Sprintf("%s must be a valid email", label))
 			case "min":
 				messages = append(messages, fmt.Sprintf("%s must be at least %s characters", label, v.Param()))
-			case "max":
+			case "max", "maxgraphemes":
 				messages = append(messages, fmt.Sprintf("%s must be at most %s characters", label, v.Param()))
 			default:
 				messages = append(messages, fmt.Sprintf("%s is invalid", label))
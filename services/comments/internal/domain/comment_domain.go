@@ -10,9 +10,18 @@ type Comment struct {
 	UserID    int
 	PostID    int
 	Content   string
+	Mentions  []Mention
 	CreatedAt time.Time
 }
 
+// Mention is a resolved @username, Start and End are byte offsets into the
+// content.
+type Mention struct {
+	UserID int `json:"user_id"`
+	Start  int `json:"start"`
+	End    int `json:"end"`
+}
+
 type CommentUsecase interface {
 	CreateComment(ctx context.Context, comment *Comment) (*Comment, error)
 	DeleteComment(ctx context.Context, commentID, userID int) error
@@ -20,6 +29,7 @@ type CommentUsecase interface {
 	GetAllCommentsByPostID(ctx context.Context, postID int) (CommentRes, error)
 	GetAllCommentsByUserID(ctx context.Context, userID int) (CommentRes, error)
 	GetFeedCommentCount(ctx context.Context, postIDs []int) (map[int]int, error)
+	GetMentionedComments(ctx context.Context, userID int, cursorTime *time.Time, cursorID int) ([]*Comment, bool, error)
 
 	HandleAccountDeletion(ctx context.Context, userID int) error
 	HandleAccountRestoration(ctx context.Context, userID int) error
@@ -36,6 +46,7 @@ type CommentRepository interface {
 	GetAllByPostID(ctx context.Context, postID int) ([]*Comment, error)
 	GetAllByUserID(ctx context.Context, userID int) ([]*Comment, error)
 	CountCommentsByPostIDs(ctx context.Context, postIDs []int) (map[int]int, error)
+	GetMentioning(ctx context.Context, userID int, cursorTime time.Time, cursorID int) ([]*Comment, bool, error)
 
 	HandleAccountDeletion(ctx context.Context, userID int) error
 	HandleAccountRestoration(ctx context.Context, userID int) error
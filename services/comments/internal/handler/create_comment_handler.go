@@ -17,9 +17,10 @@ func (ch *CommentHandler) CreateComment(ctx context.Context, req *pb.CreateComme
 	}
 
 	data := &domain.Comment{
-		UserID:  userId,
-		PostID:  int(req.GetPostId()),
-		Content: req.GetContent(),
+		UserID:   userId,
+		PostID:   int(req.GetPostId()),
+		Content:  req.GetContent(),
+		Mentions: utils.MentionsMapper(req.GetMentions()),
 	}
 
 	comment, err := ch.CommentUsecase.CreateComment(ctx, data)
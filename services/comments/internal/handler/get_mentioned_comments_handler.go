@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"time"
+	pb "voidspace/comments/proto/generated/comments/v1"
+	utils "voidspace/comments/utils/common"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (ch *CommentHandler) GetMentionedComments(ctx context.Context, req *pb.GetMentionedCommentsRequest) (*pb.GetMentionedCommentsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, ch.Logger)
+	}
+
+	var cursorTime *time.Time
+	if req.GetCursorTime() != nil {
+		t := req.GetCursorTime().AsTime()
+		cursorTime = &t
+	}
+
+	comments, hasMore, err := ch.CommentUsecase.GetMentionedComments(ctx, userID, cursorTime, int(req.GetCursorId()))
+	if err != nil {
+		return nil, helper.HandleError(err, ch.Logger, "GetMentionedComments")
+	}
+
+	pbComments := make([]*pb.Comment, 0, len(comments))
+	for _, c := range comments {
+		pbComments = append(pbComments, utils.CommentMapper(c))
+	}
+
+	return &pb.GetMentionedCommentsResponse{
+		Comments: pbComments,
+		HasMore:  hasMore,
+	}, nil
+}
@@ -10,6 +10,22 @@ type CommentRepository struct {
 	db *pgxpool.Pool
 }
 
+// commentColumns is the select list shared by every comment read, comments
+// must be aliased as c.
+const commentColumns = `
+			c.id,
+			c.content,
+			c.post_id,
+			c.user_id,
+			c.created_at,
+			(SELECT COALESCE(jsonb_agg(jsonb_build_object(
+				'user_id', m.mentioned_user_id,
+				'start', m.byte_start,
+				'end', m.byte_end
+			) ORDER BY m.byte_start), '[]'::jsonb)
+			FROM comment_mentions m WHERE m.comment_id = c.id
+			) AS mentions`
+
 func NewCommentRepository(db *pgxpool.Pool) domain.CommentRepository {
 	return &CommentRepository{
 		db: db,
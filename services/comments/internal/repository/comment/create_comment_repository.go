@@ -3,6 +3,8 @@ package comment
 import (
 	"context"
 	"voidspace/comments/internal/domain"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // Create implements [domain.CommentRepository].
@@ -13,21 +15,49 @@ func (c *CommentRepository) Create(
 	query := `
 		INSERT INTO comments (user_id, post_id, content)
 		VALUES ($1, $2, $3)
-		RETURNING id, user_id, post_id, content
+		RETURNING id, user_id, post_id, content, created_at
+	`
+
+	queryMention := `
+		INSERT INTO comment_mentions (comment_id, mentioned_user_id, byte_start, byte_end, created_at)
+		SELECT $1, m.user_id, m.byte_start, m.byte_end, $5
+		FROM UNNEST($2::int[], $3::int[], $4::int[]) AS m(user_id, byte_start, byte_end)
 	`
 
-	err := c.db.QueryRow(
-		ctx,
-		query,
-		comment.UserID,
-		comment.PostID,
-		comment.Content,
-	).Scan(
-		&comment.ID,
-		&comment.UserID,
-		&comment.PostID,
-		&comment.Content,
-	)
+	err := pgx.BeginFunc(ctx, c.db, func(tx pgx.Tx) error {
+		err := tx.QueryRow(
+			ctx,
+			query,
+			comment.UserID,
+			comment.PostID,
+			comment.Content,
+		).Scan(
+			&comment.ID,
+			&comment.UserID,
+			&comment.PostID,
+			&comment.Content,
+			&comment.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(comment.Mentions) == 0 {
+			return nil
+		}
+
+		userIDs := make([]int, len(comment.Mentions))
+		starts := make([]int, len(comment.Mentions))
+		ends := make([]int, len(comment.Mentions))
+		for i, mention := range comment.Mentions {
+			userIDs[i] = mention.UserID
+			starts[i] = mention.Start
+			ends[i] = mention.End
+		}
+
+		_, err = tx.Exec(ctx, queryMention, comment.ID, userIDs, starts, ends, comment.CreatedAt)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
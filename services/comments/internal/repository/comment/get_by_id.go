@@ -15,12 +15,7 @@ func (c *CommentRepository) GetCommentByID(ctx context.Context, commentID int) (
 	var comment domain.Comment
 
 	query := `
-		SELECT 
-			c.id,
-			c.content,
-			c.post_id,
-			c.user_id,
-			c.created_at
+		SELECT ` + commentColumns + `
 		FROM comments c
 		WHERE c.id = $1 
 		AND c.deleted_at IS NULL
@@ -0,0 +1,50 @@
+package comment
+
+import (
+	"context"
+	"time"
+	"voidspace/comments/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetMentioning implements [domain.CommentRepository].
+func (c *CommentRepository) GetMentioning(
+	ctx context.Context,
+	userID int,
+	cursorTime time.Time,
+	cursorID int,
+) ([]*domain.Comment, bool, error) {
+	var comments []domain.Comment
+
+	// self mentions are left out
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments c
+		WHERE c.id IN (
+			SELECT m.comment_id FROM comment_mentions m
+			WHERE m.mentioned_user_id = $1
+			  AND ((m.created_at < $2) OR (m.created_at = $2 AND m.comment_id < $3))
+		)
+		AND c.deleted_at IS NULL AND c.user_id <> $1
+		ORDER BY c.created_at DESC, c.id DESC
+		LIMIT $4
+	`
+
+	err := pgxscan.Select(ctx, c.db, &comments, query, userID, cursorTime, cursorID, 10+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(comments) > 10
+	if hasMore {
+		comments = comments[:10]
+	}
+
+	result := make([]*domain.Comment, len(comments))
+	for i := range comments {
+		result[i] = &comments[i]
+	}
+
+	return result, hasMore, nil
+}
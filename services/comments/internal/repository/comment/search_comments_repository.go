@@ -14,12 +14,7 @@ func (c *CommentRepository) SearchComments(
 	var comments []domain.Comment
 
 	sqlQuery := `
-		SELECT 
-			c.id,
-			c.content,
-			c.post_id,
-			c.user_id,
-			c.created_at
+		SELECT ` + commentColumns + `
 		FROM comments c
 		WHERE c.content ILIKE '%' || $1 || '%'
 		AND c.deleted_at IS NULL
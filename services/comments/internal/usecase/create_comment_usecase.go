@@ -3,6 +3,9 @@ package usecase
 import (
 	"context"
 	"voidspace/comments/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+	"github.com/vhysxl/voidspace/shared/utils/helper"
 )
 
 // CreateComment implements [domain.CommentUsecase].
@@ -10,6 +13,18 @@ func (c *commentUsecase) CreateComment(
 	ctx context.Context,
 	comment *domain.Comment,
 ) (*domain.Comment, error) {
+	spans := make([]helper.MentionSpan, len(comment.Mentions))
+	for i, mention := range comment.Mentions {
+		if mention.UserID <= 0 {
+			return nil, constants.ErrInvalidMention
+		}
+		spans[i] = helper.MentionSpan{Start: mention.Start, End: mention.End}
+	}
+
+	if !helper.ValidMentions(comment.Content, spans) {
+		return nil, constants.ErrInvalidMention
+	}
+
 	comment, err := c.commentRepository.Create(ctx, comment)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"voidspace/comments/internal/domain"
+)
+
+// GetMentionedComments implements [domain.CommentUsecase].
+func (c *commentUsecase) GetMentionedComments(
+	ctx context.Context,
+	userID int,
+	cursorTime *time.Time,
+	cursorID int,
+) ([]*domain.Comment, bool, error) {
+	var cursor time.Time
+	if cursorTime != nil {
+		cursor = *cursorTime
+	} else {
+		cursor = time.Now()
+	}
+
+	return c.commentRepository.GetMentioning(ctx, userID, cursor, cursorID)
+}
@@ -25,6 +25,7 @@ const (
 	CommentService_GetAllCommentsByPostId_FullMethodName   = "/comments.v1.CommentService/GetAllCommentsByPostId"
 	CommentService_GetAllCommentsByUserId_FullMethodName   = "/comments.v1.CommentService/GetAllCommentsByUserId"
 	CommentService_GetFeedCommentCount_FullMethodName      = "/comments.v1.CommentService/GetFeedCommentCount"
+	CommentService_GetMentionedComments_FullMethodName     = "/comments.v1.CommentService/GetMentionedComments"
 	CommentService_HandleAccountDeletion_FullMethodName    = "/comments.v1.CommentService/HandleAccountDeletion"
 	CommentService_HandleAccountRestoration_FullMethodName = "/comments.v1.CommentService/HandleAccountRestoration"
 	CommentService_HandlePostDeletion_FullMethodName       = "/comments.v1.CommentService/HandlePostDeletion"
@@ -41,6 +42,7 @@ type CommentServiceClient interface {
 	GetAllCommentsByPostId(ctx context.Context, in *GetAllCommentsByPostIdRequest, opts ...grpc.CallOption) (*GetBatchCommentsResponse, error)
 	GetAllCommentsByUserId(ctx context.Context, in *GetAllCommentsByUserIdRequest, opts ...grpc.CallOption) (*GetBatchCommentsResponse, error)
 	GetFeedCommentCount(ctx context.Context, in *GetFeedCommentCountRequest, opts ...grpc.CallOption) (*GetFeedCommentCountResponse, error)
+	GetMentionedComments(ctx context.Context, in *GetMentionedCommentsRequest, opts ...grpc.CallOption) (*GetMentionedCommentsResponse, error)
 	// ---------------------- ACCOUNT LIFECYCLE ----------------------
 	HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	HandleAccountRestoration(ctx context.Context, in *HandleAccountRestorationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -107,6 +109,16 @@ func (c *commentServiceClient) GetFeedCommentCount(ctx context.Context, in *GetF
 	return out, nil
 }
 
+func (c *commentServiceClient) GetMentionedComments(ctx context.Context, in *GetMentionedCommentsRequest, opts ...grpc.CallOption) (*GetMentionedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMentionedCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetMentionedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -157,6 +169,7 @@ type CommentServiceServer interface {
 	GetAllCommentsByPostId(context.Context, *GetAllCommentsByPostIdRequest) (*GetBatchCommentsResponse, error)
 	GetAllCommentsByUserId(context.Context, *GetAllCommentsByUserIdRequest) (*GetBatchCommentsResponse, error)
 	GetFeedCommentCount(context.Context, *GetFeedCommentCountRequest) (*GetFeedCommentCountResponse, error)
+	GetMentionedComments(context.Context, *GetMentionedCommentsRequest) (*GetMentionedCommentsResponse, error)
 	// ---------------------- ACCOUNT LIFECYCLE ----------------------
 	HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error)
 	HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error)
@@ -188,6 +201,9 @@ func (UnimplementedCommentServiceServer) GetAllCommentsByUserId(context.Context,
 func (UnimplementedCommentServiceServer) GetFeedCommentCount(context.Context, *GetFeedCommentCountRequest) (*GetFeedCommentCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFeedCommentCount not implemented")
 }
+func (UnimplementedCommentServiceServer) GetMentionedComments(context.Context, *GetMentionedCommentsRequest) (*GetMentionedCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentionedComments not implemented")
+}
 func (UnimplementedCommentServiceServer) HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountDeletion not implemented")
 }
@@ -311,6 +327,24 @@ func _CommentService_GetFeedCommentCount_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_GetMentionedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMentionedCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetMentionedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetMentionedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetMentionedComments(ctx, req.(*GetMentionedCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_HandleAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HandleAccountDeletionRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +444,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFeedCommentCount",
 			Handler:    _CommentService_GetFeedCommentCount_Handler,
 		},
+		{
+			MethodName: "GetMentionedComments",
+			Handler:    _CommentService_GetMentionedComments_Handler,
+		},
 		{
 			MethodName: "HandleAccountDeletion",
 			Handler:    _CommentService_HandleAccountDeletion_Handler,
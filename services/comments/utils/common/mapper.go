@@ -12,11 +12,34 @@ func CommentMapper(comment *domain.Comment) *pb.Comment {
 		return &pb.Comment{}
 	}
 
+	mentions := make([]*pb.Mention, len(comment.Mentions))
+	for i, mention := range comment.Mentions {
+		mentions[i] = &pb.Mention{
+			UserId: int64(mention.UserID),
+			Start:  int32(mention.Start),
+			End:    int32(mention.End),
+		}
+	}
+
 	return &pb.Comment{
 		Id:        int64(comment.ID),
 		UserId:    int64(comment.UserID),
 		PostId:    int64(comment.PostID),
 		Content:   comment.Content,
 		CreatedAt: timestamppb.New(comment.CreatedAt),
+		Mentions:  mentions,
+	}
+}
+
+func MentionsMapper(pbMentions []*pb.Mention) []domain.Mention {
+	mentions := make([]domain.Mention, len(pbMentions))
+	for i, mention := range pbMentions {
+		mentions[i] = domain.Mention{
+			UserID: int(mention.GetUserId()),
+			Start:  int(mention.GetStart()),
+			End:    int(mention.GetEnd()),
+		}
 	}
+
+	return mentions
 }
@@ -3,17 +3,29 @@ package bootstrap
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 	"voidspace/posts/config"
 	"voidspace/posts/internal/domain"
+	bookmark_repo "voidspace/posts/internal/repository/bookmark"
+	comment_repo "voidspace/posts/internal/repository/comment"
+	follow_repo "voidspace/posts/internal/repository/follow"
+	hashtag_repo "voidspace/posts/internal/repository/hashtag"
 	like_repo "voidspace/posts/internal/repository/like"
 	post_repo "voidspace/posts/internal/repository/post"
+	repost_repo "voidspace/posts/internal/repository/repost"
+	bookmark_usecase "voidspace/posts/internal/usecase/bookmark"
+	hashtag_usecase "voidspace/posts/internal/usecase/hashtag"
 	like_usecase "voidspace/posts/internal/usecase/like"
 	post_usecase "voidspace/posts/internal/usecase/post"
+	repost_usecase "voidspace/posts/internal/usecase/repost"
+	commentpb "voidspace/posts/proto/generated/comments/v1"
+	userpb "voidspace/posts/proto/generated/users/v1"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	util_db "github.com/vhysxl/voidspace/shared/utils/database"
+	"github.com/vhysxl/voidspace/shared/utils/grpcclient"
 	"github.com/vhysxl/voidspace/shared/utils/helper"
 	"go.uber.org/zap"
 )
@@ -25,8 +37,11 @@ type Application struct {
 	DB                     *pgxpool.Pool
 	InstanceConnectionName string
 	// usecase
-	LikeUsecase domain.LikeUsecase
-	PostUsecase domain.PostUsecase
+	LikeUsecase     domain.LikeUsecase
+	PostUsecase     domain.PostUsecase
+	RepostUsecase   domain.RepostUsecase
+	BookmarkUsecase domain.BookmarkUsecase
+	HashtagUsecase  domain.HashtagUsecase
 }
 
 func App() (*Application, error) {
@@ -55,20 +70,73 @@ func App() (*Application, error) {
 		return nil, err
 	}
 
+	// follow relations live in the users service
+	userConn, err := grpcclient.NewConn(cfg.UserServiceAddr, cfg.Environment)
+	if err != nil {
+		logger.Error("Failed to connect to user service", zap.Error(err))
+		return nil, err
+	}
+
+	// comment counts feed the For You ranking
+	commentConn, err := grpcclient.NewConn(cfg.CommentServiceAddr, cfg.Environment)
+	if err != nil {
+		logger.Error("Failed to connect to comment service", zap.Error(err))
+		return nil, err
+	}
+
 	likeRepo := like_repo.NewLikeRepository(db)
 	postRepo := post_repo.NewPostRepository(db)
+	repostRepo := repost_repo.NewRepostRepository(db)
+	bookmarkRepo := bookmark_repo.NewBookmarkRepository(db)
+	hashtagRepo := hashtag_repo.NewHashtagRepository(db)
+	followRepo := follow_repo.NewFollowRepository(userpb.NewUserServiceClient(userConn))
+	commentRepo := comment_repo.NewCommentRepository(commentpb.NewCommentServiceClient(commentConn))
 
-	likeUsecase := like_usecase.NewLikeUsecase(likeRepo, time.Duration(cfg.ContextTimeout)*time.Second)
-	postUsecase := post_usecase.NewPostUsecase(postRepo, likeRepo, time.Duration(cfg.ContextTimeout)*time.Second)
+	likeUsecase, err := like_usecase.NewLikeUsecase(
+		likeRepo,
+		postRepo,
+		followRepo,
+		strings.Split(cfg.Reactions, ","),
+		time.Duration(cfg.ContextTimeout)*time.Second,
+	)
+	if err != nil {
+		logger.Error("Invalid REACTIONS", zap.Error(err))
+		return nil, err
+	}
+	postUsecase := post_usecase.NewPostUsecase(
+		postRepo,
+		likeRepo,
+		repostRepo,
+		bookmarkRepo,
+		followRepo,
+		commentRepo,
+		domain.RankingConfig{
+			FollowedWeight:   cfg.ForYouFollowedWeight,
+			EngagementWeight: cfg.ForYouEngagementWeight,
+			AffinityWeight:   cfg.ForYouAffinityWeight,
+			HalfLife:         time.Duration(cfg.ForYouHalfLife) * time.Hour,
+			MaxPerAuthor:     cfg.ForYouMaxPerAuthor,
+			Window:           time.Duration(cfg.ForYouWindow) * time.Hour,
+		},
+		time.Duration(cfg.EditWindow)*time.Minute,
+		cfg.MaxEdits,
+		time.Duration(cfg.ContextTimeout)*time.Second,
+	)
+	repostUsecase := repost_usecase.NewRepostUsecase(repostRepo, postRepo, followRepo, time.Duration(cfg.ContextTimeout)*time.Second)
+	bookmarkUsecase := bookmark_usecase.NewBookmarkUsecase(bookmarkRepo, postRepo, followRepo, time.Duration(cfg.ContextTimeout)*time.Second)
+	hashtagUsecase := hashtag_usecase.NewHashtagUsecase(hashtagRepo, time.Duration(cfg.TrendingWindow)*time.Minute, time.Duration(cfg.ContextTimeout)*time.Second)
 
 	logger.Info("Application bootstrapped successfully")
 
 	return &Application{
-		Config:         cfg,
-		ContextTimeout: time.Duration(cfg.ContextTimeout) * time.Second,
-		Logger:         logger,
-		DB:             db,
-		LikeUsecase:    likeUsecase,
-		PostUsecase:    postUsecase,
+		Config:          cfg,
+		ContextTimeout:  time.Duration(cfg.ContextTimeout) * time.Second,
+		Logger:          logger,
+		DB:              db,
+		LikeUsecase:     likeUsecase,
+		PostUsecase:     postUsecase,
+		RepostUsecase:   repostUsecase,
+		BookmarkUsecase: bookmarkUsecase,
+		HashtagUsecase:  hashtagUsecase,
 	}, nil
 }
@@ -10,6 +10,29 @@ type Config struct {
 	Port           string
 	DBConnString   string
 	ContextTimeout int
+	// TrendingWindow is the sliding window in minutes trending hashtags are
+	// ranked over
+	TrendingWindow int
+	// EditWindow is how long in minutes after posting a post can be edited
+	EditWindow int
+	MaxEdits   int
+	// UserServiceAddr is where follow relations are looked up to decide who
+	// may see followers only posts
+	UserServiceAddr string
+	// CommentServiceAddr is where comment counts are looked up to rank the
+	// For You feed
+	CommentServiceAddr string
+	Environment        string
+	// Reactions is the comma separated reaction set in display order, like is
+	// always part of it
+	Reactions string
+	// ForYou* tune the For You feed ranking, see domain.RankingConfig
+	ForYouFollowedWeight   float64
+	ForYouEngagementWeight float64
+	ForYouAffinityWeight   float64
+	ForYouHalfLife         int // hours
+	ForYouMaxPerAuthor     int
+	ForYouWindow           int // hours
 }
 
 var (
@@ -27,8 +50,21 @@ func GetConfig() *Config {
 
 func initConfig() Config {
 	return Config{
-		Port:           helper.GetEnv("PORT", "8080"),
-		DBConnString:   helper.GetEnv("DB_CONN", "postgres"),
-		ContextTimeout: helper.GetEnvInt("CONTEXT_TIMEOUT", 10),
+		Port:                   helper.GetEnv("PORT", "8080"),
+		DBConnString:           helper.GetEnv("DB_CONN", "postgres"),
+		ContextTimeout:         helper.GetEnvInt("CONTEXT_TIMEOUT", 10),
+		TrendingWindow:         helper.GetEnvInt("TRENDING_WINDOW_MINUTES", 60),
+		EditWindow:             helper.GetEnvInt("EDIT_WINDOW_MINUTES", 60),
+		MaxEdits:               helper.GetEnvInt("MAX_POST_EDITS", 5),
+		UserServiceAddr:        helper.GetEnv("USER_SERVICE_URL", "localhost:8080"),
+		CommentServiceAddr:     helper.GetEnv("COMMENT_SERVICE_URL", "localhost:8082"),
+		Environment:            helper.GetEnv("ENV", "PROD"),
+		Reactions:              helper.GetEnv("REACTIONS", "like,love,haha,wow,sad,angry"),
+		ForYouFollowedWeight:   helper.GetEnvFloat("FOR_YOU_FOLLOWED_WEIGHT", 1.5),
+		ForYouEngagementWeight: helper.GetEnvFloat("FOR_YOU_ENGAGEMENT_WEIGHT", 1),
+		ForYouAffinityWeight:   helper.GetEnvFloat("FOR_YOU_AFFINITY_WEIGHT", 0.5),
+		ForYouHalfLife:         helper.GetEnvInt("FOR_YOU_HALF_LIFE_HOURS", 12),
+		ForYouMaxPerAuthor:     helper.GetEnvInt("FOR_YOU_MAX_PER_AUTHOR", 2),
+		ForYouWindow:           helper.GetEnvInt("FOR_YOU_WINDOW_HOURS", 48),
 	}
 }
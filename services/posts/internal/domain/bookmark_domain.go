@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Bookmark is a private save of a post, a nil Folder means unfiled.
+type Bookmark struct {
+	PostID    int
+	UserID    int
+	Folder    *string
+	CreatedAt time.Time
+}
+
+type BookmarkFolder struct {
+	Name           string
+	BookmarksCount int
+}
+
+type BookmarkUsecase interface {
+	AddBookmark(ctx context.Context, bookmark *Bookmark) error
+	RemoveBookmark(ctx context.Context, bookmark *Bookmark) error
+	GetBookmarkFolders(ctx context.Context, userID int) ([]BookmarkFolder, error)
+}
+
+type BookmarkRepository interface {
+	// AddBookmark moves an existing bookmark to bookmark.Folder
+	AddBookmark(ctx context.Context, bookmark *Bookmark) error
+	RemoveBookmark(ctx context.Context, bookmark *Bookmark) error
+	GetFolders(ctx context.Context, userID int) ([]BookmarkFolder, error)
+
+	IsPostsBookmarkedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error)
+}
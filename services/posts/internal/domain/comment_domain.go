@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+// CommentRepository looks up comments owned by the comments service.
+type CommentRepository interface {
+	// CountComments returns the comment counts of postIDs keyed by post id,
+	// posts without comments are left out
+	CountComments(ctx context.Context, postIDs []int) (map[int]int, error)
+}
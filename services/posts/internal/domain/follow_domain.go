@@ -0,0 +1,9 @@
+package domain
+
+import "context"
+
+// FollowRepository looks up follow relations owned by the users service.
+type FollowRepository interface {
+	// FilterFollowing reports which of targetUserIDs userID follows
+	FilterFollowing(ctx context.Context, userID int, targetUserIDs []int) (map[int]bool, error)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type TrendingHashtag struct {
+	Tag        string
+	Uses       int
+	Score      float64
+	ComputedAt time.Time
+}
+
+type HashtagUsecase interface {
+	GetTrendingHashtags(ctx context.Context, limit int) ([]TrendingHashtag, error)
+	RefreshTrendingHashtags(ctx context.Context) error
+}
+
+type HashtagRepository interface {
+	GetTrending(ctx context.Context, limit int) ([]TrendingHashtag, error)
+	// RefreshTrending replaces the trending snapshot, see the implementation
+	// for how tags are scored.
+	RefreshTrending(ctx context.Context, window, baseline time.Duration, minUses, limit int) error
+}
@@ -5,21 +5,75 @@ import (
 	"time"
 )
 
+// DefaultReaction is the reaction a like is, every reaction set includes it.
+const DefaultReaction = "like"
+
+// MaxReactionLength is the size of the post_likes.reaction column in
+// characters.
+const MaxReactionLength = 32
+
+// Like is a reaction to a post, a user has at most one per post.
 type Like struct {
 	PostID    int
 	UserID    int
+	Reaction  string
 	CreatedAt time.Time
 }
 
+// ReactionCount is how many users reacted to a post with Reaction.
+type ReactionCount struct {
+	Reaction string
+	Count    int
+}
+
+// Liker is a user who liked a post, IsFollowed is whether the viewer follows
+// them.
+type Liker struct {
+	UserID     int
+	LikedAt    time.Time
+	IsFollowed bool
+}
+
+// LikerCursor is where a page of likers continues. Likers the viewer follows
+// are listed before the rest, Followed is which of the two runs the cursor
+// is in.
+type LikerCursor struct {
+	LikedAt  time.Time
+	UserID   int
+	Followed bool
+}
+
 type LikeUsecase interface {
+	// ReactToPost sets the user's reaction, replacing the one they had
+	ReactToPost(ctx context.Context, like *Like) error
+	RemoveReaction(ctx context.Context, like *Like) error
+	// ListReactions returns the configured reaction set, default first
+	ListReactions() []string
+	// LikePost and UnlikePost react with and remove the default reaction
 	LikePost(ctx context.Context, like *Like) error
 	UnlikePost(ctx context.Context, like *Like) error
+	// ReconcileLikesCount returns how many posts had a drifted likes_count
+	ReconcileLikesCount(ctx context.Context) (int, error)
 }
 
 type LikeRepository interface {
-	LikePost(ctx context.Context, like *Like) error
-	UnlikePost(ctx context.Context, like *Like) error
+	// React and RemoveReaction keep posts.likes_count, the count of all
+	// reactions, in the same transaction
+	React(ctx context.Context, like *Like) error
+	RemoveReaction(ctx context.Context, like *Like) error
+	// ReconcileLikesCount recounts likes_count from post_likes batchSize posts
+	// at a time and returns how many were fixed
+	ReconcileLikesCount(ctx context.Context, batchSize int) (int, error)
 
 	IsPostLikedByUser(ctx context.Context, userID, postID int) (bool, error)
 	IsPostsLikedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error)
+	// GetReactionCounts returns the per reaction counts of postIDs, most used
+	// first
+	GetReactionCounts(ctx context.Context, postIDs []int) (map[int][]ReactionCount, error)
+	// GetUserReactions returns userID's reaction to each of postIDs they
+	// reacted to
+	GetUserReactions(ctx context.Context, userID int, postIDs []int) (map[int]string, error)
+
+	// GetLikers lists the users who liked postID by like time
+	GetLikers(ctx context.Context, postID int, cursorTime time.Time, cursorID int, limit int) ([]Liker, bool, error)
 }
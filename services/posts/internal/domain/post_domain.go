@@ -6,22 +6,243 @@ import (
 )
 
 type Post struct {
-	ID         int
+	ID            int
+	Content       string
+	UserID        int
+	PostImages    []PostImage
+	LikesCount    int
+	ReplyToPostID *int
+	RootPostID    *int
+	RepliesCount  int
+	RepostsCount  int
+	QuotesCount   int
+	QuotePostID   *int
+	EditCount     int
+	Visibility    string
+	// ContentWarning is shown in place of the content until the viewer
+	// expands the post, nil when there is none
+	ContentWarning *string
+	// Hashtags is parsed from Content on write, reads leave it empty
+	Hashtags []string
+	Mentions []Mention
+	// Entities is tokenized from Content on write, posts written before
+	// entities were stored scan as nil
+	Entities []Entity
+	// QuotedPost is filled by the usecase, not scanned
+	QuotedPost *Post `db:"-"`
+	// Poll is filled by the usecase, not scanned
+	Poll *Poll `db:"-"`
+	// LinkPreview is filled by the usecase once the gateway has unfurled the
+	// first link, not scanned
+	LinkPreview *LinkPreview `db:"-"`
+	// Reactions and ViewerReaction are filled by the usecase, LikesCount is
+	// their total
+	Reactions      []ReactionCount `db:"-"`
+	ViewerReaction string          `db:"-"`
+	// RepostedBy and RepostedAt are only set on feed entries that show up
+	// because someone reposted the post
+	RepostedBy *int
+	RepostedAt *time.Time
+	// BookmarkedAt and BookmarkFolder are only set on posts listed from the
+	// viewer's bookmarks
+	BookmarkedAt   *time.Time
+	BookmarkFolder *string
+	// LikedAt is only set on posts listed from a user's likes
+	LikedAt      *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	IsLiked      bool
+	IsOwner      bool
+	IsReposted   bool
+	IsBookmarked bool
+	IsPinned     bool
+}
+
+// Thread is a post with the chain of posts it replies to and a page of the
+// replies below it.
+type Thread struct {
+	Post      *Post
+	Ancestors []Post
+	Replies   []Post
+	HasMore   bool
+}
+
+// ScheduledPost is a post waiting for PublishAt. It lives apart from posts so
+// no read path can show it before it is published.
+type ScheduledPost struct {
+	ID            int
+	UserID        int
+	Content       string
+	PostImages    []PostImage
+	ReplyToPostID *int
+	QuotePostID   *int
+	Mentions      []Mention
+	Visibility    string
+	PublishAt     time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	// ContentWarning carries over to the published post
+	ContentWarning *string
+}
+
+// Draft is a half-written post kept for its author only.
+type Draft struct {
+	ID             int
+	UserID         int
+	Content        string
+	PostImages     []PostImage
+	ReplyToPostID  *int
+	Mentions       []Mention
+	Visibility     string
+	ContentWarning *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// PostRevision is a version of a post before an edit, Version 1 is the
+// original and CreatedAt is when that version was written.
+type PostRevision struct {
+	Version    int
 	Content    string
-	UserID     int
 	PostImages []PostImage
-	LikesCount int
+	Entities   []Entity
 	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	IsLiked    bool
-	IsOwner    bool
 }
 
+// PostHistory is the earlier versions of a post with the warning the post
+// has now.
+type PostHistory struct {
+	ContentWarning *string
+	Revisions      []PostRevision
+}
+
+// Poll is attached to a post when it is created and closes at EndsAt.
+// VotesCount on the options is only meaningful when TalliesVisible is set,
+// which is once the viewer has voted or the poll has closed.
+type Poll struct {
+	PostID         int
+	Options        []PollOption
+	MultipleChoice bool
+	EndsAt         time.Time
+	ClosedAt       *time.Time
+	VotersCount    int
+	Voted          bool
+	TalliesVisible bool
+}
+
+// Closed reports whether the poll no longer takes votes, the close timer may
+// run a little after EndsAt.
+func (p *Poll) Closed(now time.Time) bool {
+	return p.ClosedAt != nil || !now.Before(p.EndsAt)
+}
+
+// LinkPreview is the OpenGraph or Twitter card metadata of a URL, cached by
+// URL and shared by every post linking to it.
+type LinkPreview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+	FetchedAt   time.Time
+}
+
+type PollOption struct {
+	ID         int
+	Position   int
+	Label      string
+	VotesCount int
+	IsVoted    bool
+}
+
+// Who a post is shown to. The author and the users it mentions always see
+// it, unlisted posts are open to anyone with the link but left out of feeds
+// and search.
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityMentioned = "mentioned"
+	VisibilityUnlisted  = "unlisted"
+)
+
+// VisibleTo reports whether viewerID may see the post, a nil viewer is a
+// guest. followsAuthor only matters for followers only posts the viewer
+// neither wrote nor is mentioned in.
+func (p *Post) VisibleTo(viewerID *int, followsAuthor bool) bool {
+	if p.Visibility == VisibilityPublic || p.Visibility == VisibilityUnlisted {
+		return true
+	}
+
+	if viewerID == nil {
+		return false
+	}
+
+	if p.Involves(*viewerID) {
+		return true
+	}
+
+	return p.Visibility == VisibilityFollowers && followsAuthor
+}
+
+// Involves reports whether userID wrote the post or is mentioned in it.
+func (p *Post) Involves(userID int) bool {
+	if p.UserID == userID {
+		return true
+	}
+
+	for _, mention := range p.Mentions {
+		if mention.UserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Mention is a resolved @username, Start and End are byte offsets into the
+// content.
+type Mention struct {
+	UserID int `json:"user_id"`
+	Start  int `json:"start"`
+	End    int `json:"end"`
+}
+
+const (
+	EntityURL     = "url"
+	EntityMention = "mention"
+	EntityHashtag = "hashtag"
+	EntityCashtag = "cashtag"
+)
+
+// Entity is a typed span of the content. Start and End are byte offsets,
+// Utf16Start and Utf16End are UTF-16 code unit offsets.
+type Entity struct {
+	Type       string `json:"type"`
+	Text       string `json:"text"`
+	Start      int    `json:"start"`
+	End        int    `json:"end"`
+	Utf16Start int    `json:"utf16_start"`
+	Utf16End   int    `json:"utf16_end"`
+	UserID     *int   `json:"user_id,omitempty"`
+}
+
+// PostImage is stored as json on the post, images written before Sensitive
+// existed read as not sensitive.
 type PostImage struct {
-	Url    string
-	Order  int
-	Width  int
-	Height int
+	Url       string
+	Order     int
+	Width     int
+	Height    int
+	Sensitive bool
+}
+
+// ContentFlags are a moderator's changes to a post, nil and absent fields
+// are left as they are.
+type ContentFlags struct {
+	// ContentWarning replaces the post's warning, an empty one removes it
+	ContentWarning *string
+	// SensitiveImages flags or clears images by their Order
+	SensitiveImages map[int]bool
 }
 
 type PostUsecase interface {
@@ -30,14 +251,72 @@ type PostUsecase interface {
 	GetPost(ctx context.Context, postID int, loggedInUserID *int) (*Post, error)
 	UpdatePost(ctx context.Context, post *Post, loggedInUserID int) error
 	DeletePost(ctx context.Context, postID int, loggedInUserID int) error
+	GetThread(ctx context.Context, postID int, cursorTime *time.Time, cursorID int, loggedInUserID *int) (*Thread, error)
+	GetPostRevisions(ctx context.Context, postID int, loggedInUserID *int) (*PostHistory, error)
+
+	// Scheduled posts
+	SchedulePost(ctx context.Context, post *ScheduledPost) (*ScheduledPost, error)
+	GetScheduledPosts(ctx context.Context, userID int) ([]ScheduledPost, error)
+	UpdateScheduledPost(ctx context.Context, post *ScheduledPost, loggedInUserID int) (*ScheduledPost, error)
+	CancelScheduledPost(ctx context.Context, scheduledPostID int, loggedInUserID int) error
+	PublishScheduledPost(ctx context.Context, scheduledPostID int) (*Post, error)
+
+	// Drafts
+	SaveDraft(ctx context.Context, draft *Draft) (*Draft, error)
+	GetDrafts(ctx context.Context, userID int) ([]Draft, error)
+	DeleteDraft(ctx context.Context, draftID int, loggedInUserID int) error
+	PublishDraft(ctx context.Context, draftID int, loggedInUserID int) (*Post, error)
+
+	// Pins
+	PinPost(ctx context.Context, postID int, loggedInUserID int) error
+	UnpinPost(ctx context.Context, postID int, loggedInUserID int) error
+
+	// Moderation
+	// SetContentFlags applies flags to postID without counting as an edit
+	SetContentFlags(ctx context.Context, postID int, flags *ContentFlags) (*Post, error)
+
+	// Likers
+	// ListPostLikers pages the users who liked postID, the ones
+	// loggedInUserID follows first and then the rest, each by like time. The
+	// cursor is nil once both runs are done
+	ListPostLikers(ctx context.Context, postID int, cursor *LikerCursor, loggedInUserID *int) ([]Liker, *LikerCursor, bool, error)
+
+	// Polls
+	VotePoll(ctx context.Context, postID int, optionIDs []int, loggedInUserID int) (*Poll, error)
+	// ClosePoll closes the poll, closing it again is not an error
+	ClosePoll(ctx context.Context, postID int) error
+	// ListPollVoters pages the voters of postID by user id after cursorID
+	ListPollVoters(ctx context.Context, postID int, cursorID int) ([]int, bool, error)
+
+	// Link previews
+	// GetLinkPreview returns the cached preview of url, ErrLinkPreviewNotFound
+	// when there is none or it is too old to reuse
+	GetLinkPreview(ctx context.Context, url string) (*LinkPreview, error)
+	// SetLinkPreview caches preview and attaches it to postID
+	SetLinkPreview(ctx context.Context, postID int, preview *LinkPreview) error
 
 	// User posts operations
-	GetUserPosts(ctx context.Context, userID int, loggedInUserID *int) ([]Post, error)
-	GetLikedPosts(ctx context.Context, userID int, loggedInUserID *int) ([]Post, error)
+	GetUserPosts(ctx context.Context, userID int, cursorTime *time.Time, cursorID int, loggedInUserID *int) ([]Post, bool, error)
+	GetLikedPosts(ctx context.Context, userID int, cursorTime *time.Time, cursorID int, loggedInUserID *int) ([]Post, bool, error)
+	GetBookmarks(ctx context.Context, userID int, folder *string, cursorTime *time.Time, cursorID int) ([]Post, bool, error)
 
 	// Feed operations
 	GetGlobalFeed(ctx context.Context, cursorTime *time.Time, cursorID int, loggedInUserID *int) ([]Post, bool, error)
 	GetFollowingFeed(ctx context.Context, cursorTime *time.Time, cursorID int, loggedInUserID int, userIDs []int) ([]Post, bool, error)
+	GetHashtagFeed(ctx context.Context, tag string, cursorTime *time.Time, cursorID int, loggedInUserID *int) ([]Post, bool, error)
+	GetMentions(ctx context.Context, cursorTime *time.Time, cursorID int, loggedInUserID int) ([]Post, bool, error)
+	// GetForYouFeed ranks a new snapshot for loggedInUserID when snapshotID
+	// is nil and returns the page at offset
+	GetForYouFeed(ctx context.Context, snapshotID *int, offset int, loggedInUserID int) (*ForYouPage, error)
+
+	// Home timeline
+	// GetHomeTimeline fails with ErrTimelineNotBuilt until the timeline of
+	// loggedInUserID has been rebuilt from their follows
+	GetHomeTimeline(ctx context.Context, cursorTime *time.Time, cursorID int, loggedInUserID int) ([]Post, bool, error)
+	FanOutPost(ctx context.Context, postID int, repostedBy *int, userIDs []int) error
+	BackfillTimeline(ctx context.Context, userID int, authorIDs []int, markBuilt bool) error
+	ClearTimelineSource(ctx context.Context, userID int, sourceID int) error
+	SetTimelineCelebrity(ctx context.Context, userID int, celebrity bool) error
 
 	// Account lifecycle
 	HandleAccountDeletion(ctx context.Context, userID int) error
@@ -50,16 +329,119 @@ type PostRepository interface {
 	// Single post CRUD
 	Create(ctx context.Context, post *Post) (*Post, error)
 	GetByID(ctx context.Context, postID int) (*Post, error)
-	Update(ctx context.Context, post *Post) error
+	GetByIDs(ctx context.Context, postIDs []int) ([]Post, error)
+	// Update fails with ErrEditLimitReached once the post has maxEdits edits
+	// and with ErrEditWindowClosed once it is older than editWindow.
+	Update(ctx context.Context, post *Post, maxEdits int, editWindow time.Duration) error
 	Delete(ctx context.Context, postID int) error
 
+	// Edit history
+	GetRevisions(ctx context.Context, postID int) ([]PostRevision, error)
+
+	// Scheduled posts
+	CreateScheduled(ctx context.Context, post *ScheduledPost) (*ScheduledPost, error)
+	GetScheduledByID(ctx context.Context, scheduledPostID int) (*ScheduledPost, error)
+	GetScheduledByUserID(ctx context.Context, userID int) ([]ScheduledPost, error)
+	UpdateScheduled(ctx context.Context, post *ScheduledPost) (*ScheduledPost, error)
+	DeleteScheduled(ctx context.Context, scheduledPostID int) error
+	// PublishScheduled removes the scheduled post and creates post in one
+	// transaction
+	PublishScheduled(ctx context.Context, scheduledPostID int, post *Post) (*Post, error)
+
+	// Drafts
+	CreateDraft(ctx context.Context, draft *Draft) (*Draft, error)
+	UpdateDraft(ctx context.Context, draft *Draft) (*Draft, error)
+	GetDraftByID(ctx context.Context, draftID int) (*Draft, error)
+	GetDraftsByUserID(ctx context.Context, userID int) ([]Draft, error)
+	DeleteDraft(ctx context.Context, draftID int) error
+	// PublishDraft removes the draft and creates post in one transaction
+	PublishDraft(ctx context.Context, draftID int, post *Post) (*Post, error)
+
+	// Pins
+	// PinPost fails with ErrPinLimitReached once userID has maxPins pins,
+	// pinning a pinned post again is a no-op
+	PinPost(ctx context.Context, userID int, postID int, maxPins int) error
+	UnpinPost(ctx context.Context, userID int, postID int) error
+	// GetPinnedByUserID returns userID's pinned posts, most recently pinned
+	// first
+	GetPinnedByUserID(ctx context.Context, userID int) ([]Post, error)
+
+	// Polls
+	// GetPolls returns the polls on postIDs keyed by post id, Voted and the
+	// options IsVoted are filled for userID when set
+	GetPolls(ctx context.Context, postIDs []int, userID *int) (map[int]*Poll, error)
+	VotePoll(ctx context.Context, postID int, userID int, optionIDs []int) error
+	// ClosePoll marks the poll closed, an already closed poll is left as is
+	ClosePoll(ctx context.Context, postID int) error
+	// GetPollVoters returns up to limit voters of postID with a user id above
+	// cursorID, lowest first
+	GetPollVoters(ctx context.Context, postID int, cursorID int, limit int) ([]int, error)
+
+	// Moderation
+	// SetContentFlags overwrites the content warning and images of postID
+	SetContentFlags(ctx context.Context, postID int, contentWarning *string, images []PostImage) error
+
+	// Link previews
+	GetLinkPreview(ctx context.Context, url string) (*LinkPreview, error)
+	// SetLinkPreview upserts preview by URL and points postID at it
+	SetLinkPreview(ctx context.Context, postID int, preview *LinkPreview) error
+	// GetLinkPreviews returns the previews attached to postIDs keyed by post id
+	GetLinkPreviews(ctx context.Context, postIDs []int) (map[int]*LinkPreview, error)
+
+	// Threads
+	GetAncestors(ctx context.Context, postID int) ([]Post, error)
+	GetReplies(ctx context.Context, postID int, cursorTime time.Time, cursorID int, limit int) ([]Post, error)
+	GetDescendants(ctx context.Context, parentIDs []int, maxDepth int, limit int) ([]Post, error)
+
 	// Bulk query operations
-	GetByUserID(ctx context.Context, userID int) ([]Post, error)
-	GetLikedByUserID(ctx context.Context, userID int) ([]Post, error)
+	// GetByUserID lists userID's posts and reposts that viewerID may see,
+	// followsAuthor is whether viewerID follows userID. Pinned posts are left
+	// out, reposts of them are not.
+	GetByUserID(ctx context.Context, userID int, viewerID *int, followsAuthor bool, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+	// GetLikedByUserID lists the posts userID liked by like time
+	GetLikedByUserID(ctx context.Context, userID int, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+	// GetBookmarked lists userID's bookmarks by bookmark time, every folder
+	// when folder is nil
+	GetBookmarked(ctx context.Context, userID int, folder *string, cursorTime time.Time, cursorID int) ([]Post, bool, error)
 
 	// Feed operations
 	GetGlobalFeed(ctx context.Context, cursorTime time.Time, cursorID int) ([]Post, bool, error)
-	GetFollowingFeed(ctx context.Context, userIDs []int, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+	// GetFollowingFeed lists posts and reposts by userIDs that viewerID may
+	// see, userIDs must be users viewerID follows
+	GetFollowingFeed(ctx context.Context, viewerID int, userIDs []int, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+	GetHashtagFeed(ctx context.Context, tag string, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+	GetMentioning(ctx context.Context, userID int, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+
+	// For You feed
+	// GetRankCandidates returns the top level posts written since that
+	// viewerID may see in a feed: up to limit of the fastest liked, and up to
+	// reserved more each by authors they follow and authors they interact
+	// with. Followers only posts come back with FollowersOnly set for the
+	// caller to check
+	GetRankCandidates(ctx context.Context, viewerID int, since time.Time, limit int, reserved int) ([]RankCandidate, error)
+	// CreateFeedSnapshot stores the ranked postIDs and drops snapshots older
+	// than ttl
+	CreateFeedSnapshot(ctx context.Context, userID int, postIDs []int, ttl time.Duration) (int, error)
+	// GetFeedSnapshot fails with ErrFeedSnapshotExpired when userID has no
+	// snapshot snapshotID younger than ttl
+	GetFeedSnapshot(ctx context.Context, snapshotID int, userID int, ttl time.Duration) ([]int, error)
+
+	// Home timeline
+	// GetHomeTimeline merges the timeline of viewerID with the posts and
+	// reposts of celebrityIDs, which must be users viewerID follows.
+	// Followers only posts are not checked against viewerID's follows.
+	GetHomeTimeline(ctx context.Context, viewerID int, celebrityIDs []int, cursorTime time.Time, cursorID int) ([]Post, bool, error)
+	// FanOutPost adds postID to the timelines of userIDs, as a repost by
+	// repostedBy when set. It does nothing once the post or repost is gone.
+	FanOutPost(ctx context.Context, postID int, repostedBy *int, userIDs []int) error
+	// BackfillTimeline adds the latest perAuthor posts and reposts of each of
+	// authorIDs to the timeline of userID, celebrities are skipped
+	BackfillTimeline(ctx context.Context, userID int, authorIDs []int, perAuthor int) error
+	ClearTimelineSource(ctx context.Context, userID int, sourceID int) error
+	IsTimelineBuilt(ctx context.Context, userID int) (bool, error)
+	MarkTimelineBuilt(ctx context.Context, userID int) error
+	ListTimelineCelebrities(ctx context.Context) ([]int, error)
+	SetTimelineCelebrity(ctx context.Context, userID int, celebrity bool) error
 
 	// Account lifecycle (atomic operations with transaction)
 	HandleAccountDeletion(ctx context.Context, userID int) error
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// RankingConfig tunes the For You feed, it is read from the environment so
+// weights tuned offline can be rolled out without a build.
+type RankingConfig struct {
+	// FollowedWeight is added to the score of posts by followed authors
+	FollowedWeight float64
+	// EngagementWeight scales the log of likes, comments, reposts and quotes
+	// per hour since posting
+	EngagementWeight float64
+	// AffinityWeight scales the log of the viewer's recent likes, reposts
+	// and replies on the author's posts
+	AffinityWeight float64
+	// HalfLife is the age at which a post's score has halved
+	HalfLife time.Duration
+	// MaxPerAuthor caps the posts by one author on a page, zero for no cap
+	MaxPerAuthor int
+	// Window is how far back candidates are taken from
+	Window time.Duration
+}
+
+// RankCandidate is a post considered for the For You feed with the signals
+// it is scored on.
+type RankCandidate struct {
+	PostID    int
+	UserID    int
+	CreatedAt time.Time
+	// FollowersOnly is set when only following the author lets the viewer
+	// see the post
+	FollowersOnly bool
+	LikesCount    int
+	RepliesCount  int
+	RepostsCount  int
+	QuotesCount   int
+	// Affinity counts the viewer's recent interactions with the author
+	Affinity int
+	// Followed and CommentsCount are filled in by the usecase, not scanned
+	Followed      bool `db:"-"`
+	CommentsCount int  `db:"-"`
+}
+
+// ForYouPage is a page of a ranked snapshot, the next page is read from
+// SnapshotID at NextOffset. SnapshotID is zero when there was nothing to
+// rank.
+type ForYouPage struct {
+	Posts      []Post
+	SnapshotID int
+	NextOffset int
+	HasMore    bool
+}
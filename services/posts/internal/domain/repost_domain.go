@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Repost struct {
+	PostID    int
+	UserID    int
+	CreatedAt time.Time
+}
+
+type RepostUsecase interface {
+	Repost(ctx context.Context, repost *Repost) error
+	UndoRepost(ctx context.Context, repost *Repost) error
+}
+
+type RepostRepository interface {
+	Repost(ctx context.Context, repost *Repost) error
+	UndoRepost(ctx context.Context, repost *Repost) error
+
+	IsPostsRepostedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) AddBookmark(
+	ctx context.Context,
+	req *pb.AddBookmarkRequest,
+) (*emptypb.Empty, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Add Bookmark")
+	}
+
+	bookmark := &domain.Bookmark{
+		PostID: int(req.GetPostId()),
+		UserID: userID,
+		Folder: req.Folder,
+	}
+
+	err = h.BookmarkUsecase.AddBookmark(ctx, bookmark)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Add Bookmark")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) BackfillTimeline(
+	ctx context.Context,
+	req *pb.BackfillTimelineRequest,
+) (*emptypb.Empty, error) {
+	authorIDs := make([]int, len(req.GetAuthorIds()))
+	for i, id := range req.GetAuthorIds() {
+		authorIDs[i] = int(id)
+	}
+
+	err := h.PostUsecase.BackfillTimeline(ctx, int(req.GetUserId()), authorIDs, req.GetMarkBuilt())
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Backfill Timeline")
+	}
+
+	return &emptypb.Empty{}, nil
+}
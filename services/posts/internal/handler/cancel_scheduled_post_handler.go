@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) CancelScheduledPost(
+	ctx context.Context,
+	req *pb.ScheduledPostRequest,
+) (*emptypb.Empty, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Cancel Scheduled Post")
+	}
+
+	err = h.PostUsecase.CancelScheduledPost(ctx, int(req.GetScheduledPostId()), userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Cancel Scheduled Post")
+	}
+
+	return &emptypb.Empty{}, nil
+}
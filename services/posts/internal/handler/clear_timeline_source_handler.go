@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ClearTimelineSource(
+	ctx context.Context,
+	req *pb.ClearTimelineSourceRequest,
+) (*emptypb.Empty, error) {
+	err := h.PostUsecase.ClearTimelineSource(ctx, int(req.GetUserId()), int(req.GetSourceId()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Clear Timeline Source")
+	}
+
+	return &emptypb.Empty{}, nil
+}
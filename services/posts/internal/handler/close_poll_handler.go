@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ClosePoll(
+	ctx context.Context,
+	req *pb.ClosePollRequest,
+) (*emptypb.Empty, error) {
+	err := h.PostUsecase.ClosePoll(ctx, int(req.GetPostId()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Close Poll")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *PostHandler) ListPollVoters(
+	ctx context.Context,
+	req *pb.ListPollVotersRequest,
+) (*pb.ListPollVotersResponse, error) {
+	voterIDs, hasMore, err := h.PostUsecase.ListPollVoters(ctx, int(req.GetPostId()), int(req.GetCursorId()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Poll Voters")
+	}
+
+	res := &pb.ListPollVotersResponse{
+		VoterIds: make([]int64, len(voterIDs)),
+		HasMore:  hasMore,
+	}
+	for i, voterID := range voterIDs {
+		res.VoterIds[i] = int64(voterID)
+	}
+
+	return res, nil
+}
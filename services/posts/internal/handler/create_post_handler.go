@@ -6,6 +6,7 @@ import (
 	pb "voidspace/posts/proto/generated/posts/v1"
 	"voidspace/posts/utils"
 
+	"github.com/vhysxl/voidspace/shared/utils/constants"
 	"github.com/vhysxl/voidspace/shared/utils/helper"
 	"github.com/vhysxl/voidspace/shared/utils/interceptor"
 )
@@ -20,9 +21,47 @@ func (h *PostHandler) CreatePost(
 	}
 
 	post := &domain.Post{
-		Content:    req.GetContent(),
-		UserID:     userID,
-		PostImages: utils.MapPbPostImageToDomain(req.GetImages()),
+		Content:        req.GetContent(),
+		UserID:         userID,
+		PostImages:     utils.MapPbPostImageToDomain(req.GetImages()),
+		Mentions:       utils.MapPbMentionsToDomain(req.GetMentions()),
+		Poll:           utils.MapPbPollInputToDomain(req.GetPoll()),
+		Visibility:     req.GetVisibility(),
+		ContentWarning: req.ContentWarning,
+	}
+
+	if req.ReplyTo != nil {
+		replyTo := int(req.GetReplyTo())
+		post.ReplyToPostID = &replyTo
+	}
+
+	if req.QuotePostId != nil {
+		quotePostID := int(req.GetQuotePostId())
+		post.QuotePostID = &quotePostID
+	}
+
+	if req.GetPublishAt() != nil {
+		// the close timer starts with the post, so polls can't be scheduled
+		if post.Poll != nil {
+			return nil, helper.HandleError(constants.ErrInvalidPoll, h.Logger, "Schedule Post")
+		}
+
+		scheduled, err := h.PostUsecase.SchedulePost(ctx, &domain.ScheduledPost{
+			UserID:         post.UserID,
+			Content:        post.Content,
+			PostImages:     post.PostImages,
+			ReplyToPostID:  post.ReplyToPostID,
+			QuotePostID:    post.QuotePostID,
+			Mentions:       post.Mentions,
+			Visibility:     post.Visibility,
+			PublishAt:      req.GetPublishAt().AsTime(),
+			ContentWarning: post.ContentWarning,
+		})
+		if err != nil {
+			return nil, helper.HandleError(err, h.Logger, "Schedule Post")
+		}
+
+		return utils.MapDomainScheduledPostToPb(scheduled), nil
 	}
 
 	createdPost, err := h.PostUsecase.CreatePost(ctx, post)
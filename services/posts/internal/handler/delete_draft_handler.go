@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) DeleteDraft(
+	ctx context.Context,
+	req *pb.DraftRequest,
+) (*emptypb.Empty, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Delete Draft")
+	}
+
+	err = h.PostUsecase.DeleteDraft(ctx, int(req.GetDraftId()), userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Delete Draft")
+	}
+
+	return &emptypb.Empty{}, nil
+}
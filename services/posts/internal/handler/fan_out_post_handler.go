@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) FanOutPost(
+	ctx context.Context,
+	req *pb.FanOutPostRequest,
+) (*emptypb.Empty, error) {
+	var repostedBy *int
+	if req.RepostedBy != nil {
+		userID := int(req.GetRepostedBy())
+		repostedBy = &userID
+	}
+
+	userIDs := make([]int, len(req.GetUserIds()))
+	for i, id := range req.GetUserIds() {
+		userIDs[i] = int(id)
+	}
+
+	err := h.PostUsecase.FanOutPost(ctx, int(req.GetPostId()), repostedBy, userIDs)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Fan Out Post")
+	}
+
+	return &emptypb.Empty{}, nil
+}
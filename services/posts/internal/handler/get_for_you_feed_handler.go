@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) GetForYouFeed(
+	ctx context.Context,
+	req *pb.GetForYouFeedRequest,
+) (*pb.GetForYouFeedResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get For You Feed")
+	}
+
+	var snapshotID *int
+	if req.SnapshotId != nil {
+		id := int(req.GetSnapshotId())
+		snapshotID = &id
+	}
+
+	page, err := h.PostUsecase.GetForYouFeed(ctx, snapshotID, int(req.GetOffset()), userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get For You Feed")
+	}
+
+	return &pb.GetForYouFeedResponse{
+		Posts:      utils.MapDomainPostsToPb(page.Posts),
+		HasMore:    page.HasMore,
+		SnapshotId: int64(page.SnapshotID),
+		NextOffset: int32(page.NextOffset),
+	}, nil
+}
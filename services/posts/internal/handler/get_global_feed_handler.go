@@ -40,8 +40,16 @@ func (h *PostHandler) GetGlobalFeed(
 		pbPosts[i] = utils.MapDomainPostToPb(&p)
 	}
 
-	return &pb.GetFeedResponse{
+	res := &pb.GetFeedResponse{
 		Posts:   pbPosts,
 		HasMore: hasMore,
-	}, nil
+	}
+
+	if len(posts) > 0 {
+		cursorTime, cursorID := utils.FeedCursor(&posts[len(posts)-1])
+		res.NextCursorTime = cursorTime
+		res.NextCursorId = &cursorID
+	}
+
+	return res, nil
 }
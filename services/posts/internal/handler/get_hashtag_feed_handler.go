@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"time"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) GetHashtagFeed(
+	ctx context.Context,
+	req *pb.GetHashtagFeedRequest,
+) (*pb.GetFeedResponse, error) {
+	userID, err := helper.GetOptionalUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Hashtag Feed")
+	}
+
+	var loggedInUserID *int
+	if userID != 0 {
+		loggedInUserID = &userID
+	}
+
+	var cursorTime *time.Time
+	if req.GetCursorTime() != nil {
+		t := req.GetCursorTime().AsTime()
+		cursorTime = &t
+	}
+
+	posts, hasMore, err := h.PostUsecase.GetHashtagFeed(ctx, req.GetTag(), cursorTime, int(req.GetCursorId()), loggedInUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Hashtag Feed")
+	}
+
+	res := &pb.GetFeedResponse{
+		Posts:   utils.MapDomainPostsToPb(posts),
+		HasMore: hasMore,
+	}
+
+	if len(posts) > 0 {
+		cursorTime, cursorID := utils.FeedCursor(&posts[len(posts)-1])
+		res.NextCursorTime = cursorTime
+		res.NextCursorId = &cursorID
+	}
+
+	return res, nil
+}
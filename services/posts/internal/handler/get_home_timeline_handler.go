@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"time"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) GetHomeTimeline(
+	ctx context.Context,
+	req *pb.GetHomeTimelineRequest,
+) (*pb.GetFeedResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Home Timeline")
+	}
+
+	var cursorTime *time.Time
+	if req.GetCursorTime() != nil {
+		t := req.GetCursorTime().AsTime()
+		cursorTime = &t
+	}
+
+	posts, hasMore, err := h.PostUsecase.GetHomeTimeline(ctx, cursorTime, int(req.GetCursorId()), userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Home Timeline")
+	}
+
+	pbPosts := make([]*pb.Post, len(posts))
+	for i, p := range posts {
+		pbPosts[i] = utils.MapDomainPostToPb(&p)
+	}
+
+	res := &pb.GetFeedResponse{
+		Posts:   pbPosts,
+		HasMore: hasMore,
+	}
+
+	if len(posts) > 0 {
+		cursorTime, cursorID := utils.FeedCursor(&posts[len(posts)-1])
+		res.NextCursorTime = cursorTime
+		res.NextCursorId = &cursorID
+	}
+
+	return res, nil
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"time"
 	pb "voidspace/posts/proto/generated/posts/v1"
 	"voidspace/posts/utils"
 
@@ -12,7 +13,7 @@ import (
 func (h *PostHandler) GetLikedPosts(
 	ctx context.Context,
 	req *pb.GetUserPostsRequest,
-) (*pb.GetPostsResponse, error) {
+) (*pb.GetFeedResponse, error) {
 	userID, err := helper.GetOptionalUserIDFromContext(ctx, interceptor.CtxKeyUserID)
 	if err != nil {
 		return nil, helper.HandleError(err, h.Logger, "Get Liked Posts")
@@ -23,15 +24,27 @@ func (h *PostHandler) GetLikedPosts(
 		loggedInUserID = &userID
 	}
 
-	posts, err := h.PostUsecase.GetLikedPosts(ctx, int(req.GetUserId()), loggedInUserID)
+	var cursorTime *time.Time
+	if req.GetCursorTime() != nil {
+		t := req.GetCursorTime().AsTime()
+		cursorTime = &t
+	}
+
+	posts, hasMore, err := h.PostUsecase.GetLikedPosts(ctx, int(req.GetUserId()), cursorTime, int(req.GetCursorId()), loggedInUserID)
 	if err != nil {
 		return nil, helper.HandleError(err, h.Logger, "Get Liked Posts")
 	}
 
-	pbPosts := make([]*pb.Post, len(posts))
-	for i, p := range posts {
-		pbPosts[i] = utils.MapDomainPostToPb(&p)
+	res := &pb.GetFeedResponse{
+		Posts:   utils.MapDomainPostsToPb(posts),
+		HasMore: hasMore,
+	}
+
+	if len(posts) > 0 {
+		cursorTime, cursorID := utils.FeedCursor(&posts[len(posts)-1])
+		res.NextCursorTime = cursorTime
+		res.NextCursorId = &cursorID
 	}
 
-	return &pb.GetPostsResponse{Posts: pbPosts}, nil
+	return res, nil
 }
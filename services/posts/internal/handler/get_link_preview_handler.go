@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (h *PostHandler) GetLinkPreview(
+	ctx context.Context,
+	req *pb.GetLinkPreviewRequest,
+) (*pb.LinkPreview, error) {
+	preview, err := h.PostUsecase.GetLinkPreview(ctx, req.GetUrl())
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Link Preview")
+	}
+
+	return utils.MapDomainLinkPreviewToPb(preview), nil
+}
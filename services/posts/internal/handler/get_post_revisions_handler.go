@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) GetPostRevisions(
+	ctx context.Context,
+	req *pb.GetPostRevisionsRequest,
+) (*pb.GetPostRevisionsResponse, error) {
+	userID, err := helper.GetOptionalUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Post Revisions")
+	}
+
+	var loggedInUserID *int
+	if userID != 0 {
+		loggedInUserID = &userID
+	}
+
+	history, err := h.PostUsecase.GetPostRevisions(ctx, int(req.GetPostId()), loggedInUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Post Revisions")
+	}
+
+	return &pb.GetPostRevisionsResponse{
+		Revisions:      utils.MapDomainRevisionsToPb(history.Revisions),
+		ContentWarning: history.ContentWarning,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"time"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) GetThread(
+	ctx context.Context,
+	req *pb.GetThreadRequest,
+) (*pb.GetThreadResponse, error) {
+	userID, err := helper.GetOptionalUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Thread")
+	}
+
+	var loggedInUserID *int
+	if userID != 0 {
+		loggedInUserID = &userID
+	}
+
+	var cursorTime *time.Time
+	if req.GetCursorTime() != nil {
+		t := req.GetCursorTime().AsTime()
+		cursorTime = &t
+	}
+
+	thread, err := h.PostUsecase.GetThread(ctx, int(req.GetPostId()), cursorTime, int(req.GetCursorId()), loggedInUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Thread")
+	}
+
+	return &pb.GetThreadResponse{
+		Post:      utils.MapDomainPostToPb(thread.Post),
+		Ancestors: utils.MapDomainPostsToPb(thread.Ancestors),
+		Replies:   utils.MapDomainPostsToPb(thread.Replies),
+		HasMore:   thread.HasMore,
+	}, nil
+}
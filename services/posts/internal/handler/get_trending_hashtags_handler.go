@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PostHandler) GetTrendingHashtags(
+	ctx context.Context,
+	req *pb.GetTrendingHashtagsRequest,
+) (*pb.GetTrendingHashtagsResponse, error) {
+	hashtags, err := h.HashtagUsecase.GetTrendingHashtags(ctx, int(req.GetLimit()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Get Trending Hashtags")
+	}
+
+	res := &pb.GetTrendingHashtagsResponse{
+		Hashtags: make([]*pb.TrendingHashtag, len(hashtags)),
+	}
+
+	for i, hashtag := range hashtags {
+		res.Hashtags[i] = &pb.TrendingHashtag{
+			Tag:   hashtag.Tag,
+			Uses:  int64(hashtag.Uses),
+			Score: hashtag.Score,
+		}
+	}
+
+	// every row of a snapshot shares the same computed_at
+	if len(hashtags) > 0 {
+		res.ComputedAt = timestamppb.New(hashtags[0].ComputedAt)
+	}
+
+	return res, nil
+}
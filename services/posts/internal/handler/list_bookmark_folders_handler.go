@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ListBookmarkFolders(
+	ctx context.Context,
+	req *emptypb.Empty,
+) (*pb.ListBookmarkFoldersResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Bookmark Folders")
+	}
+
+	folders, err := h.BookmarkUsecase.GetBookmarkFolders(ctx, userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Bookmark Folders")
+	}
+
+	res := &pb.ListBookmarkFoldersResponse{
+		Folders: make([]*pb.BookmarkFolder, len(folders)),
+	}
+	for i, folder := range folders {
+		res.Folders[i] = &pb.BookmarkFolder{
+			Name:           folder.Name,
+			BookmarksCount: int64(folder.BookmarksCount),
+		}
+	}
+
+	return res, nil
+}
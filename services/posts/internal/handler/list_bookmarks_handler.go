@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"time"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) ListBookmarks(
+	ctx context.Context,
+	req *pb.ListBookmarksRequest,
+) (*pb.GetFeedResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Bookmarks")
+	}
+
+	var cursorTime *time.Time
+	if req.GetCursorTime() != nil {
+		t := req.GetCursorTime().AsTime()
+		cursorTime = &t
+	}
+
+	posts, hasMore, err := h.PostUsecase.GetBookmarks(ctx, userID, req.Folder, cursorTime, int(req.GetCursorId()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Bookmarks")
+	}
+
+	res := &pb.GetFeedResponse{
+		Posts:   utils.MapDomainPostsToPb(posts),
+		HasMore: hasMore,
+	}
+
+	if len(posts) > 0 {
+		cursorTime, cursorID := utils.FeedCursor(&posts[len(posts)-1])
+		res.NextCursorTime = cursorTime
+		res.NextCursorId = &cursorID
+	}
+
+	return res, nil
+}
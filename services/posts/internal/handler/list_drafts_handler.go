@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ListDrafts(
+	ctx context.Context,
+	req *emptypb.Empty,
+) (*pb.ListDraftsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Drafts")
+	}
+
+	drafts, err := h.PostUsecase.GetDrafts(ctx, userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Drafts")
+	}
+
+	return &pb.ListDraftsResponse{Drafts: utils.MapDomainDraftsToPb(drafts)}, nil
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PostHandler) ListPostLikers(
+	ctx context.Context,
+	req *pb.ListPostLikersRequest,
+) (*pb.ListPostLikersResponse, error) {
+	userID, err := helper.GetOptionalUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Post Likers")
+	}
+
+	var loggedInUserID *int
+	if userID != 0 {
+		loggedInUserID = &userID
+	}
+
+	var cursor *domain.LikerCursor
+	if req.GetCursorTime() != nil {
+		cursor = &domain.LikerCursor{
+			LikedAt:  req.GetCursorTime().AsTime(),
+			UserID:   int(req.GetCursorId()),
+			Followed: req.GetCursorFollowed(),
+		}
+	}
+
+	likers, next, hasMore, err := h.PostUsecase.ListPostLikers(ctx, int(req.GetPostId()), cursor, loggedInUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "List Post Likers")
+	}
+
+	res := &pb.ListPostLikersResponse{
+		Likers:  utils.MapDomainLikersToPb(likers),
+		HasMore: hasMore,
+	}
+
+	if next != nil {
+		cursorID := int64(next.UserID)
+		res.NextCursorTime = timestamppb.New(next.LikedAt)
+		res.NextCursorId = &cursorID
+		res.NextCursorFollowed = &next.Followed
+	}
+
+	return res, nil
+}
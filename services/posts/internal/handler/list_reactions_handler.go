@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ListReactions(
+	ctx context.Context,
+	req *emptypb.Empty,
+) (*pb.ListReactionsResponse, error) {
+	return &pb.ListReactionsResponse{
+		Reactions:       h.LikeUsecase.ListReactions(),
+		DefaultReaction: domain.DefaultReaction,
+	}, nil
+}
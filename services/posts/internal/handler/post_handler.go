@@ -11,22 +11,31 @@ import (
 type PostHandler struct {
 	pb.UnimplementedPostServiceServer
 
-	PostUsecase    domain.PostUsecase
-	LikeUsecase    domain.LikeUsecase
-	Logger         *zap.Logger
-	ContextTimeout time.Duration
+	PostUsecase     domain.PostUsecase
+	LikeUsecase     domain.LikeUsecase
+	RepostUsecase   domain.RepostUsecase
+	BookmarkUsecase domain.BookmarkUsecase
+	HashtagUsecase  domain.HashtagUsecase
+	Logger          *zap.Logger
+	ContextTimeout  time.Duration
 }
 
 func NewPostHandler(
 	postUsecase domain.PostUsecase,
 	likeUsecase domain.LikeUsecase,
+	repostUsecase domain.RepostUsecase,
+	bookmarkUsecase domain.BookmarkUsecase,
+	hashtagUsecase domain.HashtagUsecase,
 	logger *zap.Logger,
 	timeout time.Duration,
 ) pb.PostServiceServer {
 	return &PostHandler{
-		PostUsecase:    postUsecase,
-		LikeUsecase:    likeUsecase,
-		Logger:         logger,
-		ContextTimeout: timeout,
+		PostUsecase:     postUsecase,
+		LikeUsecase:     likeUsecase,
+		RepostUsecase:   repostUsecase,
+		BookmarkUsecase: bookmarkUsecase,
+		HashtagUsecase:  hashtagUsecase,
+		Logger:          logger,
+		ContextTimeout:  timeout,
 	}
 }
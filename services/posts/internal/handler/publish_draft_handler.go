@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) PublishDraft(
+	ctx context.Context,
+	req *pb.DraftRequest,
+) (*pb.Post, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Publish Draft")
+	}
+
+	post, err := h.PostUsecase.PublishDraft(ctx, int(req.GetDraftId()), userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Publish Draft")
+	}
+
+	return utils.MapDomainPostToPb(post), nil
+}
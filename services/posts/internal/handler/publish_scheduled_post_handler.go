@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (h *PostHandler) PublishScheduledPost(
+	ctx context.Context,
+	req *pb.ScheduledPostRequest,
+) (*pb.Post, error) {
+	post, err := h.PostUsecase.PublishScheduledPost(ctx, int(req.GetScheduledPostId()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Publish Scheduled Post")
+	}
+
+	return utils.MapDomainPostToPb(post), nil
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ReactToPost(
+	ctx context.Context,
+	req *pb.ReactToPostRequest,
+) (*emptypb.Empty, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "React To Post")
+	}
+
+	like := &domain.Like{
+		PostID:   int(req.GetPostId()),
+		UserID:   userID,
+		Reaction: req.GetReaction(),
+	}
+
+	err = h.LikeUsecase.ReactToPost(ctx, like)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "React To Post")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) ReconcileLikesCount(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.ReconcileLikesCountResponse, error) {
+	fixed, err := h.LikeUsecase.ReconcileLikesCount(ctx)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Reconcile Likes Count")
+	}
+
+	return &pb.ReconcileLikesCountResponse{
+		FixedPosts: int64(fixed),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) RefreshTrendingHashtags(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*emptypb.Empty, error) {
+	err := h.HashtagUsecase.RefreshTrendingHashtags(ctx)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Refresh Trending Hashtags")
+	}
+
+	return &emptypb.Empty{}, nil
+}
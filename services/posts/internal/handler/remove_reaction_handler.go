@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) RemoveReaction(
+	ctx context.Context,
+	req *pb.RemoveReactionRequest,
+) (*emptypb.Empty, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Remove Reaction")
+	}
+
+	like := &domain.Like{
+		PostID: int(req.GetPostId()),
+		UserID: userID,
+	}
+
+	err = h.LikeUsecase.RemoveReaction(ctx, like)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Remove Reaction")
+	}
+
+	return &emptypb.Empty{}, nil
+}
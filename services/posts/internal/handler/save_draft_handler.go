@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) SaveDraft(
+	ctx context.Context,
+	req *pb.SaveDraftRequest,
+) (*pb.Draft, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Save Draft")
+	}
+
+	draft := &domain.Draft{
+		ID:             int(req.GetDraftId()),
+		UserID:         userID,
+		Content:        req.GetContent(),
+		PostImages:     utils.MapPbPostImageToDomain(req.GetImages()),
+		Mentions:       utils.MapPbMentionsToDomain(req.GetMentions()),
+		Visibility:     req.GetVisibility(),
+		ContentWarning: req.ContentWarning,
+	}
+
+	if req.ReplyTo != nil {
+		replyTo := int(req.GetReplyTo())
+		draft.ReplyToPostID = &replyTo
+	}
+
+	saved, err := h.PostUsecase.SaveDraft(ctx, draft)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Save Draft")
+	}
+
+	return utils.MapDomainDraftToPb(saved), nil
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (h *PostHandler) SetContentFlags(
+	ctx context.Context,
+	req *pb.SetContentFlagsRequest,
+) (*pb.Post, error) {
+	post, err := h.PostUsecase.SetContentFlags(ctx, int(req.GetPostId()), utils.MapPbContentFlagsToDomain(req))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Set Content Flags")
+	}
+
+	return utils.MapDomainPostToPb(post), nil
+}
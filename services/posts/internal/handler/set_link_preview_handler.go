@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) SetLinkPreview(
+	ctx context.Context,
+	req *pb.SetLinkPreviewRequest,
+) (*emptypb.Empty, error) {
+	err := h.PostUsecase.SetLinkPreview(ctx, int(req.GetPostId()), utils.MapPbLinkPreviewToDomain(req.GetPreview()))
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Set Link Preview")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) SetTimelineCelebrity(
+	ctx context.Context,
+	req *pb.SetTimelineCelebrityRequest,
+) (*emptypb.Empty, error) {
+	err := h.PostUsecase.SetTimelineCelebrity(ctx, int(req.GetUserId()), req.GetCelebrity())
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Set Timeline Celebrity")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *PostHandler) UndoRepost(
+	ctx context.Context,
+	req *pb.RepostRequest,
+) (*emptypb.Empty, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Undo Repost")
+	}
+
+	repost := &domain.Repost{
+		PostID: int(req.GetPostId()),
+		UserID: userID,
+	}
+
+	err = h.RepostUsecase.UndoRepost(ctx, repost)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Undo Repost")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -24,6 +24,7 @@ func (h *PostHandler) UpdatePost(
 		ID:         int(req.GetPostId()),
 		Content:    req.GetContent(),
 		PostImages: utils.MapPbPostImageToDomain(req.GetImages()),
+		Mentions:   utils.MapPbMentionsToDomain(req.GetMentions()),
 	}
 
 	err = h.PostUsecase.UpdatePost(ctx, post, userID)
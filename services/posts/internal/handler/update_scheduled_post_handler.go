@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) UpdateScheduledPost(
+	ctx context.Context,
+	req *pb.UpdateScheduledPostRequest,
+) (*pb.Post, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Update Scheduled Post")
+	}
+
+	post := &domain.ScheduledPost{
+		ID:         int(req.GetScheduledPostId()),
+		Content:    req.GetContent(),
+		PostImages: utils.MapPbPostImageToDomain(req.GetImages()),
+		Mentions:   utils.MapPbMentionsToDomain(req.GetMentions()),
+	}
+
+	if req.GetPublishAt() != nil {
+		post.PublishAt = req.GetPublishAt().AsTime()
+	}
+
+	scheduled, err := h.PostUsecase.UpdateScheduledPost(ctx, post, userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Update Scheduled Post")
+	}
+
+	return utils.MapDomainScheduledPostToPb(scheduled), nil
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/posts/proto/generated/posts/v1"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (h *PostHandler) VotePoll(
+	ctx context.Context,
+	req *pb.VotePollRequest,
+) (*pb.Poll, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Vote Poll")
+	}
+
+	optionIDs := make([]int, len(req.GetOptionIds()))
+	for i, optionID := range req.GetOptionIds() {
+		optionIDs[i] = int(optionID)
+	}
+
+	poll, err := h.PostUsecase.VotePoll(ctx, int(req.GetPostId()), optionIDs, userID)
+	if err != nil {
+		return nil, helper.HandleError(err, h.Logger, "Vote Poll")
+	}
+
+	return utils.MapDomainPollToPb(poll), nil
+}
@@ -0,0 +1,17 @@
+package bookmark
+
+import (
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BookmarkRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBookmarkRepository(db *pgxpool.Pool) domain.BookmarkRepository {
+	return &BookmarkRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,30 @@
+package bookmark
+
+import (
+	"context"
+	"errors"
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// AddBookmark implements [domain.BookmarkRepository].
+func (b *BookmarkRepository) AddBookmark(ctx context.Context, bookmark *domain.Bookmark) error {
+	// bookmarking again only moves it between folders, it keeps its place
+	query := `
+		INSERT INTO post_bookmarks (user_id, post_id, folder)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (post_id, user_id) DO UPDATE SET folder = EXCLUDED.folder
+	`
+	_, err := b.db.Exec(ctx, query, bookmark.UserID, bookmark.PostID, bookmark.Folder)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return constants.ErrPostNotFound
+		}
+		return err
+	}
+	return nil
+}
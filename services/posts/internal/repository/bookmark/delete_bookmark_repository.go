@@ -0,0 +1,20 @@
+package bookmark
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// RemoveBookmark implements [domain.BookmarkRepository].
+func (b *BookmarkRepository) RemoveBookmark(ctx context.Context, bookmark *domain.Bookmark) error {
+	query := `
+		DELETE FROM post_bookmarks
+		WHERE post_id = $1
+		AND user_id = $2
+	`
+	_, err := b.db.Exec(ctx, query, bookmark.PostID, bookmark.UserID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package bookmark
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetFolders implements [domain.BookmarkRepository]. A folder exists while
+// it holds a bookmark of a post that is not deleted.
+func (b *BookmarkRepository) GetFolders(ctx context.Context, userID int) ([]domain.BookmarkFolder, error) {
+	query := `
+		SELECT b.folder AS name, COUNT(*) AS bookmarks_count
+		FROM post_bookmarks b
+		JOIN posts p ON p.id = b.post_id
+		WHERE b.user_id = $1
+		AND b.folder IS NOT NULL
+		AND b.deleted_at IS NULL
+		AND p.deleted_at IS NULL
+		GROUP BY b.folder
+		ORDER BY b.folder ASC
+	`
+
+	folders := []domain.BookmarkFolder{}
+	err := pgxscan.Select(ctx, b.db, &folders, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
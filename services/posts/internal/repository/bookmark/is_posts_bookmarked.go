@@ -0,0 +1,39 @@
+package bookmark
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// IsPostsBookmarkedByUser implements [domain.BookmarkRepository].
+func (b *BookmarkRepository) IsPostsBookmarkedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error) {
+	if len(postIDs) == 0 {
+		return map[int]bool{}, nil
+	}
+
+	query := `
+		SELECT post_id
+		FROM post_bookmarks
+		WHERE user_id = $1
+		AND post_id = ANY($2)
+		AND deleted_at IS NULL
+	`
+
+	var bookmarkedPostIDs []int
+	err := pgxscan.Select(ctx, b.db, &bookmarkedPostIDs, query, userID, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]bool, len(postIDs))
+	for _, postID := range postIDs {
+		result[postID] = false
+	}
+
+	for _, postID := range bookmarkedPostIDs {
+		result[postID] = true
+	}
+
+	return result, nil
+}
@@ -0,0 +1,16 @@
+package comment
+
+import (
+	"voidspace/posts/internal/domain"
+	commentpb "voidspace/posts/proto/generated/comments/v1"
+)
+
+type CommentRepository struct {
+	commentClient commentpb.CommentServiceClient
+}
+
+func NewCommentRepository(commentClient commentpb.CommentServiceClient) domain.CommentRepository {
+	return &CommentRepository{
+		commentClient: commentClient,
+	}
+}
@@ -0,0 +1,34 @@
+package comment
+
+import (
+	"context"
+	commentpb "voidspace/posts/proto/generated/comments/v1"
+)
+
+func (c *CommentRepository) CountComments(
+	ctx context.Context,
+	postIDs []int,
+) (map[int]int, error) {
+	result := make(map[int]int)
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	ids := make([]int64, 0, len(postIDs))
+	for _, ID := range postIDs {
+		ids = append(ids, int64(ID))
+	}
+
+	res, err := c.commentClient.GetFeedCommentCount(ctx, &commentpb.GetFeedCommentCountRequest{
+		PostIds: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, count := range res.GetPostCommentsCount() {
+		result[int(count.GetPostId())] = int(count.GetCount())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package follow
+
+import (
+	"context"
+	userpb "voidspace/posts/proto/generated/users/v1"
+)
+
+func (f *FollowRepository) FilterFollowing(
+	ctx context.Context,
+	userID int,
+	targetUserIDs []int,
+) (map[int]bool, error) {
+	result := make(map[int]bool)
+	if len(targetUserIDs) == 0 {
+		return result, nil
+	}
+
+	targets := make([]int64, 0, len(targetUserIDs))
+	for _, ID := range targetUserIDs {
+		targets = append(targets, int64(ID))
+	}
+
+	res, err := f.userClient.FilterFollowing(ctx, &userpb.FilterFollowingRequest{
+		UserId:        int64(userID),
+		TargetUserIds: targets,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ID := range res.GetUserIds() {
+		result[int(ID)] = true
+	}
+
+	return result, nil
+}
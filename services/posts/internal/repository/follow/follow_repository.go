@@ -0,0 +1,16 @@
+package follow
+
+import (
+	"voidspace/posts/internal/domain"
+	userpb "voidspace/posts/proto/generated/users/v1"
+)
+
+type FollowRepository struct {
+	userClient userpb.UserServiceClient
+}
+
+func NewFollowRepository(userClient userpb.UserServiceClient) domain.FollowRepository {
+	return &FollowRepository{
+		userClient: userClient,
+	}
+}
@@ -0,0 +1,27 @@
+package hashtag
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetTrending implements [domain.HashtagRepository].
+func (h *HashtagRepository) GetTrending(ctx context.Context, limit int) ([]domain.TrendingHashtag, error) {
+	var hashtags []domain.TrendingHashtag
+
+	query := `
+		SELECT tag, uses, score, computed_at
+		FROM trending_hashtags
+		ORDER BY score DESC, tag
+		LIMIT $1
+	`
+
+	err := pgxscan.Select(ctx, h.db, &hashtags, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashtags, nil
+}
@@ -0,0 +1,17 @@
+package hashtag
+
+import (
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type HashtagRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewHashtagRepository(db *pgxpool.Pool) domain.HashtagRepository {
+	return &HashtagRepository{
+		db: db,
+	}
+}
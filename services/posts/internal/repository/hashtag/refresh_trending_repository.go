@@ -0,0 +1,56 @@
+package hashtag
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// RefreshTrending implements [domain.HashtagRepository].
+//
+// uses is the number of distinct authors that used a tag inside the last
+// window. The score is how far uses is above the tag's average per window
+// over the baseline period before it, so a tag that is always busy does not
+// trend while a sudden spike does.
+func (h *HashtagRepository) RefreshTrending(
+	ctx context.Context,
+	window, baseline time.Duration,
+	minUses, limit int,
+) error {
+	windows := float64(baseline) / float64(window)
+
+	query := `
+		WITH recent AS (
+			SELECT h.tag, COUNT(DISTINCT p.user_id) AS uses
+			FROM post_hashtags h
+			JOIN posts p ON p.id = h.post_id AND p.deleted_at IS NULL AND p.visibility = 'public'
+			WHERE h.created_at >= NOW() - $1::interval
+			GROUP BY h.tag
+		), previous AS (
+			SELECT h.tag, COUNT(DISTINCT p.user_id) / $3::float8 AS uses
+			FROM post_hashtags h
+			JOIN posts p ON p.id = h.post_id AND p.deleted_at IS NULL AND p.visibility = 'public'
+			WHERE h.created_at >= NOW() - $1::interval - $2::interval
+			  AND h.created_at < NOW() - $1::interval
+			GROUP BY h.tag
+		)
+		INSERT INTO trending_hashtags (tag, uses, score, computed_at)
+		SELECT r.tag, r.uses, r.uses - COALESCE(pr.uses, 0) AS score, NOW()
+		FROM recent r
+		LEFT JOIN previous pr ON pr.tag = r.tag
+		WHERE r.uses >= $4 AND r.uses - COALESCE(pr.uses, 0) > 0
+		ORDER BY score DESC
+		LIMIT $5
+	`
+
+	return pgx.BeginFunc(ctx, h.db, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `DELETE FROM trending_hashtags`)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, query, window, baseline, windows, minUses, limit)
+		return err
+	})
+}
@@ -0,0 +1,43 @@
+package like
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetLikers implements [domain.LikeRepository]. Likes of deleted accounts are
+// soft deleted with the account and left out.
+func (l *LikeRepository) GetLikers(
+	ctx context.Context,
+	postID int,
+	cursorTime time.Time,
+	cursorID int,
+	limit int,
+) ([]domain.Liker, bool, error) {
+	var likers []domain.Liker
+
+	query := `
+		SELECT user_id, created_at AS liked_at
+		FROM post_likes
+		WHERE post_id = $1
+		AND deleted_at IS NULL
+		AND ((created_at < $2) OR (created_at = $2 AND user_id < $3))
+		ORDER BY created_at DESC, user_id DESC
+		LIMIT $4
+	`
+
+	err := pgxscan.Select(ctx, l.db, &likers, query, postID, cursorTime, cursorID, limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(likers) > limit
+	if hasMore {
+		likers = likers[:limit]
+	}
+
+	return likers, hasMore, nil
+}
@@ -0,0 +1,70 @@
+package like
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+type reactionCountRow struct {
+	PostID int
+	domain.ReactionCount
+}
+
+// GetReactionCounts implements [domain.LikeRepository].
+func (l *LikeRepository) GetReactionCounts(ctx context.Context, postIDs []int) (map[int][]domain.ReactionCount, error) {
+	result := make(map[int][]domain.ReactionCount, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT post_id, reaction, COUNT(*) AS count
+		FROM post_likes
+		WHERE post_id = ANY($1)
+		AND deleted_at IS NULL
+		GROUP BY post_id, reaction
+		ORDER BY post_id, count DESC, reaction
+	`
+
+	var rows []reactionCountRow
+	err := pgxscan.Select(ctx, l.db, &rows, query, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.PostID] = append(result[row.PostID], row.ReactionCount)
+	}
+
+	return result, nil
+}
+
+// GetUserReactions implements [domain.LikeRepository].
+func (l *LikeRepository) GetUserReactions(ctx context.Context, userID int, postIDs []int) (map[int]string, error) {
+	result := make(map[int]string, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	query := `
+		SELECT post_id, reaction
+		FROM post_likes
+		WHERE user_id = $1
+		AND post_id = ANY($2)
+		AND deleted_at IS NULL
+	`
+
+	var rows []domain.Like
+	err := pgxscan.Select(ctx, l.db, &rows, query, userID, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.PostID] = row.Reaction
+	}
+
+	return result, nil
+}
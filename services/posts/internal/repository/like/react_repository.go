@@ -0,0 +1,69 @@
+package like
+
+import (
+	"context"
+	"errors"
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// React implements [domain.LikeRepository]. Changing a reaction keeps its
+// place in the liker list and leaves likes_count alone, which only moves when
+// the reaction is new or revived.
+func (l *LikeRepository) React(ctx context.Context, like *domain.Like) error {
+	change := `
+		UPDATE post_likes
+		SET reaction = $3
+		WHERE user_id = $1
+		AND post_id = $2
+		AND deleted_at IS NULL
+	`
+
+	insert := `
+		INSERT INTO post_likes (user_id, post_id, reaction, created_at, deleted_at)
+		VALUES ($1, $2, $3, NOW(), NULL)
+		ON CONFLICT (user_id, post_id)
+		DO UPDATE SET
+			reaction = EXCLUDED.reaction,
+			deleted_at = NULL,
+			created_at = NOW()
+		WHERE post_likes.deleted_at IS NOT NULL
+	`
+
+	err := pgx.BeginFunc(ctx, l.db, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, change, like.UserID, like.PostID, like.Reaction)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() > 0 {
+			return nil
+		}
+
+		tag, err = tx.Exec(ctx, insert, like.UserID, like.PostID, like.Reaction)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			// a concurrent reaction by the same user won the insert
+			_, err = tx.Exec(ctx, change, like.UserID, like.PostID, like.Reaction)
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE posts SET likes_count = likes_count + 1 WHERE id = $1`, like.PostID)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return constants.ErrUserOrPostNotFound
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package like
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReconcileLikesCount implements [domain.LikeRepository]. Each batch locks
+// its posts before counting, so a like committing meanwhile either is counted
+// or waits and applies its increment on top of the fixed count.
+func (l *LikeRepository) ReconcileLikesCount(ctx context.Context, batchSize int) (int, error) {
+	fixed := 0
+	lastID := 0
+
+	for {
+		var postIDs []int
+
+		err := pgx.BeginFunc(ctx, l.db, func(tx pgx.Tx) error {
+			err := pgxscan.Select(
+				ctx,
+				tx,
+				&postIDs,
+				`SELECT id FROM posts WHERE id > $1 ORDER BY id LIMIT $2 FOR NO KEY UPDATE`,
+				lastID,
+				batchSize,
+			)
+			if err != nil || len(postIDs) == 0 {
+				return err
+			}
+
+			tag, err := tx.Exec(
+				ctx,
+				`UPDATE posts p
+				SET likes_count = c.likes_count
+				FROM (
+					SELECT id, (
+						SELECT COUNT(*) FROM post_likes pl
+						WHERE pl.post_id = posts.id AND pl.deleted_at IS NULL
+					) AS likes_count
+					FROM posts
+					WHERE id = ANY($1)
+				) c
+				WHERE p.id = c.id AND p.likes_count <> c.likes_count`,
+				postIDs,
+			)
+			if err != nil {
+				return err
+			}
+
+			fixed += int(tag.RowsAffected())
+			return nil
+		})
+		if err != nil {
+			return fixed, err
+		}
+
+		if len(postIDs) < batchSize {
+			return fixed, nil
+		}
+
+		lastID = postIDs[len(postIDs)-1]
+	}
+}
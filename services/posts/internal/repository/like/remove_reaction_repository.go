@@ -0,0 +1,38 @@
+package like
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// RemoveReaction implements [domain.LikeRepository].
+func (l *LikeRepository) RemoveReaction(ctx context.Context, like *domain.Like) error {
+	query := `
+		UPDATE post_likes
+		SET deleted_at = NOW()
+		WHERE post_id = $1
+		AND user_id = $2
+		AND deleted_at IS NULL
+	`
+
+	return pgx.BeginFunc(ctx, l.db, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(
+			ctx,
+			query,
+			like.PostID,
+			like.UserID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE posts SET likes_count = likes_count - 1 WHERE id = $1`, like.PostID)
+		return err
+	})
+}
@@ -13,8 +13,34 @@ func (u *PostRepository) HandleAccountDeletion(ctx context.Context, userID int)
 		WHERE user_id = $1 AND deleted_at IS NULL
 	`
 
+	// the liked posts lose the likes in the same statement
 	queryLike := `
-		UPDATE post_likes SET deleted_at = NOW()
+		WITH removed AS (
+			UPDATE post_likes SET deleted_at = NOW()
+			WHERE user_id = $1 AND deleted_at IS NULL
+			RETURNING post_id
+		)
+		UPDATE posts SET likes_count = likes_count - 1
+		WHERE id IN (SELECT post_id FROM removed)
+	`
+
+	queryRepost := `
+		UPDATE post_reposts SET deleted_at = NOW()
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+
+	queryScheduled := `
+		UPDATE scheduled_posts SET deleted_at = NOW()
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+
+	queryDraft := `
+		UPDATE post_drafts SET deleted_at = NOW()
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+
+	queryBookmark := `
+		UPDATE post_bookmarks SET deleted_at = NOW()
 		WHERE user_id = $1 AND deleted_at IS NULL
 	`
 
@@ -30,6 +56,26 @@ func (u *PostRepository) HandleAccountDeletion(ctx context.Context, userID int)
 			return err
 		}
 
+		_, err = tx.Exec(ctx, queryRepost, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, queryScheduled, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, queryDraft, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, queryBookmark, userID)
+		if err != nil {
+			return err
+		}
+
 		return nil
 
 	})
@@ -13,7 +13,32 @@ func (u *PostRepository) HandleAccountRestoration(ctx context.Context, userID in
 	`
 
 	queryLike := `
-		UPDATE post_likes SET deleted_at = NULL
+		WITH restored AS (
+			UPDATE post_likes SET deleted_at = NULL
+			WHERE user_id = $1 AND deleted_at IS NOT NULL
+			RETURNING post_id
+		)
+		UPDATE posts SET likes_count = likes_count + 1
+		WHERE id IN (SELECT post_id FROM restored)
+	`
+
+	queryRepost := `
+		UPDATE post_reposts SET deleted_at = NULL
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+	`
+
+	queryScheduled := `
+		UPDATE scheduled_posts SET deleted_at = NULL
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+	`
+
+	queryDraft := `
+		UPDATE post_drafts SET deleted_at = NULL
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+	`
+
+	queryBookmark := `
+		UPDATE post_bookmarks SET deleted_at = NULL
 		WHERE user_id = $1 AND deleted_at IS NOT NULL
 	`
 
@@ -28,6 +53,26 @@ func (u *PostRepository) HandleAccountRestoration(ctx context.Context, userID in
 			return err
 		}
 
+		_, err = tx.Exec(ctx, queryRepost, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, queryScheduled, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, queryDraft, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, queryBookmark, userID)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
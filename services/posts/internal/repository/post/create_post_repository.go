@@ -4,41 +4,85 @@ import (
 	"context"
 	"encoding/json"
 	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5"
 )
 
 // Create implements [domain.PostRepository].
 func (p *PostRepository) Create(ctx context.Context, post *domain.Post) (*domain.Post, error) {
+	err := pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		return insertPost(ctx, tx, post)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
+
+// insertPost writes post with its hashtags, mentions and poll inside tx and fills
+// the generated columns back into post.
+func insertPost(ctx context.Context, tx pgx.Tx, post *domain.Post) error {
 	if len(post.PostImages) == 0 {
 		post.PostImages = nil
 	}
 
 	imagesJSON, err := json.Marshal(post.PostImages)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	entitiesJSON, err := json.Marshal(post.Entities)
+	if err != nil {
+		return err
 	}
 
 	var jsonRaw []byte
 
-	err = p.db.QueryRow(
+	err = tx.QueryRow(
 		ctx,
-		`INSERT INTO posts (content, user_id, post_images)
-        VALUES ($1, $2, $3) 
-        RETURNING id, content, user_id, post_images, created_at, updated_at`,
+		`INSERT INTO posts (content, user_id, post_images, reply_to_post_id, root_post_id, quote_post_id, entities, visibility, content_warning)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, content, user_id, post_images, reply_to_post_id, root_post_id, quote_post_id, visibility, content_warning, created_at, updated_at`,
 		post.Content,
 		post.UserID,
 		imagesJSON,
+		post.ReplyToPostID,
+		post.RootPostID,
+		post.QuotePostID,
+		entitiesJSON,
+		post.Visibility,
+		post.ContentWarning,
 	).Scan(
 		&post.ID,
 		&post.Content,
 		&post.UserID,
 		&jsonRaw,
+		&post.ReplyToPostID,
+		&post.RootPostID,
+		&post.QuotePostID,
+		&post.Visibility,
+		&post.ContentWarning,
 		&post.CreatedAt,
 		&post.UpdatedAt,
 	)
+	if err != nil {
+		return err
+	}
 
+	err = replaceHashtags(ctx, tx, post.ID, post.Hashtags)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return post, nil
+	err = replaceMentions(ctx, tx, post.ID, post.Mentions)
+	if err != nil {
+		return err
+	}
+
+	if post.Poll == nil {
+		return nil
+	}
+
+	return insertPoll(ctx, tx, post.ID, post.Poll)
 }
@@ -0,0 +1,165 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+const draftColumns = `
+			id,
+			user_id,
+			content,
+			COALESCE(post_images, '[]'::jsonb) AS post_images,
+			reply_to_post_id,
+			COALESCE(mentions, '[]'::jsonb) AS mentions,
+			visibility,
+			content_warning,
+			created_at,
+			updated_at`
+
+// CreateDraft implements [domain.PostRepository].
+func (p *PostRepository) CreateDraft(ctx context.Context, draft *domain.Draft) (*domain.Draft, error) {
+	imagesJSON, err := json.Marshal(draft.PostImages)
+	if err != nil {
+		return nil, err
+	}
+
+	mentionsJSON, err := json.Marshal(draft.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved domain.Draft
+
+	query := `
+		INSERT INTO post_drafts (user_id, content, post_images, reply_to_post_id, mentions, visibility, content_warning)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + draftColumns
+
+	err = pgxscan.Get(ctx, p.db, &saved, query, draft.UserID, draft.Content, imagesJSON, draft.ReplyToPostID, mentionsJSON, draft.Visibility, draft.ContentWarning)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+// UpdateDraft implements [domain.PostRepository].
+func (p *PostRepository) UpdateDraft(ctx context.Context, draft *domain.Draft) (*domain.Draft, error) {
+	imagesJSON, err := json.Marshal(draft.PostImages)
+	if err != nil {
+		return nil, err
+	}
+
+	mentionsJSON, err := json.Marshal(draft.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved domain.Draft
+
+	query := `
+		UPDATE post_drafts
+		SET content = $1, post_images = $2, reply_to_post_id = $3, mentions = $4, visibility = $5, content_warning = $6, updated_at = NOW()
+		WHERE id = $7 AND deleted_at IS NULL
+		RETURNING ` + draftColumns
+
+	err = pgxscan.Get(ctx, p.db, &saved, query, draft.Content, imagesJSON, draft.ReplyToPostID, mentionsJSON, draft.Visibility, draft.ContentWarning, draft.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrDraftNotFound
+		}
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+// GetDraftByID implements [domain.PostRepository].
+func (p *PostRepository) GetDraftByID(ctx context.Context, draftID int) (*domain.Draft, error) {
+	var draft domain.Draft
+
+	query := `
+		SELECT ` + draftColumns + `
+		FROM post_drafts
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	err := pgxscan.Get(ctx, p.db, &draft, query, draftID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrDraftNotFound
+		}
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+// GetDraftsByUserID implements [domain.PostRepository].
+func (p *PostRepository) GetDraftsByUserID(ctx context.Context, userID int) ([]domain.Draft, error) {
+	var drafts []domain.Draft
+
+	query := `
+		SELECT ` + draftColumns + `
+		FROM post_drafts
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY updated_at DESC, id DESC
+	`
+
+	err := pgxscan.Select(ctx, p.db, &drafts, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+// DeleteDraft implements [domain.PostRepository].
+func (p *PostRepository) DeleteDraft(ctx context.Context, draftID int) error {
+	cmdTag, err := p.db.Exec(
+		ctx,
+		`DELETE FROM post_drafts WHERE id = $1 AND deleted_at IS NULL`,
+		draftID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return constants.ErrDraftNotFound
+	}
+
+	return nil
+}
+
+// PublishDraft implements [domain.PostRepository].
+func (p *PostRepository) PublishDraft(ctx context.Context, draftID int, post *domain.Post) (*domain.Post, error) {
+	err := pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		cmdTag, err := tx.Exec(
+			ctx,
+			`DELETE FROM post_drafts WHERE id = $1 AND deleted_at IS NULL`,
+			draftID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if cmdTag.RowsAffected() == 0 {
+			return constants.ErrDraftNotFound
+		}
+
+		return insertPost(ctx, tx, post)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
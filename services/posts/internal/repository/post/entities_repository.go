@@ -0,0 +1,67 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// replaceHashtags swaps the hashtags of a post inside the write transaction,
+// rows keep the post's created_at so hashtag feeds order like other feeds.
+func replaceHashtags(ctx context.Context, tx pgx.Tx, postID int, tags []string) error {
+	_, err := tx.Exec(ctx, `DELETE FROM post_hashtags WHERE post_id = $1`, postID)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO post_hashtags (post_id, tag, created_at)
+		SELECT p.id, t.tag, p.created_at
+		FROM posts p, UNNEST($2::text[]) AS t(tag)
+		WHERE p.id = $1
+		ON CONFLICT DO NOTHING`,
+		postID,
+		tags,
+	)
+	return err
+}
+
+// replaceMentions swaps the mentions of a post inside the write transaction.
+func replaceMentions(ctx context.Context, tx pgx.Tx, postID int, mentions []domain.Mention) error {
+	_, err := tx.Exec(ctx, `DELETE FROM post_mentions WHERE post_id = $1`, postID)
+	if err != nil {
+		return err
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	userIDs := make([]int, len(mentions))
+	starts := make([]int, len(mentions))
+	ends := make([]int, len(mentions))
+	for i, mention := range mentions {
+		userIDs[i] = mention.UserID
+		starts[i] = mention.Start
+		ends[i] = mention.End
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO post_mentions (post_id, mentioned_user_id, byte_start, byte_end, created_at)
+		SELECT p.id, m.user_id, m.byte_start, m.byte_end, p.created_at
+		FROM posts p, UNNEST($2::int[], $3::int[], $4::int[]) AS m(user_id, byte_start, byte_end)
+		WHERE p.id = $1`,
+		postID,
+		userIDs,
+		starts,
+		ends,
+	)
+	return err
+}
@@ -0,0 +1,135 @@
+package post
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	util_db "github.com/vhysxl/voidspace/shared/utils/database"
+)
+
+// The benchmarks need a migrated scratch database:
+//
+//	POSTS_BENCH_DB_CONN=postgres://... go test -run ^$ -bench Feed ./internal/repository/post
+//
+// They seed benchPosts posts with benchLikesPerPost likes each, a million
+// likes in total, and remove them again when done.
+const (
+	benchUserID       = -1
+	benchPosts        = 10000
+	benchLikesPerPost = 100
+)
+
+func seedBenchLikes(b *testing.B) *pgxpool.Pool {
+	b.Helper()
+
+	connString := os.Getenv("POSTS_BENCH_DB_CONN")
+	if connString == "" {
+		b.Skip("POSTS_BENCH_DB_CONN is not set")
+	}
+
+	ctx := context.Background()
+
+	db, err := util_db.PostgresDatabase(ctx, connString)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	cleanup := func() {
+		// likes go with the posts through the foreign key
+		_, err := db.Exec(ctx, `DELETE FROM posts WHERE user_id = $1`, benchUserID)
+		if err != nil {
+			b.Error(err)
+		}
+	}
+
+	cleanup()
+	b.Cleanup(func() {
+		cleanup()
+		db.Close()
+	})
+
+	_, err = db.Exec(
+		ctx,
+		`INSERT INTO posts (content, user_id, created_at, likes_count)
+		SELECT 'bench post ' || i, $1, NOW() - i * INTERVAL '1 second', $3
+		FROM generate_series(1, $2) AS i`,
+		benchUserID,
+		benchPosts,
+		benchLikesPerPost,
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, err = db.Exec(
+		ctx,
+		`INSERT INTO post_likes (post_id, user_id, created_at)
+		SELECT p.id, u, NOW()
+		FROM posts p, generate_series(1, $2) AS u
+		WHERE p.user_id = $1`,
+		benchUserID,
+		benchLikesPerPost,
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, table := range []string{"posts", "post_likes"} {
+		_, err = db.Exec(ctx, `ANALYZE `+table)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+// BenchmarkGlobalFeed reads feed pages with the materialized likes_count.
+func BenchmarkGlobalFeed(b *testing.B) {
+	db := seedBenchLikes(b)
+	repo := NewPostRepository(db)
+	ctx := context.Background()
+	cursor := time.Now().Add(time.Minute)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, err := repo.GetGlobalFeed(ctx, cursor, 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkGlobalFeedCountSubquery reads the same pages counting likes per
+// row, as the feed did before likes_count, for comparison.
+func BenchmarkGlobalFeedCountSubquery(b *testing.B) {
+	db := seedBenchLikes(b)
+	ctx := context.Background()
+	cursor := time.Now().Add(time.Minute)
+
+	query := `
+		SELECT p.id, (SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
+			AND deleted_at IS NULL
+		) AS likes_count
+		FROM posts p
+		WHERE p.deleted_at IS NULL AND p.visibility = 'public'
+		  AND ((p.created_at < $1) OR (p.created_at = $1 AND p.id < $2))
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $3
+	`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rows, err := db.Query(ctx, query, cursor, 0, 10+1)
+		if err != nil {
+			b.Fatal(err)
+		}
+		rows.Close()
+		if rows.Err() != nil {
+			b.Fatal(rows.Err())
+		}
+	}
+}
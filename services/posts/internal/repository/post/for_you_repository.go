@@ -0,0 +1,172 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// GetRankCandidates implements [domain.PostRepository]. Affinity counts the
+// viewer's likes, reposts and replies on each author's posts over the last
+// 30 days. Unlisted posts stay out of feeds. Most candidates are preselected
+// by likes per hour, the same two hour offset the ranking uses, so a post old
+// enough to have piled up likes doesn't crowd out fresh ones. Fresh posts
+// with no likes yet still make it in through the reserved picks: the newest
+// posts fanned out to the viewer's home timeline, which are the authors they
+// follow, and the newest posts by the authors they interact with most.
+func (p *PostRepository) GetRankCandidates(
+	ctx context.Context,
+	viewerID int,
+	since time.Time,
+	limit int,
+	reserved int,
+) ([]domain.RankCandidate, error) {
+	var candidates []domain.RankCandidate
+
+	eligible := `p.created_at > $2
+				  AND p.deleted_at IS NULL
+				  AND p.reply_to_post_id IS NULL
+				  AND p.user_id <> $1
+				  AND p.visibility <> 'unlisted'
+				  AND (` + visibleTo("$1", "'{}'") + ` OR p.visibility = 'followers')`
+
+	query := `
+		WITH affinity AS (
+			SELECT author_id, COUNT(*) AS interactions
+			FROM (
+				SELECT lp.user_id AS author_id
+				FROM post_likes l
+				JOIN posts lp ON lp.id = l.post_id
+				WHERE l.user_id = $1 AND l.deleted_at IS NULL
+				  AND l.created_at > NOW() - INTERVAL '30 days'
+				UNION ALL
+				SELECT rp.user_id
+				FROM post_reposts r
+				JOIN posts rp ON rp.id = r.post_id
+				WHERE r.user_id = $1 AND r.deleted_at IS NULL
+				  AND r.created_at > NOW() - INTERVAL '30 days'
+				UNION ALL
+				SELECT parent.user_id
+				FROM posts reply
+				JOIN posts parent ON parent.id = reply.reply_to_post_id
+				WHERE reply.user_id = $1 AND reply.deleted_at IS NULL
+				  AND reply.created_at > NOW() - INTERVAL '30 days'
+			) interactions
+			GROUP BY author_id
+		),
+		pool AS (
+			(SELECT p.id
+			FROM posts p
+			WHERE ` + eligible + `
+			ORDER BY p.likes_count / (EXTRACT(EPOCH FROM NOW() - p.created_at) / 3600 + 2) DESC, p.created_at DESC
+			LIMIT $3)
+			UNION
+			(SELECT p.id
+			FROM home_timelines t
+			JOIN posts p ON p.id = t.post_id
+			WHERE t.user_id = $1 AND NOT t.repost
+			  AND ` + eligible + `
+			ORDER BY p.created_at DESC
+			LIMIT $4)
+			UNION
+			(SELECT p.id
+			FROM posts p
+			JOIN affinity a ON a.author_id = p.user_id
+			WHERE ` + eligible + `
+			ORDER BY a.interactions DESC, p.created_at DESC
+			LIMIT $4)
+		)
+		SELECT
+			p.id AS post_id,
+			p.user_id,
+			p.created_at,
+			p.visibility = 'followers' AND NOT EXISTS (
+				SELECT 1 FROM post_mentions fm
+				WHERE fm.post_id = p.id AND fm.mentioned_user_id = $1
+			) AS followers_only,
+			p.likes_count,
+			(SELECT COUNT(*) FROM posts r WHERE r.reply_to_post_id = p.id
+			AND r.deleted_at IS NULL
+			) AS replies_count,
+			(SELECT COUNT(*) FROM post_reposts rp WHERE rp.post_id = p.id
+			AND rp.deleted_at IS NULL
+			) AS reposts_count,
+			(SELECT COUNT(*) FROM posts q WHERE q.quote_post_id = p.id
+			AND q.deleted_at IS NULL
+			) AS quotes_count,
+			COALESCE(a.interactions, 0) AS affinity
+		FROM pool
+		JOIN posts p ON p.id = pool.id
+		LEFT JOIN affinity a ON a.author_id = p.user_id
+	`
+
+	err := pgxscan.Select(ctx, p.db, &candidates, query, viewerID, since, limit, reserved)
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// CreateFeedSnapshot implements [domain.PostRepository].
+func (p *PostRepository) CreateFeedSnapshot(
+	ctx context.Context,
+	userID int,
+	postIDs []int,
+	ttl time.Duration,
+) (int, error) {
+	_, err := p.db.Exec(
+		ctx,
+		`DELETE FROM for_you_snapshots WHERE created_at < NOW() - make_interval(secs => $1)`,
+		ttl.Seconds(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var snapshotID int
+
+	err = p.db.QueryRow(
+		ctx,
+		`INSERT INTO for_you_snapshots (user_id, post_ids) VALUES ($1, $2) RETURNING id`,
+		userID,
+		postIDs,
+	).Scan(&snapshotID)
+	if err != nil {
+		return 0, err
+	}
+
+	return snapshotID, nil
+}
+
+// GetFeedSnapshot implements [domain.PostRepository].
+func (p *PostRepository) GetFeedSnapshot(
+	ctx context.Context,
+	snapshotID int,
+	userID int,
+	ttl time.Duration,
+) ([]int, error) {
+	var postIDs []int
+
+	err := p.db.QueryRow(
+		ctx,
+		`SELECT post_ids FROM for_you_snapshots
+		WHERE id = $1 AND user_id = $2 AND created_at > NOW() - make_interval(secs => $3)`,
+		snapshotID,
+		userID,
+		ttl.Seconds(),
+	).Scan(&postIDs)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrFeedSnapshotExpired
+		}
+		return nil, err
+	}
+
+	return postIDs, nil
+}
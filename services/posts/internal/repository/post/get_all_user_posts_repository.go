@@ -2,35 +2,80 @@ package post
 
 import (
 	"context"
+	"time"
 	"voidspace/posts/internal/domain"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 )
 
 // GetAllUserPosts implements [domain.PostRepository].
-func (p *PostRepository) GetByUserID(ctx context.Context, userID int) ([]domain.Post, error) {
+func (p *PostRepository) GetByUserID(
+	ctx context.Context,
+	userID int,
+	viewerID *int,
+	followsAuthor bool,
+	cursorTime time.Time,
+	cursorID int,
+) ([]domain.Post, bool, error) {
 	var posts []domain.Post
 
+	followed := []int{}
+	if followsAuthor {
+		followed = append(followed, userID)
+	}
+
+	// the cursor is on feed_at, the repost time for reposted entries. Each
+	// branch is cut to the page before the merge, a post the user reposted
+	// only shows as the repost. Pinned posts are listed above the timeline by
+	// the usecase.
 	query := `
-		SELECT 
-			p.id, 
-			p.content, 
-			p.user_id, 
-			COALESCE(p.post_images, '[]'::jsonb) AS post_images, 
-			p.created_at, 
-			p.updated_at,
-			(SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
-			AND deleted_at IS NULL
-			) AS likes_count
-		FROM posts p
-		WHERE p.user_id = $1 AND p.deleted_at IS NULL
-		ORDER BY p.created_at DESC
+		WITH entries AS (
+			(SELECT p.id AS post_id, p.created_at AS feed_at, NULL::int AS reposted_by
+			FROM posts p
+			WHERE p.user_id = $1
+			  AND p.deleted_at IS NULL
+			  AND ` + visibleTo("$5", "$6") + `
+			  AND NOT EXISTS (SELECT 1 FROM pinned_posts pp WHERE pp.post_id = p.id)
+			  AND NOT EXISTS (
+				SELECT 1 FROM post_reposts sr
+				WHERE sr.post_id = p.id AND sr.user_id = $1 AND sr.deleted_at IS NULL
+				  AND sr.created_at >= p.created_at
+			  )
+			  AND ((p.created_at < $2) OR (p.created_at = $2 AND p.id < $3))
+			ORDER BY p.created_at DESC, p.id DESC
+			LIMIT $4)
+			UNION ALL
+			(SELECT r.post_id, r.created_at, r.user_id
+			FROM post_reposts r
+			JOIN posts p ON p.id = r.post_id
+			WHERE r.user_id = $1
+			  AND r.deleted_at IS NULL
+			  AND p.deleted_at IS NULL
+			  AND ` + visibleTo("$5", "$6") + `
+			  AND ((r.created_at < $2) OR (r.created_at = $2 AND r.post_id < $3))
+			ORDER BY r.created_at DESC, r.post_id DESC
+			LIMIT $4)
+		), feed_entries AS (
+			SELECT DISTINCT ON (post_id) post_id, feed_at, reposted_by
+			FROM entries
+			ORDER BY post_id, feed_at DESC
+		)
+		SELECT ` + postColumns + repostColumns + `
+		FROM feed_entries e
+		JOIN posts p ON p.id = e.post_id
+		ORDER BY e.feed_at DESC, p.id DESC
+		LIMIT $4
 	`
 
-	err := pgxscan.Select(ctx, p.db, &posts, query, userID)
+	err := pgxscan.Select(ctx, p.db, &posts, query, userID, cursorTime, cursorID, 10+1, viewerID, followed)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	hasMore := len(posts) > 10
+	if hasMore {
+		posts = posts[:10]
 	}
 
-	return posts, nil
+	return posts, hasMore, nil
 }
@@ -0,0 +1,44 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// maxAncestorDepth guards the walk up against very long or corrupted chains.
+const maxAncestorDepth = 50
+
+// GetAncestors walks reply_to_post_id upwards from postID and returns the
+// chain root first. The walk stops at a deleted or missing parent.
+func (p *PostRepository) GetAncestors(ctx context.Context, postID int) ([]domain.Post, error) {
+	var posts []domain.Post
+
+	query := `
+		WITH RECURSIVE chain AS (
+			SELECT reply_to_post_id AS id, 1 AS depth
+			FROM posts
+			WHERE id = $1 AND reply_to_post_id IS NOT NULL
+
+			UNION ALL
+
+			SELECT parent.reply_to_post_id, chain.depth + 1
+			FROM chain
+			JOIN posts parent ON parent.id = chain.id AND parent.deleted_at IS NULL
+			WHERE parent.reply_to_post_id IS NOT NULL AND chain.depth < $2
+		)
+		SELECT ` + postColumns + `
+		FROM chain
+		JOIN posts p ON p.id = chain.id
+		WHERE p.deleted_at IS NULL
+		ORDER BY chain.depth DESC
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, postID, maxAncestorDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,48 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetBookmarked implements [domain.PostRepository]. The page is keyed on the
+// bookmark time and post id, a user bookmarks a post at most once.
+func (p *PostRepository) GetBookmarked(
+	ctx context.Context,
+	userID int,
+	folder *string,
+	cursorTime time.Time,
+	cursorID int,
+) ([]domain.Post, bool, error) {
+	var posts []domain.Post
+
+	query := `
+		SELECT ` + postColumns + `,
+			b.created_at AS bookmarked_at,
+			b.folder AS bookmark_folder
+		FROM post_bookmarks b
+		JOIN posts p ON p.id = b.post_id
+		WHERE b.user_id = $1
+		AND ($2::text IS NULL OR b.folder = $2)
+		AND b.deleted_at IS NULL
+		AND p.deleted_at IS NULL
+		AND ((b.created_at < $3) OR (b.created_at = $3 AND b.post_id < $4))
+		ORDER BY b.created_at DESC, b.post_id DESC
+		LIMIT $5
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, userID, folder, cursorTime, cursorID, 10+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(posts) > 10
+	if hasMore {
+		posts = posts[:10]
+	}
+
+	return posts, hasMore, nil
+}
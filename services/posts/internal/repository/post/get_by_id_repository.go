@@ -15,16 +15,7 @@ func (p *PostRepository) GetByID(ctx context.Context, postID int) (*domain.Post,
 	var post domain.Post
 
 	query := `
-		SELECT
-			p.id,
-			p.content,
-			p.user_id,
-			COALESCE(p.post_images, '[]'::jsonb) AS post_images,
-		p.created_at,
-			p.updated_at,
-			(SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
-			AND deleted_at IS NULL
-			) AS likes_count
+		SELECT ` + postColumns + `
         FROM posts p
 		WHERE p.id = $1 AND p.deleted_at IS NULL
 	`
@@ -0,0 +1,30 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetByIDs implements [domain.PostRepository].
+func (p *PostRepository) GetByIDs(ctx context.Context, postIDs []int) ([]domain.Post, error) {
+	if len(postIDs) == 0 {
+		return []domain.Post{}, nil
+	}
+
+	var posts []domain.Post
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		WHERE p.id = ANY($1) AND p.deleted_at IS NULL
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,50 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetDescendants returns the replies below parentIDs, up to maxDepth levels
+// deep and at most limit rows, ordered level by level.
+func (p *PostRepository) GetDescendants(
+	ctx context.Context,
+	parentIDs []int,
+	maxDepth int,
+	limit int,
+) ([]domain.Post, error) {
+	var posts []domain.Post
+
+	if len(parentIDs) == 0 || maxDepth <= 0 {
+		return posts, nil
+	}
+
+	query := `
+		WITH RECURSIVE tree AS (
+			SELECT id, 1 AS depth
+			FROM posts
+			WHERE reply_to_post_id = ANY($1) AND deleted_at IS NULL
+
+			UNION ALL
+
+			SELECT child.id, tree.depth + 1
+			FROM tree
+			JOIN posts child ON child.reply_to_post_id = tree.id AND child.deleted_at IS NULL
+			WHERE tree.depth < $2
+		)
+		SELECT ` + postColumns + `
+		FROM tree
+		JOIN posts p ON p.id = tree.id
+		ORDER BY tree.depth ASC, p.created_at ASC, p.id ASC
+		LIMIT $3
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, parentIDs, maxDepth, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
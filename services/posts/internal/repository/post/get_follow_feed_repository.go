@@ -9,28 +9,22 @@ import (
 )
 
 // GetFollowFeed implements [domain.PostRepository].
-func (p *PostRepository) GetFollowingFeed(ctx context.Context, userIDs []int, cursorTime time.Time, cursorID int) ([]domain.Post, bool, error) {
+func (p *PostRepository) GetFollowingFeed(ctx context.Context, viewerID int, userIDs []int, cursorTime time.Time, cursorID int) ([]domain.Post, bool, error) {
 	var posts []domain.Post
 
-	query := `
-		SELECT 
-			p.id, 
-			p.content, 
-			p.user_id, 
-			COALESCE(p.post_images, '[]'::jsonb) AS post_images, 
-			p.created_at, 
-			p.updated_at,
-			(SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
-			AND deleted_at IS NULL
-			) AS likes_count
-		FROM posts p
-		WHERE p.user_id = ANY($1) AND p.deleted_at IS NULL
-		  AND ((p.created_at < $2) OR (p.created_at = $2 AND p.id < $3))
-		ORDER BY p.created_at DESC, p.id DESC
+	// the cursor is on feed_at, the repost time for reposted entries
+	query := repostEntries("user_id = ANY($1)") + `
+		SELECT ` + postColumns + repostColumns + `
+		FROM feed_entries e
+		JOIN posts p ON p.id = e.post_id
+		WHERE p.deleted_at IS NULL
+		  AND ` + visibleTo("$5", "$1") + `
+		  AND ((e.feed_at < $2) OR (e.feed_at = $2 AND p.id < $3))
+		ORDER BY e.feed_at DESC, p.id DESC
 		LIMIT $4
 	`
 
-	err := pgxscan.Select(ctx, p.db, &posts, query, userIDs, cursorTime, cursorID, 10+1)
+	err := pgxscan.Select(ctx, p.db, &posts, query, userIDs, cursorTime, cursorID, 10+1, viewerID)
 	if err != nil {
 		return nil, false, err
 	}
@@ -13,18 +13,9 @@ func (p *PostRepository) GetGlobalFeed(ctx context.Context, cursorTime time.Time
 	var posts []domain.Post
 
 	query := `
-		SELECT 
-			p.id, 
-			p.content, 
-			p.user_id, 
-			COALESCE(p.post_images, '[]'::jsonb) AS post_images, 
-			p.created_at, 
-			p.updated_at,
-			(SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
-			AND deleted_at IS NULL
-			) AS likes_count
+		SELECT ` + postColumns + `
 		FROM posts p
-		WHERE p.deleted_at IS NULL 
+		WHERE p.deleted_at IS NULL AND p.visibility = 'public'
 		  AND ((p.created_at < $1) OR (p.created_at = $1 AND p.id < $2))
 		ORDER BY p.created_at DESC, p.id DESC
 		LIMIT $3
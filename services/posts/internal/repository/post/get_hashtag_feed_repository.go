@@ -0,0 +1,36 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetHashtagFeed implements [domain.PostRepository].
+func (p *PostRepository) GetHashtagFeed(ctx context.Context, tag string, cursorTime time.Time, cursorID int) ([]domain.Post, bool, error) {
+	var posts []domain.Post
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM post_hashtags h
+		JOIN posts p ON p.id = h.post_id
+		WHERE h.tag = $1 AND p.deleted_at IS NULL AND p.visibility = 'public'
+		  AND ((h.created_at < $2) OR (h.created_at = $2 AND h.post_id < $3))
+		ORDER BY h.created_at DESC, h.post_id DESC
+		LIMIT $4
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, tag, cursorTime, cursorID, 10+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(posts) > 10
+	if hasMore {
+		posts = posts[:10]
+	}
+
+	return posts, hasMore, nil
+}
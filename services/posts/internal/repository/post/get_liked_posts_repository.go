@@ -2,38 +2,44 @@ package post
 
 import (
 	"context"
+	"time"
 	"voidspace/posts/internal/domain"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 )
 
-// GetLikedPosts implements [domain.PostRepository].
-func (p *PostRepository) GetLikedByUserID(ctx context.Context, userID int) ([]domain.Post, error) {
+// GetLikedPosts implements [domain.PostRepository]. The page is keyed on the
+// like time and post id, a user likes a post at most once.
+func (p *PostRepository) GetLikedByUserID(
+	ctx context.Context,
+	userID int,
+	cursorTime time.Time,
+	cursorID int,
+) ([]domain.Post, bool, error) {
 	var posts []domain.Post
 
 	query := `
-		SELECT 
-			p.id, 
-			p.content, 
-			p.user_id, 
-			COALESCE(p.post_images, '[]'::jsonb) AS post_images, 
-			p.created_at, 
-			p.updated_at,
-			(SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
-			AND deleted_at IS NULL
-			) AS likes_count
-		FROM posts p
-		JOIN post_likes pl ON p.id = pl.post_id
-		WHERE pl.user_id = $1 
+		SELECT ` + postColumns + `,
+			pl.created_at AS liked_at
+		FROM post_likes pl
+		JOIN posts p ON p.id = pl.post_id
+		WHERE pl.user_id = $1
 		AND p.deleted_at IS NULL
 		AND pl.deleted_at IS NULL
-		ORDER BY pl.created_at DESC
+		AND ((pl.created_at < $2) OR (pl.created_at = $2 AND pl.post_id < $3))
+		ORDER BY pl.created_at DESC, pl.post_id DESC
+		LIMIT $4
 	`
 
-	err := pgxscan.Select(ctx, p.db, &posts, query, userID)
+	err := pgxscan.Select(ctx, p.db, &posts, query, userID, cursorTime, cursorID, 10+1)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return posts, nil
+	hasMore := len(posts) > 10
+	if hasMore {
+		posts = posts[:10]
+	}
+
+	return posts, hasMore, nil
 }
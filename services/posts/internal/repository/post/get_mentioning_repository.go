@@ -0,0 +1,41 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetMentioning implements [domain.PostRepository].
+func (p *PostRepository) GetMentioning(ctx context.Context, userID int, cursorTime time.Time, cursorID int) ([]domain.Post, bool, error) {
+	var posts []domain.Post
+
+	// mention rows carry the post's created_at, so the cursor can be applied
+	// on post_mentions. Self mentions are left out.
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		WHERE p.id IN (
+			SELECT m.post_id FROM post_mentions m
+			WHERE m.mentioned_user_id = $1
+			  AND ((m.created_at < $2) OR (m.created_at = $2 AND m.post_id < $3))
+		)
+		  AND p.deleted_at IS NULL AND p.user_id <> $1
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $4
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, userID, cursorTime, cursorID, 10+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(posts) > 10
+	if hasMore {
+		posts = posts[:10]
+	}
+
+	return posts, hasMore, nil
+}
@@ -0,0 +1,37 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetReplies returns direct replies to postID oldest first, after the
+// (cursorTime, cursorID) keyset.
+func (p *PostRepository) GetReplies(
+	ctx context.Context,
+	postID int,
+	cursorTime time.Time,
+	cursorID int,
+	limit int,
+) ([]domain.Post, error) {
+	var posts []domain.Post
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		WHERE p.reply_to_post_id = $1 AND p.deleted_at IS NULL
+		  AND ((p.created_at > $2) OR (p.created_at = $2 AND p.id > $3))
+		ORDER BY p.created_at ASC, p.id ASC
+		LIMIT $4
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, postID, cursorTime, cursorID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
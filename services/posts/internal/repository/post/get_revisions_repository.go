@@ -0,0 +1,32 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetRevisions implements [domain.PostRepository].
+func (p *PostRepository) GetRevisions(ctx context.Context, postID int) ([]domain.PostRevision, error) {
+	var revisions []domain.PostRevision
+
+	query := `
+		SELECT
+			ROW_NUMBER() OVER (ORDER BY id) AS version,
+			content,
+			COALESCE(post_images, '[]'::jsonb) AS post_images,
+			entities,
+			created_at
+		FROM post_revisions
+		WHERE post_id = $1
+		ORDER BY id DESC
+	`
+
+	err := pgxscan.Select(ctx, p.db, &revisions, query, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
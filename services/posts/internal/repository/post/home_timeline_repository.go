@@ -0,0 +1,227 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// liveTimelineEntry filters timeline rows aliased as alias down to the ones
+// still backed by their source, a repost row can outlive an undone repost
+// while the undo races a fan-out.
+func liveTimelineEntry(alias string) string {
+	return `(NOT ` + alias + `.repost OR EXISTS (
+				SELECT 1 FROM post_reposts lr
+				WHERE lr.post_id = ` + alias + `.post_id AND lr.user_id = ` + alias + `.source_id
+				  AND lr.deleted_at IS NULL
+			))`
+}
+
+// GetHomeTimeline implements [domain.PostRepository]. Each post shows once,
+// at its latest entry: a timeline row is skipped when a later row or a
+// later celebrity repost of the post exists, and ties go to the timeline.
+// Every source is filtered before its LIMIT so a page is only short at the
+// end of the timeline. Followers only posts are let through for the
+// usecase to check against the viewer's follows.
+func (p *PostRepository) GetHomeTimeline(
+	ctx context.Context,
+	viewerID int,
+	celebrityIDs []int,
+	cursorTime time.Time,
+	cursorID int,
+) ([]domain.Post, bool, error) {
+	var posts []domain.Post
+
+	visible := `(` + visibleTo("$1", "$5") + ` OR p.visibility = 'followers')`
+
+	query := `
+		WITH entries AS (
+			(SELECT t.post_id, t.feed_at, CASE WHEN t.repost THEN t.source_id END AS reposted_by
+			FROM home_timelines t
+			JOIN posts p ON p.id = t.post_id
+			WHERE t.user_id = $1
+			  AND ((t.feed_at < $2) OR (t.feed_at = $2 AND t.post_id < $3))
+			  AND p.deleted_at IS NULL
+			  AND ` + visible + `
+			  AND ` + liveTimelineEntry("t") + `
+			  AND NOT EXISTS (
+				SELECT 1 FROM home_timelines n
+				WHERE n.user_id = $1 AND n.post_id = t.post_id
+				  AND (n.feed_at, n.source_id, n.repost) > (t.feed_at, t.source_id, t.repost)
+				  AND ` + liveTimelineEntry("n") + `
+			  )
+			  AND NOT EXISTS (
+				SELECT 1 FROM post_reposts cr
+				WHERE cr.post_id = t.post_id AND cr.user_id = ANY($5) AND cr.deleted_at IS NULL
+				  AND cr.created_at > t.feed_at
+			  )
+			ORDER BY t.feed_at DESC, t.post_id DESC
+			LIMIT $4)
+			UNION ALL
+			(SELECT p.id, p.created_at, NULL::int
+			FROM posts p
+			WHERE p.user_id = ANY($5)
+			  AND ((p.created_at < $2) OR (p.created_at = $2 AND p.id < $3))
+			  AND p.deleted_at IS NULL
+			  AND ` + visible + `
+			  AND NOT EXISTS (
+				SELECT 1 FROM home_timelines n
+				WHERE n.user_id = $1 AND n.post_id = p.id AND n.feed_at >= p.created_at
+				  AND ` + liveTimelineEntry("n") + `
+			  )
+			  AND NOT EXISTS (
+				SELECT 1 FROM post_reposts cr
+				WHERE cr.post_id = p.id AND cr.user_id = ANY($5) AND cr.deleted_at IS NULL
+				  AND cr.created_at >= p.created_at
+			  )
+			ORDER BY p.created_at DESC, p.id DESC
+			LIMIT $4)
+			UNION ALL
+			(SELECT r.post_id, r.created_at, r.user_id
+			FROM post_reposts r
+			JOIN posts p ON p.id = r.post_id
+			WHERE r.user_id = ANY($5) AND r.deleted_at IS NULL
+			  AND ((r.created_at < $2) OR (r.created_at = $2 AND r.post_id < $3))
+			  AND p.deleted_at IS NULL
+			  AND ` + visible + `
+			  AND NOT EXISTS (
+				SELECT 1 FROM home_timelines n
+				WHERE n.user_id = $1 AND n.post_id = r.post_id AND n.feed_at >= r.created_at
+				  AND ` + liveTimelineEntry("n") + `
+			  )
+			  AND NOT EXISTS (
+				SELECT 1 FROM post_reposts cr
+				WHERE cr.post_id = r.post_id AND cr.user_id = ANY($5) AND cr.deleted_at IS NULL
+				  AND (cr.created_at, cr.user_id) > (r.created_at, r.user_id)
+			  )
+			ORDER BY r.created_at DESC, r.post_id DESC
+			LIMIT $4)
+		)
+		SELECT ` + postColumns + repostColumns + `
+		FROM entries e
+		JOIN posts p ON p.id = e.post_id
+		ORDER BY e.feed_at DESC, p.id DESC
+		LIMIT $4
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, viewerID, cursorTime, cursorID, 10+1, celebrityIDs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(posts) > 10
+	if hasMore {
+		posts = posts[:10]
+	}
+
+	return posts, hasMore, nil
+}
+
+// FanOutPost implements [domain.PostRepository].
+func (p *PostRepository) FanOutPost(ctx context.Context, postID int, repostedBy *int, userIDs []int) error {
+	query := `
+		INSERT INTO home_timelines (user_id, post_id, source_id, repost, feed_at)
+		SELECT t.user_id, p.id, COALESCE(r.user_id, p.user_id), r.user_id IS NOT NULL, COALESCE(r.created_at, p.created_at)
+		FROM posts p
+		LEFT JOIN post_reposts r ON r.post_id = p.id AND r.user_id = $2 AND r.deleted_at IS NULL
+		CROSS JOIN (SELECT DISTINCT UNNEST($3::int[]) AS user_id) t
+		WHERE p.id = $1
+		  AND p.deleted_at IS NULL
+		  AND ($2::int IS NULL OR r.user_id IS NOT NULL)
+		ON CONFLICT (user_id, post_id, source_id, repost) DO NOTHING
+	`
+
+	_, err := p.db.Exec(ctx, query, postID, repostedBy, userIDs)
+	return err
+}
+
+// BackfillTimeline implements [domain.PostRepository].
+func (p *PostRepository) BackfillTimeline(ctx context.Context, userID int, authorIDs []int, perAuthor int) error {
+	query := `
+		INSERT INTO home_timelines (user_id, post_id, source_id, repost, feed_at)
+		SELECT $1::int, e.post_id, a.id, e.repost, e.feed_at
+		FROM UNNEST($2::int[]) AS a(id)
+		CROSS JOIN LATERAL (
+			(SELECT id AS post_id, FALSE AS repost, created_at AS feed_at
+			FROM posts
+			WHERE user_id = a.id AND deleted_at IS NULL
+			ORDER BY created_at DESC
+			LIMIT $3)
+			UNION ALL
+			(SELECT post_id, TRUE, created_at
+			FROM post_reposts
+			WHERE user_id = a.id AND deleted_at IS NULL
+			ORDER BY created_at DESC
+			LIMIT $3)
+		) e
+		WHERE a.id NOT IN (SELECT user_id FROM timeline_celebrities)
+		ON CONFLICT (user_id, post_id, source_id, repost) DO NOTHING
+	`
+
+	_, err := p.db.Exec(ctx, query, userID, authorIDs, perAuthor)
+	return err
+}
+
+// ClearTimelineSource implements [domain.PostRepository]. Rows of the same
+// posts from other sources stay.
+func (p *PostRepository) ClearTimelineSource(ctx context.Context, userID int, sourceID int) error {
+	_, err := p.db.Exec(
+		ctx,
+		`DELETE FROM home_timelines WHERE user_id = $1 AND source_id = $2`,
+		userID,
+		sourceID,
+	)
+	return err
+}
+
+// IsTimelineBuilt implements [domain.PostRepository].
+func (p *PostRepository) IsTimelineBuilt(ctx context.Context, userID int) (bool, error) {
+	var built bool
+
+	err := p.db.QueryRow(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM home_timeline_states WHERE user_id = $1)`,
+		userID,
+	).Scan(&built)
+	if err != nil {
+		return false, err
+	}
+
+	return built, nil
+}
+
+// MarkTimelineBuilt implements [domain.PostRepository].
+func (p *PostRepository) MarkTimelineBuilt(ctx context.Context, userID int) error {
+	_, err := p.db.Exec(
+		ctx,
+		`INSERT INTO home_timeline_states (user_id) VALUES ($1)
+		ON CONFLICT (user_id) DO UPDATE SET built_at = NOW()`,
+		userID,
+	)
+	return err
+}
+
+// ListTimelineCelebrities implements [domain.PostRepository].
+func (p *PostRepository) ListTimelineCelebrities(ctx context.Context) ([]int, error) {
+	userIDs := []int{}
+
+	err := pgxscan.Select(ctx, p.db, &userIDs, `SELECT user_id FROM timeline_celebrities`)
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// SetTimelineCelebrity implements [domain.PostRepository].
+func (p *PostRepository) SetTimelineCelebrity(ctx context.Context, userID int, celebrity bool) error {
+	query := `DELETE FROM timeline_celebrities WHERE user_id = $1`
+	if celebrity {
+		query = `INSERT INTO timeline_celebrities (user_id) VALUES ($1) ON CONFLICT (user_id) DO NOTHING`
+	}
+
+	_, err := p.db.Exec(ctx, query, userID)
+	return err
+}
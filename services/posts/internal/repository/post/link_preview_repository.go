@@ -0,0 +1,114 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type linkPreviewRow struct {
+	PostID int
+	domain.LinkPreview
+}
+
+// GetLinkPreview implements [domain.PostRepository].
+func (p *PostRepository) GetLinkPreview(ctx context.Context, url string) (*domain.LinkPreview, error) {
+	var preview domain.LinkPreview
+
+	query := `
+		SELECT url, title, description, image_url, site_name, fetched_at
+		FROM link_previews
+		WHERE url = $1
+	`
+
+	err := pgxscan.Get(ctx, p.db, &preview, query, url)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrLinkPreviewNotFound
+		}
+		return nil, err
+	}
+
+	return &preview, nil
+}
+
+// SetLinkPreview implements [domain.PostRepository].
+func (p *PostRepository) SetLinkPreview(ctx context.Context, postID int, preview *domain.LinkPreview) error {
+	return pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		_, err := tx.Exec(
+			ctx,
+			`INSERT INTO link_previews (url, title, description, image_url, site_name, fetched_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (url) DO UPDATE SET
+				title = EXCLUDED.title,
+				description = EXCLUDED.description,
+				image_url = EXCLUDED.image_url,
+				site_name = EXCLUDED.site_name,
+				fetched_at = EXCLUDED.fetched_at`,
+			preview.URL,
+			preview.Title,
+			preview.Description,
+			preview.ImageURL,
+			preview.SiteName,
+			preview.FetchedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE posts SET link_preview_url = $2 WHERE id = $1 AND deleted_at IS NULL`,
+			postID,
+			preview.URL,
+		)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return constants.ErrPostNotFound
+		}
+
+		return nil
+	})
+}
+
+// GetLinkPreviews implements [domain.PostRepository].
+func (p *PostRepository) GetLinkPreviews(ctx context.Context, postIDs []int) (map[int]*domain.LinkPreview, error) {
+	result := make(map[int]*domain.LinkPreview)
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []linkPreviewRow
+
+	query := `
+		SELECT
+			p.id AS post_id,
+			lp.url,
+			lp.title,
+			lp.description,
+			lp.image_url,
+			lp.site_name,
+			lp.fetched_at
+		FROM posts p
+		JOIN link_previews lp ON lp.url = p.link_preview_url
+		WHERE p.id = ANY($1)
+	`
+
+	err := pgxscan.Select(ctx, p.db, &rows, query, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		result[rows[i].PostID] = &rows[i].LinkPreview
+	}
+
+	return result, nil
+}
@@ -0,0 +1,86 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// PinPost implements [domain.PostRepository].
+func (p *PostRepository) PinPost(ctx context.Context, userID int, postID int, maxPins int) error {
+	return pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		// serializes pins per user so two concurrent pins can't both pass
+		// the limit
+		_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('pinned_posts'), $1)`, userID)
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(
+			ctx,
+			`INSERT INTO pinned_posts (post_id, user_id) VALUES ($1, $2)
+			ON CONFLICT (post_id) DO NOTHING`,
+			postID,
+			userID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		// pins of soft deleted posts come back with the account, they still
+		// count
+		var pins int
+		err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM pinned_posts WHERE user_id = $1`, userID).Scan(&pins)
+		if err != nil {
+			return err
+		}
+
+		if pins > maxPins {
+			return constants.ErrPinLimitReached
+		}
+
+		return nil
+	})
+}
+
+// UnpinPost implements [domain.PostRepository].
+func (p *PostRepository) UnpinPost(ctx context.Context, userID int, postID int) error {
+	_, err := p.db.Exec(
+		ctx,
+		`DELETE FROM pinned_posts WHERE post_id = $1 AND user_id = $2`,
+		postID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPinnedByUserID implements [domain.PostRepository].
+func (p *PostRepository) GetPinnedByUserID(ctx context.Context, userID int) ([]domain.Post, error) {
+	var posts []domain.Post
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM pinned_posts pp
+		JOIN posts p ON p.id = pp.post_id
+		WHERE pp.user_id = $1 AND p.deleted_at IS NULL
+		ORDER BY pp.created_at DESC, p.id DESC
+	`
+
+	err := pgxscan.Select(ctx, p.db, &posts, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,260 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type pollOptionRow struct {
+	PostID int
+	domain.PollOption
+}
+
+// insertPoll writes the poll of a post being created inside tx, options keep
+// the order they were sent in.
+func insertPoll(ctx context.Context, tx pgx.Tx, postID int, poll *domain.Poll) error {
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO polls (post_id, multiple_choice, ends_at) VALUES ($1, $2, $3)`,
+		postID,
+		poll.MultipleChoice,
+		poll.EndsAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	poll.PostID = postID
+	for i := range poll.Options {
+		poll.Options[i].Position = i
+		err = tx.QueryRow(
+			ctx,
+			`INSERT INTO poll_options (post_id, position, label) VALUES ($1, $2, $3) RETURNING id`,
+			postID,
+			i,
+			poll.Options[i].Label,
+		).Scan(&poll.Options[i].ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPolls implements [domain.PostRepository].
+func (p *PostRepository) GetPolls(ctx context.Context, postIDs []int, userID *int) (map[int]*domain.Poll, error) {
+	result := make(map[int]*domain.Poll)
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	var polls []domain.Poll
+
+	query := `
+		SELECT
+			p.post_id,
+			p.multiple_choice,
+			p.ends_at,
+			p.closed_at,
+			(SELECT COUNT(*) FROM poll_voters v WHERE v.post_id = p.post_id) AS voters_count
+		FROM polls p
+		WHERE p.post_id = ANY($1)
+	`
+
+	err := pgxscan.Select(ctx, p.db, &polls, query, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(polls) == 0 {
+		return result, nil
+	}
+
+	for i := range polls {
+		result[polls[i].PostID] = &polls[i]
+	}
+
+	var options []pollOptionRow
+
+	query = `
+		SELECT
+			o.post_id,
+			o.id,
+			o.position,
+			o.label,
+			COUNT(v.user_id) AS votes_count,
+			COALESCE(BOOL_OR(v.user_id = $2), FALSE) AS is_voted
+		FROM poll_options o
+		LEFT JOIN poll_votes v ON v.option_id = o.id
+		WHERE o.post_id = ANY($1)
+		GROUP BY o.id
+		ORDER BY o.post_id, o.position
+	`
+
+	err = pgxscan.Select(ctx, p.db, &options, query, postIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, option := range options {
+		poll := result[option.PostID]
+		if poll != nil {
+			poll.Options = append(poll.Options, option.PollOption)
+		}
+	}
+
+	if userID == nil {
+		return result, nil
+	}
+
+	var votedPostIDs []int
+
+	query = `
+		SELECT post_id
+		FROM poll_voters
+		WHERE user_id = $1 AND post_id = ANY($2)
+	`
+
+	err = pgxscan.Select(ctx, p.db, &votedPostIDs, query, *userID, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, postID := range votedPostIDs {
+		if poll := result[postID]; poll != nil {
+			poll.Voted = true
+		}
+	}
+
+	return result, nil
+}
+
+// VotePoll implements [domain.PostRepository].
+func (p *PostRepository) VotePoll(ctx context.Context, postID int, userID int, optionIDs []int) error {
+	return pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		var endsAt time.Time
+		var closedAt *time.Time
+
+		// FOR SHARE keeps ClosePoll from closing the poll under this vote
+		err := tx.QueryRow(
+			ctx,
+			`SELECT p.ends_at, p.closed_at
+			FROM polls p
+			JOIN posts ON posts.id = p.post_id
+			WHERE p.post_id = $1 AND posts.deleted_at IS NULL
+			FOR SHARE OF p`,
+			postID,
+		).Scan(&endsAt, &closedAt)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return constants.ErrPollNotFound
+			}
+			return err
+		}
+
+		poll := domain.Poll{EndsAt: endsAt, ClosedAt: closedAt}
+		if poll.Closed(time.Now()) {
+			return constants.ErrPollClosed
+		}
+
+		tag, err := tx.Exec(
+			ctx,
+			`INSERT INTO poll_voters (post_id, user_id) VALUES ($1, $2)
+			ON CONFLICT (post_id, user_id) DO NOTHING`,
+			postID,
+			userID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return constants.ErrAlreadyVoted
+		}
+
+		tag, err = tx.Exec(
+			ctx,
+			`INSERT INTO poll_votes (option_id, post_id, user_id)
+			SELECT id, post_id, $3
+			FROM poll_options
+			WHERE post_id = $1 AND id = ANY($2)`,
+			postID,
+			optionIDs,
+			userID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if int(tag.RowsAffected()) != len(optionIDs) {
+			return constants.ErrInvalidVote
+		}
+
+		return nil
+	})
+}
+
+// ClosePoll implements [domain.PostRepository].
+func (p *PostRepository) ClosePoll(ctx context.Context, postID int) error {
+	var exists bool
+
+	// the UPDATE is a no-op for a closed poll, so a retried close still
+	// finds the poll and succeeds
+	err := p.db.QueryRow(
+		ctx,
+		`WITH closed AS (
+			UPDATE polls
+			SET closed_at = NOW()
+			FROM posts
+			WHERE polls.post_id = $1
+			AND posts.id = polls.post_id
+			AND posts.deleted_at IS NULL
+			AND polls.closed_at IS NULL
+		)
+		SELECT EXISTS (
+			SELECT 1 FROM polls
+			JOIN posts ON posts.id = polls.post_id
+			WHERE polls.post_id = $1 AND posts.deleted_at IS NULL
+		)`,
+		postID,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return constants.ErrPollNotFound
+	}
+
+	return nil
+}
+
+// GetPollVoters implements [domain.PostRepository].
+func (p *PostRepository) GetPollVoters(ctx context.Context, postID int, cursorID int, limit int) ([]int, error) {
+	var voterIDs []int
+
+	err := pgxscan.Select(
+		ctx,
+		p.db,
+		&voterIDs,
+		`SELECT user_id FROM poll_voters
+		WHERE post_id = $1 AND user_id > $2
+		ORDER BY user_id
+		LIMIT $3`,
+		postID,
+		cursorID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return voterIDs, nil
+}
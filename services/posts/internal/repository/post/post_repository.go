@@ -15,3 +15,76 @@ func NewPostRepository(db *pgxpool.Pool) domain.PostRepository {
 		db: db,
 	}
 }
+
+// postColumns is the select list shared by every post read, posts must be
+// aliased as p.
+const postColumns = `
+			p.id,
+			p.content,
+			p.user_id,
+			COALESCE(p.post_images, '[]'::jsonb) AS post_images,
+			p.entities,
+			p.created_at,
+			p.updated_at,
+			p.reply_to_post_id,
+			p.root_post_id,
+			p.quote_post_id,
+			p.edit_count,
+			p.visibility,
+			p.content_warning,
+			p.likes_count,
+			(SELECT COUNT(*) FROM posts r WHERE r.reply_to_post_id = p.id
+			AND r.deleted_at IS NULL
+			) AS replies_count,
+			(SELECT COUNT(*) FROM post_reposts rp WHERE rp.post_id = p.id
+			AND rp.deleted_at IS NULL
+			) AS reposts_count,
+			(SELECT COUNT(*) FROM posts q WHERE q.quote_post_id = p.id
+			AND q.deleted_at IS NULL
+			) AS quotes_count,
+			(SELECT COALESCE(jsonb_agg(jsonb_build_object(
+				'user_id', m.mentioned_user_id,
+				'start', m.byte_start,
+				'end', m.byte_end
+			) ORDER BY m.byte_start), '[]'::jsonb)
+			FROM post_mentions m WHERE m.post_id = p.id
+			) AS mentions`
+
+// repostEntries builds the feed_entries CTE for feeds that mix posts with
+// reposts. userFilter is applied to both posts and reposts through a user_id
+// column. A post that is both posted and reposted, or reposted by several
+// users, shows up once at its latest activity.
+func repostEntries(userFilter string) string {
+	return `
+		WITH entries AS (
+			SELECT id AS post_id, created_at AS feed_at, NULL::int AS reposted_by
+			FROM posts
+			WHERE ` + userFilter + ` AND deleted_at IS NULL
+			UNION ALL
+			SELECT post_id, created_at, user_id
+			FROM post_reposts
+			WHERE ` + userFilter + ` AND deleted_at IS NULL
+		), feed_entries AS (
+			SELECT DISTINCT ON (post_id) post_id, feed_at, reposted_by
+			FROM entries
+			ORDER BY post_id, feed_at DESC
+		)`
+}
+
+// visibleTo filters posts aliased as p down to the ones the viewer in
+// parameter viewer may see, followed is an int array parameter of authors the
+// viewer is known to follow.
+func visibleTo(viewer, followed string) string {
+	return `(p.visibility IN ('public', 'unlisted')
+			OR p.user_id = ` + viewer + `
+			OR (p.visibility = 'followers' AND p.user_id = ANY(` + followed + `))
+			OR EXISTS (
+				SELECT 1 FROM post_mentions vm
+				WHERE vm.post_id = p.id AND vm.mentioned_user_id = ` + viewer + `
+			))`
+}
+
+// repostColumns goes after postColumns when selecting from feed_entries e.
+const repostColumns = `,
+			e.reposted_by,
+			CASE WHEN e.reposted_by IS NOT NULL THEN e.feed_at END AS reposted_at`
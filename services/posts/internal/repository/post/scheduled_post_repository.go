@@ -0,0 +1,183 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"voidspace/posts/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+const scheduledPostColumns = `
+			id,
+			user_id,
+			content,
+			COALESCE(post_images, '[]'::jsonb) AS post_images,
+			reply_to_post_id,
+			quote_post_id,
+			COALESCE(mentions, '[]'::jsonb) AS mentions,
+			visibility,
+			content_warning,
+			publish_at,
+			created_at,
+			updated_at`
+
+// CreateScheduled implements [domain.PostRepository].
+func (p *PostRepository) CreateScheduled(ctx context.Context, post *domain.ScheduledPost) (*domain.ScheduledPost, error) {
+	imagesJSON, err := json.Marshal(post.PostImages)
+	if err != nil {
+		return nil, err
+	}
+
+	mentionsJSON, err := json.Marshal(post.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduled domain.ScheduledPost
+
+	query := `
+		INSERT INTO scheduled_posts (user_id, content, post_images, reply_to_post_id, quote_post_id, mentions, visibility, publish_at, content_warning)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + scheduledPostColumns
+
+	err = pgxscan.Get(
+		ctx,
+		p.db,
+		&scheduled,
+		query,
+		post.UserID,
+		post.Content,
+		imagesJSON,
+		post.ReplyToPostID,
+		post.QuotePostID,
+		mentionsJSON,
+		post.Visibility,
+		post.PublishAt,
+		post.ContentWarning,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduled, nil
+}
+
+// GetScheduledByID implements [domain.PostRepository].
+func (p *PostRepository) GetScheduledByID(ctx context.Context, scheduledPostID int) (*domain.ScheduledPost, error) {
+	var scheduled domain.ScheduledPost
+
+	query := `
+		SELECT ` + scheduledPostColumns + `
+		FROM scheduled_posts
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	err := pgxscan.Get(ctx, p.db, &scheduled, query, scheduledPostID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrScheduledPostNotFound
+		}
+		return nil, err
+	}
+
+	return &scheduled, nil
+}
+
+// GetScheduledByUserID implements [domain.PostRepository].
+func (p *PostRepository) GetScheduledByUserID(ctx context.Context, userID int) ([]domain.ScheduledPost, error) {
+	var scheduled []domain.ScheduledPost
+
+	query := `
+		SELECT ` + scheduledPostColumns + `
+		FROM scheduled_posts
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY publish_at ASC, id ASC
+	`
+
+	err := pgxscan.Select(ctx, p.db, &scheduled, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// UpdateScheduled implements [domain.PostRepository].
+func (p *PostRepository) UpdateScheduled(ctx context.Context, post *domain.ScheduledPost) (*domain.ScheduledPost, error) {
+	imagesJSON, err := json.Marshal(post.PostImages)
+	if err != nil {
+		return nil, err
+	}
+
+	mentionsJSON, err := json.Marshal(post.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduled domain.ScheduledPost
+
+	query := `
+		UPDATE scheduled_posts
+		SET content = $1, post_images = $2, mentions = $3, visibility = $4, publish_at = $5, updated_at = NOW()
+		WHERE id = $6 AND deleted_at IS NULL
+		RETURNING ` + scheduledPostColumns
+
+	err = pgxscan.Get(ctx, p.db, &scheduled, query, post.Content, imagesJSON, mentionsJSON, post.Visibility, post.PublishAt, post.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrScheduledPostNotFound
+		}
+		return nil, err
+	}
+
+	return &scheduled, nil
+}
+
+// DeleteScheduled implements [domain.PostRepository].
+func (p *PostRepository) DeleteScheduled(ctx context.Context, scheduledPostID int) error {
+	cmdTag, err := p.db.Exec(
+		ctx,
+		`DELETE FROM scheduled_posts WHERE id = $1 AND deleted_at IS NULL`,
+		scheduledPostID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return constants.ErrScheduledPostNotFound
+	}
+
+	return nil
+}
+
+// PublishScheduled implements [domain.PostRepository]. Deleting the scheduled
+// row first makes a second publish of the same id fail instead of posting
+// twice.
+func (p *PostRepository) PublishScheduled(ctx context.Context, scheduledPostID int, post *domain.Post) (*domain.Post, error) {
+	err := pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		cmdTag, err := tx.Exec(
+			ctx,
+			`DELETE FROM scheduled_posts WHERE id = $1 AND deleted_at IS NULL`,
+			scheduledPostID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if cmdTag.RowsAffected() == 0 {
+			return constants.ErrScheduledPostNotFound
+		}
+
+		return insertPost(ctx, tx, post)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
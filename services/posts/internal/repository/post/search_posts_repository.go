@@ -14,19 +14,11 @@ func (p *PostRepository) SearchPosts(
 	var posts []domain.Post
 
 	sqlQuery := `
-		SELECT 
-			p.id,
-			p.content,
-			p.user_id,
-			COALESCE(p.post_images, '[]'::jsonb) AS post_images,
-			p.created_at,
-			p.updated_at,
-			(SELECT COUNT(*) FROM post_likes WHERE post_id = p.id
-			AND deleted_at IS NULL
-			) AS likes_count
+		SELECT ` + postColumns + `
 		FROM posts p
 		WHERE p.content ILIKE '%' || $1 || '%'
 		AND p.deleted_at IS NULL
+		AND p.visibility = 'public'
 		ORDER BY p.created_at DESC
 		LIMIT 20
 	`
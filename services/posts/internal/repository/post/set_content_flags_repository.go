@@ -0,0 +1,45 @@
+package post
+
+import (
+	"context"
+	"encoding/json"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// SetContentFlags implements [domain.PostRepository]. It leaves edit_count
+// and updated_at alone, a moderator flag is not an edit by the author.
+func (p *PostRepository) SetContentFlags(
+	ctx context.Context,
+	postID int,
+	contentWarning *string,
+	images []domain.PostImage,
+) error {
+	if len(images) == 0 {
+		images = nil
+	}
+
+	imagesJSON, err := json.Marshal(images)
+	if err != nil {
+		return err
+	}
+
+	cmdTag, err := p.db.Exec(
+		ctx,
+		`UPDATE posts SET content_warning = $1, post_images = $2
+		WHERE id = $3 AND deleted_at IS NULL`,
+		contentWarning,
+		imagesJSON,
+		postID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return constants.ErrPostNotFound
+	}
+
+	return nil
+}
@@ -3,13 +3,22 @@ package post
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
 	"voidspace/posts/internal/domain"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/vhysxl/voidspace/shared/utils/constants"
 )
 
 // Update implements [domain.PostRepository].
-func (p *PostRepository) Update(ctx context.Context, post *domain.Post) error {
+func (p *PostRepository) Update(
+	ctx context.Context,
+	post *domain.Post,
+	maxEdits int,
+	editWindow time.Duration,
+) error {
 	if len(post.PostImages) == 0 {
 		post.PostImages = nil
 	}
@@ -19,21 +28,81 @@ func (p *PostRepository) Update(ctx context.Context, post *domain.Post) error {
 		return err
 	}
 
-	cmdTag, err := p.db.Exec(
+	entitiesJSON, err := json.Marshal(post.Entities)
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		// a concurrent edit waits here, otherwise both would save the same
+		// version to history and the first edit would never reach it
+		_, err := tx.Exec(ctx, `SELECT 1 FROM posts WHERE id = $1 FOR UPDATE`, post.ID)
+		if err != nil {
+			return err
+		}
+
+		// keep the version being replaced before overwriting it
+		_, err = tx.Exec(
+			ctx,
+			`INSERT INTO post_revisions (post_id, content, post_images, entities, created_at)
+			SELECT id, content, post_images, entities, COALESCE(updated_at, created_at)
+			FROM posts
+			WHERE id = $1 AND deleted_at IS NULL`,
+			post.ID,
+		)
+		if err != nil {
+			return err
+		}
+
+		cmdTag, err := tx.Exec(
+			ctx,
+			`UPDATE posts SET content = $1, post_images = $2, entities = $3, edit_count = edit_count + 1, updated_at = NOW()
+    		WHERE id = $4 AND deleted_at IS NULL
+			AND edit_count < $5 AND created_at > NOW() - make_interval(secs => $6)`,
+			post.Content,
+			jsonImages,
+			entitiesJSON,
+			post.ID,
+			maxEdits,
+			editWindow.Seconds(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if cmdTag.RowsAffected() == 0 {
+			return editRejection(ctx, tx, post.ID, maxEdits)
+		}
+
+		err = replaceHashtags(ctx, tx, post.ID, post.Hashtags)
+		if err != nil {
+			return err
+		}
+
+		return replaceMentions(ctx, tx, post.ID, post.Mentions)
+	})
+}
+
+// editRejection tells why an update guarded by the edit limit and window
+// matched no row.
+func editRejection(ctx context.Context, tx pgx.Tx, postID int, maxEdits int) error {
+	var editCount int
+
+	err := tx.QueryRow(
 		ctx,
-		`UPDATE posts SET content = $1, post_images = $2, updated_at = NOW()
-    	WHERE id = $3 AND deleted_at IS NULL`,
-		post.Content,
-		jsonImages,
-		post.ID,
-	)
+		`SELECT edit_count FROM posts WHERE id = $1 AND deleted_at IS NULL`,
+		postID,
+	).Scan(&editCount)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return constants.ErrPostNotFound
+		}
 		return err
 	}
 
-	if cmdTag.RowsAffected() == 0 {
-		return constants.ErrPostNotFound
+	if editCount >= maxEdits {
+		return constants.ErrEditLimitReached
 	}
 
-	return nil
+	return constants.ErrEditWindowClosed
 }
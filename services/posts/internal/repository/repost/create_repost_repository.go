@@ -0,0 +1,30 @@
+package repost
+
+import (
+	"context"
+	"errors"
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// Repost implements [domain.RepostRepository].
+func (r *RepostRepository) Repost(ctx context.Context, repost *domain.Repost) error {
+	// reposting again is a no-op, it does not bump the post back up in feeds
+	query := `
+		INSERT INTO post_reposts (user_id, post_id)
+		VALUES ($1, $2)
+		ON CONFLICT (post_id, user_id) DO NOTHING
+	`
+	_, err := r.db.Exec(ctx, query, repost.UserID, repost.PostID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return constants.ErrPostNotFound
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package repost
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// UndoRepost implements [domain.RepostRepository]. The repost leaves the home
+// timelines it was fanned out to along with it.
+func (r *RepostRepository) UndoRepost(ctx context.Context, repost *domain.Repost) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		query := `
+			DELETE FROM post_reposts
+			WHERE post_id = $1
+			AND user_id = $2
+		`
+		_, err := tx.Exec(ctx, query, repost.PostID, repost.UserID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			`DELETE FROM home_timelines WHERE post_id = $1 AND source_id = $2 AND repost`,
+			repost.PostID,
+			repost.UserID,
+		)
+		return err
+	})
+}
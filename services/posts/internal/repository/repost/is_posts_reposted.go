@@ -0,0 +1,35 @@
+package repost
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// IsPostsRepostedByUser implements [domain.RepostRepository].
+func (r *RepostRepository) IsPostsRepostedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error) {
+	if len(postIDs) == 0 {
+		return map[int]bool{}, nil
+	}
+
+	query := `
+		SELECT post_id
+		FROM post_reposts
+		WHERE user_id = $1
+		AND post_id = ANY($2)
+		AND deleted_at IS NULL
+	`
+
+	var repostedPostIDs []int
+	err := pgxscan.Select(ctx, r.db, &repostedPostIDs, query, userID, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]bool, len(postIDs))
+	for _, postID := range repostedPostIDs {
+		result[postID] = true
+	}
+
+	return result, nil
+}
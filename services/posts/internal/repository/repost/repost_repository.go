@@ -0,0 +1,17 @@
+package repost
+
+import (
+	"voidspace/posts/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RepostRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepostRepository(db *pgxpool.Pool) domain.RepostRepository {
+	return &RepostRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,45 @@
+package bookmark
+
+import (
+	"context"
+	"strings"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+const maxFolderLength = 32
+
+// AddBookmark implements [domain.BookmarkUsecase]. A post the user may not
+// see is not found, as it is on every read path.
+func (b *bookmarkUsecase) AddBookmark(ctx context.Context, bookmark *domain.Bookmark) error {
+	if bookmark.Folder != nil {
+		folder := strings.TrimSpace(*bookmark.Folder)
+		if folder == "" || helper.GraphemeCount(folder) > maxFolderLength {
+			return constants.ErrInvalidBookmarkFolder
+		}
+		bookmark.Folder = &folder
+	}
+
+	// the foreign key still accepts soft deleted posts
+	post, err := b.postRepository.GetByID(ctx, bookmark.PostID)
+	if err != nil {
+		return err
+	}
+
+	followsAuthor := false
+	if post.Visibility == domain.VisibilityFollowers && !post.Involves(bookmark.UserID) {
+		followed, err := b.followRepository.FilterFollowing(ctx, bookmark.UserID, []int{post.UserID})
+		if err != nil {
+			return err
+		}
+		followsAuthor = followed[post.UserID]
+	}
+
+	if !post.VisibleTo(&bookmark.UserID, followsAuthor) {
+		return constants.ErrPostNotFound
+	}
+
+	return b.bookmarkRepository.AddBookmark(ctx, bookmark)
+}
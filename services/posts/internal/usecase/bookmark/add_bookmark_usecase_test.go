@@ -0,0 +1,69 @@
+package bookmark
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type fakePostRepository struct {
+	domain.PostRepository
+	post *domain.Post
+}
+
+func (f *fakePostRepository) GetByID(ctx context.Context, postID int) (*domain.Post, error) {
+	return f.post, nil
+}
+
+type fakeBookmarkRepository struct {
+	domain.BookmarkRepository
+	added bool
+}
+
+func (f *fakeBookmarkRepository) AddBookmark(ctx context.Context, bookmark *domain.Bookmark) error {
+	f.added = true
+	return nil
+}
+
+type fakeFollowRepository struct {
+	follows bool
+}
+
+func (f *fakeFollowRepository) FilterFollowing(ctx context.Context, userID int, targetUserIDs []int) (map[int]bool, error) {
+	return map[int]bool{targetUserIDs[0]: f.follows}, nil
+}
+
+func TestAddBookmarkVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		mentions   []domain.Mention
+		follows    bool
+		wantErr    error
+	}{
+		{"public", domain.VisibilityPublic, nil, false, nil},
+		{"followers only, following", domain.VisibilityFollowers, nil, true, nil},
+		{"followers only, not following", domain.VisibilityFollowers, nil, false, constants.ErrPostNotFound},
+		{"mentioned only, mentioned", domain.VisibilityMentioned, []domain.Mention{{UserID: 2}}, false, nil},
+		{"mentioned only, not mentioned", domain.VisibilityMentioned, nil, true, constants.ErrPostNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookmarks := &fakeBookmarkRepository{}
+			posts := &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: tt.visibility, Mentions: tt.mentions}}
+			usecase := NewBookmarkUsecase(bookmarks, posts, &fakeFollowRepository{follows: tt.follows}, 0)
+
+			err := usecase.AddBookmark(context.Background(), &domain.Bookmark{PostID: 10, UserID: 2})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddBookmark = %v, want %v", err, tt.wantErr)
+			}
+			if bookmarks.added != (tt.wantErr == nil) {
+				t.Fatalf("added = %v", bookmarks.added)
+			}
+		})
+	}
+}
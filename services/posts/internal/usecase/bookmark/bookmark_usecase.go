@@ -0,0 +1,27 @@
+package bookmark
+
+import (
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+type bookmarkUsecase struct {
+	bookmarkRepository domain.BookmarkRepository
+	postRepository     domain.PostRepository
+	followRepository   domain.FollowRepository
+	contextTimeout     time.Duration
+}
+
+func NewBookmarkUsecase(
+	bookmarkRepository domain.BookmarkRepository,
+	postRepository domain.PostRepository,
+	followRepository domain.FollowRepository,
+	contextTimeout time.Duration,
+) domain.BookmarkUsecase {
+	return &bookmarkUsecase{
+		bookmarkRepository: bookmarkRepository,
+		postRepository:     postRepository,
+		followRepository:   followRepository,
+		contextTimeout:     contextTimeout,
+	}
+}
@@ -0,0 +1,11 @@
+package bookmark
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// GetBookmarkFolders implements [domain.BookmarkUsecase].
+func (b *bookmarkUsecase) GetBookmarkFolders(ctx context.Context, userID int) ([]domain.BookmarkFolder, error) {
+	return b.bookmarkRepository.GetFolders(ctx, userID)
+}
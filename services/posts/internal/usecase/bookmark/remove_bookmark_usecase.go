@@ -0,0 +1,16 @@
+package bookmark
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// RemoveBookmark implements [domain.BookmarkUsecase].
+func (b *bookmarkUsecase) RemoveBookmark(ctx context.Context, bookmark *domain.Bookmark) error {
+	err := b.bookmarkRepository.RemoveBookmark(ctx, bookmark)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
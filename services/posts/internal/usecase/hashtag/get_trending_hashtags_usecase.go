@@ -0,0 +1,20 @@
+package hashtag
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// GetTrendingHashtags implements [domain.HashtagUsecase].
+func (h *hashtagUsecase) GetTrendingHashtags(ctx context.Context, limit int) ([]domain.TrendingHashtag, error) {
+	if limit <= 0 || limit > trendingMaxTags {
+		limit = 10
+	}
+
+	hashtags, err := h.hashtagRepository.GetTrending(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashtags, nil
+}
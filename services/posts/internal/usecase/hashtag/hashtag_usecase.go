@@ -0,0 +1,30 @@
+package hashtag
+
+import (
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+const (
+	trendingBaseline = 24 * time.Hour
+	trendingMinUses  = 3
+	trendingMaxTags  = 50
+)
+
+type hashtagUsecase struct {
+	hashtagRepository domain.HashtagRepository
+	trendingWindow    time.Duration
+	contextTimeout    time.Duration
+}
+
+func NewHashtagUsecase(
+	hashtagRepository domain.HashtagRepository,
+	trendingWindow time.Duration,
+	contextTimeout time.Duration,
+) domain.HashtagUsecase {
+	return &hashtagUsecase{
+		hashtagRepository: hashtagRepository,
+		trendingWindow:    trendingWindow,
+		contextTimeout:    contextTimeout,
+	}
+}
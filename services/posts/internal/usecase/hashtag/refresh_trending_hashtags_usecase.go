@@ -0,0 +1,14 @@
+package hashtag
+
+import "context"
+
+// RefreshTrendingHashtags implements [domain.HashtagUsecase].
+func (h *hashtagUsecase) RefreshTrendingHashtags(ctx context.Context) error {
+	return h.hashtagRepository.RefreshTrending(
+		ctx,
+		h.trendingWindow,
+		trendingBaseline,
+		trendingMinUses,
+		trendingMaxTags,
+	)
+}
@@ -7,11 +7,7 @@ import (
 
 // LikePost implements [domain.LikeUsecase].
 func (l *likeUsecase) LikePost(ctx context.Context, like *domain.Like) error {
-	err := l.likeRepository.LikePost(ctx, like)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	like.Reaction = domain.DefaultReaction
 
+	return l.ReactToPost(ctx, like)
 }
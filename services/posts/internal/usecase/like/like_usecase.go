@@ -1,21 +1,48 @@
 package like
 
 import (
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 	"voidspace/posts/internal/domain"
 )
 
 type likeUsecase struct {
-	likeRepository domain.LikeRepository
-	contextTimeout time.Duration
+	likeRepository   domain.LikeRepository
+	postRepository   domain.PostRepository
+	followRepository domain.FollowRepository
+	reactions        []string
+	contextTimeout   time.Duration
 }
 
+// NewLikeUsecase takes the allowed reactions in display order, the default
+// reaction is put first when missing so likes always work. A reaction too
+// long for the reaction column is an error so it fails at startup and not on
+// every react.
 func NewLikeUsecase(
 	likeRepository domain.LikeRepository,
+	postRepository domain.PostRepository,
+	followRepository domain.FollowRepository,
+	reactions []string,
 	contextTimeout time.Duration,
-) domain.LikeUsecase {
-	return &likeUsecase{
-		likeRepository: likeRepository,
-		contextTimeout: contextTimeout,
+) (domain.LikeUsecase, error) {
+	set := []string{domain.DefaultReaction}
+	for _, reaction := range reactions {
+		reaction = strings.TrimSpace(reaction)
+		if utf8.RuneCountInString(reaction) > domain.MaxReactionLength {
+			return nil, fmt.Errorf("reaction %q is longer than %d characters", reaction, domain.MaxReactionLength)
+		}
+		if reaction != "" && reaction != domain.DefaultReaction {
+			set = append(set, reaction)
+		}
 	}
+
+	return &likeUsecase{
+		likeRepository:   likeRepository,
+		postRepository:   postRepository,
+		followRepository: followRepository,
+		reactions:        set,
+		contextTimeout:   contextTimeout,
+	}, nil
 }
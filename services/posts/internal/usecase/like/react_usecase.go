@@ -0,0 +1,47 @@
+package like
+
+import (
+	"context"
+	"slices"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// ReactToPost implements [domain.LikeUsecase]. A post the user may not see
+// is not found, as it is on every read path.
+func (l *likeUsecase) ReactToPost(ctx context.Context, like *domain.Like) error {
+	if !slices.Contains(l.reactions, like.Reaction) {
+		return constants.ErrInvalidReaction
+	}
+
+	post, err := l.postRepository.GetByID(ctx, like.PostID)
+	if err != nil {
+		return err
+	}
+
+	followsAuthor := false
+	if post.Visibility == domain.VisibilityFollowers && !post.Involves(like.UserID) {
+		followed, err := l.followRepository.FilterFollowing(ctx, like.UserID, []int{post.UserID})
+		if err != nil {
+			return err
+		}
+		followsAuthor = followed[post.UserID]
+	}
+
+	if !post.VisibleTo(&like.UserID, followsAuthor) {
+		return constants.ErrPostNotFound
+	}
+
+	return l.likeRepository.React(ctx, like)
+}
+
+// RemoveReaction implements [domain.LikeUsecase].
+func (l *likeUsecase) RemoveReaction(ctx context.Context, like *domain.Like) error {
+	return l.likeRepository.RemoveReaction(ctx, like)
+}
+
+// ListReactions implements [domain.LikeUsecase].
+func (l *likeUsecase) ListReactions() []string {
+	return l.reactions
+}
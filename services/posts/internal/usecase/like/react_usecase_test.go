@@ -0,0 +1,84 @@
+package like
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type fakePostRepository struct {
+	domain.PostRepository
+	post *domain.Post
+}
+
+func (f *fakePostRepository) GetByID(ctx context.Context, postID int) (*domain.Post, error) {
+	return f.post, nil
+}
+
+type fakeLikeRepository struct {
+	domain.LikeRepository
+	reacted bool
+}
+
+func (f *fakeLikeRepository) React(ctx context.Context, like *domain.Like) error {
+	f.reacted = true
+	return nil
+}
+
+type fakeFollowRepository struct {
+	follows bool
+}
+
+func (f *fakeFollowRepository) FilterFollowing(ctx context.Context, userID int, targetUserIDs []int) (map[int]bool, error) {
+	return map[int]bool{targetUserIDs[0]: f.follows}, nil
+}
+
+func TestReactToPostVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		follows    bool
+		wantErr    error
+	}{
+		{"public", domain.VisibilityPublic, false, nil},
+		{"followers only, following", domain.VisibilityFollowers, true, nil},
+		{"followers only, not following", domain.VisibilityFollowers, false, constants.ErrPostNotFound},
+		{"mentioned only, not mentioned", domain.VisibilityMentioned, true, constants.ErrPostNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			likes := &fakeLikeRepository{}
+			posts := &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: tt.visibility}}
+
+			usecase, err := NewLikeUsecase(likes, posts, &fakeFollowRepository{follows: tt.follows}, nil, 0)
+			if err != nil {
+				t.Fatalf("NewLikeUsecase: %v", err)
+			}
+
+			err = usecase.LikePost(context.Background(), &domain.Like{PostID: 10, UserID: 2})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LikePost = %v, want %v", err, tt.wantErr)
+			}
+			if likes.reacted != (tt.wantErr == nil) {
+				t.Fatalf("reacted = %v", likes.reacted)
+			}
+		})
+	}
+}
+
+func TestNewLikeUsecaseRejectsLongReactions(t *testing.T) {
+	_, err := NewLikeUsecase(nil, nil, nil, []string{"love", strings.Repeat("é", domain.MaxReactionLength)}, 0)
+	if err != nil {
+		t.Fatalf("reaction at the limit: %v", err)
+	}
+
+	_, err = NewLikeUsecase(nil, nil, nil, []string{"love", strings.Repeat("a", domain.MaxReactionLength+1)}, 0)
+	if err == nil {
+		t.Fatal("expected a reaction over the limit to be rejected")
+	}
+}
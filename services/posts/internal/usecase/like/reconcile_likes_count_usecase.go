@@ -0,0 +1,12 @@
+package like
+
+import (
+	"context"
+)
+
+const reconcileBatchSize = 1000
+
+// ReconcileLikesCount implements [domain.LikeUsecase].
+func (l *likeUsecase) ReconcileLikesCount(ctx context.Context) (int, error) {
+	return l.likeRepository.ReconcileLikesCount(ctx, reconcileBatchSize)
+}
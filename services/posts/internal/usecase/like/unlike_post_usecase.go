@@ -5,13 +5,8 @@ import (
 	"voidspace/posts/internal/domain"
 )
 
-// UnlikePost implements [domain.LikeUsecase].
+// UnlikePost implements [domain.LikeUsecase]. Whichever reaction the user
+// had is removed.
 func (l *likeUsecase) UnlikePost(ctx context.Context, like *domain.Like) error {
-	err := l.likeRepository.UnlikePost(ctx, like)
-	if err != nil {
-		return err
-	}
-
-	return nil
-
+	return l.RemoveReaction(ctx, like)
 }
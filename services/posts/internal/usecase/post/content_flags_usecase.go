@@ -0,0 +1,51 @@
+package post
+
+import (
+	"context"
+	"slices"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// SetContentFlags implements [domain.PostUsecase]. The caller is trusted to
+// be a moderator, every flagged image must be on the post.
+func (p *postUsecase) SetContentFlags(
+	ctx context.Context,
+	postID int,
+	flags *domain.ContentFlags,
+) (*domain.Post, error) {
+	post, err := p.postRepository.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	warning := post.ContentWarning
+	if flags.ContentWarning != nil {
+		warning, err = normalizeContentWarning(flags.ContentWarning)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	images := slices.Clone(post.PostImages)
+	for order, sensitive := range flags.SensitiveImages {
+		i := slices.IndexFunc(images, func(image domain.PostImage) bool {
+			return image.Order == order
+		})
+		if i < 0 {
+			return nil, constants.ErrInvalidData
+		}
+		images[i].Sensitive = sensitive
+	}
+
+	err = p.postRepository.SetContentFlags(ctx, postID, warning, images)
+	if err != nil {
+		return nil, err
+	}
+
+	post.ContentWarning = warning
+	post.PostImages = images
+
+	return post, nil
+}
@@ -2,7 +2,11 @@ package post
 
 import (
 	"context"
+	"time"
 	"voidspace/posts/internal/domain"
+	"voidspace/posts/utils"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
 )
 
 // CreatePost implements [domain.PostUsecase].
@@ -10,10 +14,82 @@ func (p *postUsecase) CreatePost(
 	ctx context.Context,
 	post *domain.Post,
 ) (*domain.Post, error) {
-	post, err := p.postRepository.Create(ctx, post)
+	err := p.preparePost(ctx, post)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err = p.postRepository.Create(ctx, post)
 	if err != nil {
 		return nil, err
 	}
 
 	return post, nil
 }
+
+// preparePost validates a post about to be written and fills the fields
+// derived from it: the thread root, hashtags and entities.
+func (p *postUsecase) preparePost(ctx context.Context, post *domain.Post) error {
+	err := validateContent(post)
+	if err != nil {
+		return err
+	}
+
+	err = validateVisibility(&post.Visibility)
+	if err != nil {
+		return err
+	}
+
+	post.ContentWarning, err = normalizeContentWarning(post.ContentWarning)
+	if err != nil {
+		return err
+	}
+
+	if post.Poll != nil {
+		err = validatePoll(post.Poll, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	if post.ReplyToPostID != nil {
+		parent, err := p.postRepository.GetByID(ctx, *post.ReplyToPostID)
+		if err != nil {
+			return err
+		}
+
+		err = p.checkVisible(ctx, parent, &post.UserID)
+		if err != nil {
+			return err
+		}
+
+		// every reply points at the post that started the conversation
+		rootID := parent.ID
+		if parent.RootPostID != nil {
+			rootID = *parent.RootPostID
+		}
+		post.RootPostID = &rootID
+	}
+
+	if post.QuotePostID != nil {
+		quoted, err := p.postRepository.GetByID(ctx, *post.QuotePostID)
+		if err != nil {
+			return err
+		}
+
+		// a post the author may not see is not found, not unshareable
+		err = p.checkVisible(ctx, quoted, &post.UserID)
+		if err != nil {
+			return err
+		}
+
+		if !shareable(quoted) {
+			return constants.ErrPostNotShareable
+		}
+	}
+
+	post.Hashtags = utils.ExtractHashtags(post.Content)
+	post.Entities = utils.ExtractEntities(post.Content, post.Mentions)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// SaveDraft implements [domain.PostUsecase]. A draft with an ID replaces that
+// draft. Drafts may be incomplete, only the length and mentions are checked
+// until the draft is published.
+func (p *postUsecase) SaveDraft(
+	ctx context.Context,
+	draft *domain.Draft,
+) (*domain.Draft, error) {
+	err := validateContent(&domain.Post{Content: draft.Content, Mentions: draft.Mentions})
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateVisibility(&draft.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	draft.ContentWarning, err = normalizeContentWarning(draft.ContentWarning)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.ID == 0 {
+		return p.postRepository.CreateDraft(ctx, draft)
+	}
+
+	existing, err := p.postRepository.GetDraftByID(ctx, draft.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.UserID != draft.UserID {
+		return nil, constants.ErrUnauthorized
+	}
+
+	return p.postRepository.UpdateDraft(ctx, draft)
+}
+
+// GetDrafts implements [domain.PostUsecase].
+func (p *postUsecase) GetDrafts(
+	ctx context.Context,
+	userID int,
+) ([]domain.Draft, error) {
+	drafts, err := p.postRepository.GetDraftsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+// DeleteDraft implements [domain.PostUsecase].
+func (p *postUsecase) DeleteDraft(
+	ctx context.Context,
+	draftID int,
+	loggedInUserID int,
+) error {
+	existing, err := p.postRepository.GetDraftByID(ctx, draftID)
+	if err != nil {
+		return err
+	}
+
+	if existing.UserID != loggedInUserID {
+		return constants.ErrUnauthorized
+	}
+
+	return p.postRepository.DeleteDraft(ctx, draftID)
+}
+
+// PublishDraft implements [domain.PostUsecase]. The draft goes through the
+// CreatePost checks and is replaced by the post in one transaction.
+func (p *postUsecase) PublishDraft(
+	ctx context.Context,
+	draftID int,
+	loggedInUserID int,
+) (*domain.Post, error) {
+	draft, err := p.postRepository.GetDraftByID(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.UserID != loggedInUserID {
+		return nil, constants.ErrUnauthorized
+	}
+
+	if draft.Content == "" && len(draft.PostImages) == 0 {
+		return nil, constants.ErrInvalidData
+	}
+
+	post := &domain.Post{
+		Content:        draft.Content,
+		UserID:         draft.UserID,
+		PostImages:     draft.PostImages,
+		ReplyToPostID:  draft.ReplyToPostID,
+		Mentions:       draft.Mentions,
+		Visibility:     draft.Visibility,
+		ContentWarning: draft.ContentWarning,
+	}
+
+	err = p.preparePost(ctx, post)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.postRepository.PublishDraft(ctx, draftID, post)
+}
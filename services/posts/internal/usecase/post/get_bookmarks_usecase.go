@@ -0,0 +1,75 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+// GetBookmarks implements [domain.PostUsecase]. Bookmarks are private, userID
+// is always the viewer.
+func (p *postUsecase) GetBookmarks(
+	ctx context.Context,
+	userID int,
+	folder *string,
+	cursorTime *time.Time,
+	cursorID int,
+) ([]domain.Post, bool, error) {
+	var cursor time.Time
+
+	if cursorTime != nil {
+		cursor = *cursorTime
+	} else {
+		cursor = time.Now()
+	}
+
+	var posts []domain.Post
+	var hasMore bool
+
+	for {
+		page, more, err := p.postRepository.GetBookmarked(ctx, userID, folder, cursor, cursorID)
+		if err != nil {
+			return []domain.Post{}, false, err
+		}
+
+		// a bookmarked followers only post hides once its author is unfollowed
+		posts, err = p.filterVisible(ctx, page, &userID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		hasMore = more
+		if len(posts) > 0 || !hasMore {
+			break
+		}
+
+		last := page[len(page)-1]
+		cursor, cursorID = *last.BookmarkedAt, last.ID
+	}
+
+	if len(posts) == 0 {
+		return []domain.Post{}, false, nil
+	}
+
+	err := p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, &userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.applyViewerState(ctx, posts, &userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return posts, hasMore, nil
+}
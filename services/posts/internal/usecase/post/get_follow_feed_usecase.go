@@ -22,7 +22,19 @@ func (p *postUsecase) GetFollowingFeed(
 		cursor = time.Now()
 	}
 
-	posts, hasMore, err := p.postRepository.GetFollowingFeed(ctx, userIDs, cursor, cursorID)
+	// userIDs come from the caller, followers only posts are shown for the
+	// follows the users service confirms
+	followed, err := p.followRepository.FilterFollowing(ctx, loggedInUserID, userIDs)
+	if err != nil {
+		return []domain.Post{}, false, err
+	}
+
+	followedIDs := make([]int, 0, len(followed))
+	for userID := range followed {
+		followedIDs = append(followedIDs, userID)
+	}
+
+	posts, hasMore, err := p.postRepository.GetFollowingFeed(ctx, loggedInUserID, followedIDs, cursor, cursorID)
 	if err != nil {
 		return []domain.Post{}, false, err
 	}
@@ -31,18 +43,24 @@ func (p *postUsecase) GetFollowingFeed(
 		return []domain.Post{}, false, nil
 	}
 
-	postIDs := make([]int, 0, len(posts))
-	for _, post := range posts {
-		postIDs = append(postIDs, post.ID)
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, false, err
 	}
 
-	likedMap, err := p.likeRepository.IsPostsLikedByUser(ctx, loggedInUserID, postIDs)
+	err = p.attachLinkPreviews(ctx, posts)
 	if err != nil {
 		return nil, false, err
 	}
 
-	for i := range posts {
-		posts[i].IsLiked = likedMap[posts[i].ID]
+	err = p.applyViewerState(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, false, err
 	}
 
 	return posts, hasMore, nil
@@ -0,0 +1,190 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+const (
+	forYouPageSize       = 10
+	forYouCandidateLimit = 500
+	// forYouReservedCandidates is how many candidates by followed and by
+	// often interacted with authors are taken on top of the fastest liked,
+	// so their new posts are ranked before they have likes
+	forYouReservedCandidates = 100
+	// forYouSnapshotTTL is how long a ranking can be paged through before
+	// the client has to reload the feed
+	forYouSnapshotTTL = 30 * time.Minute
+)
+
+// GetForYouFeed implements [domain.PostUsecase]. Pages are read from the
+// snapshot, posts deleted or hidden since it was ranked are left out so a
+// page can come back short.
+func (p *postUsecase) GetForYouFeed(
+	ctx context.Context,
+	snapshotID *int,
+	offset int,
+	loggedInUserID int,
+) (*domain.ForYouPage, error) {
+	if offset < 0 {
+		return nil, constants.ErrInvalidData
+	}
+
+	page := &domain.ForYouPage{Posts: []domain.Post{}}
+
+	var postIDs []int
+	if snapshotID == nil {
+		ranked, err := p.rankForYou(ctx, loggedInUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ranked) == 0 {
+			return page, nil
+		}
+
+		page.SnapshotID, err = p.postRepository.CreateFeedSnapshot(ctx, loggedInUserID, ranked, forYouSnapshotTTL)
+		if err != nil {
+			return nil, err
+		}
+		postIDs = ranked
+		offset = 0
+	} else {
+		var err error
+		postIDs, err = p.postRepository.GetFeedSnapshot(ctx, *snapshotID, loggedInUserID, forYouSnapshotTTL)
+		if err != nil {
+			return nil, err
+		}
+		page.SnapshotID = *snapshotID
+	}
+
+	end := min(offset+forYouPageSize, len(postIDs))
+	page.NextOffset = end
+	page.HasMore = end < len(postIDs)
+
+	if offset >= end {
+		return page, nil
+	}
+
+	posts, err := p.postRepository.GetByIDs(ctx, postIDs[offset:end])
+	if err != nil {
+		return nil, err
+	}
+
+	posts = inSnapshotOrder(posts, postIDs[offset:end])
+
+	posts, err = p.filterVisible(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(posts) == 0 {
+		return page, nil
+	}
+
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachPolls(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.applyViewerState(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Posts = posts
+
+	return page, nil
+}
+
+// rankForYou scores the candidates for loggedInUserID and returns their ids
+// in feed order. Follows are only looked up for the candidates' authors.
+func (p *postUsecase) rankForYou(ctx context.Context, loggedInUserID int) ([]int, error) {
+	now := time.Now()
+
+	candidates, err := p.postRepository.GetRankCandidates(ctx, loggedInUserID, now.Add(-p.rankingConfig.Window), forYouCandidateLimit, forYouReservedCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	authorIDs := make([]int, 0, len(candidates))
+	seen := make(map[int]bool, len(candidates))
+	for i := range candidates {
+		if !seen[candidates[i].UserID] {
+			seen[candidates[i].UserID] = true
+			authorIDs = append(authorIDs, candidates[i].UserID)
+		}
+	}
+
+	followed, err := p.followRepository.FilterFollowing(ctx, loggedInUserID, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := candidates[:0]
+	for _, candidate := range candidates {
+		candidate.Followed = followed[candidate.UserID]
+		if candidate.FollowersOnly && !candidate.Followed {
+			continue
+		}
+		visible = append(visible, candidate)
+	}
+	candidates = visible
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	candidateIDs := make([]int, len(candidates))
+	for i := range candidates {
+		candidateIDs[i] = candidates[i].PostID
+	}
+
+	commentCounts, err := p.commentRepository.CountComments(ctx, candidateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range candidates {
+		candidates[i].CommentsCount = commentCounts[candidates[i].PostID]
+	}
+
+	ranked := rankCandidates(candidates, p.rankingConfig, now)
+
+	postIDs := make([]int, len(ranked))
+	for i := range ranked {
+		postIDs[i] = ranked[i].PostID
+	}
+
+	return postIDs, nil
+}
+
+// inSnapshotOrder sorts posts, as read by id, back into the order of postIDs.
+func inSnapshotOrder(posts []domain.Post, postIDs []int) []domain.Post {
+	byID := make(map[int]domain.Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+
+	ordered := make([]domain.Post, 0, len(posts))
+	for _, postID := range postIDs {
+		if post, ok := byID[postID]; ok {
+			ordered = append(ordered, post)
+		}
+	}
+
+	return ordered
+}
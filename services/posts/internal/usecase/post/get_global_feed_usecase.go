@@ -30,26 +30,24 @@ func (p *postUsecase) GetGlobalFeed(
 		return []domain.Post{}, false, nil
 	}
 
-	if loggedInUserID != nil {
-		postIDs := make([]int, 0, len(posts))
-		for _, post := range posts {
-			postIDs = append(postIDs, post.ID)
-		}
-
-		likedMap, err := p.likeRepository.IsPostsLikedByUser(ctx, *loggedInUserID, postIDs)
-		if err != nil {
-			return nil, false, err
-		}
-
-		for i := range posts {
-			posts[i].IsLiked = likedMap[posts[i].ID]
-			posts[i].IsOwner = posts[i].UserID == *loggedInUserID
-		}
-	} else {
-		for i := range posts {
-			posts[i].IsLiked = false
-			posts[i].IsOwner = false
-		}
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.applyViewerState(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, false, err
 	}
 
 	return posts, hasMore, nil
@@ -2,6 +2,7 @@ package post
 
 import (
 	"context"
+	"time"
 	"voidspace/posts/internal/domain"
 )
 
@@ -9,38 +10,65 @@ import (
 func (p *postUsecase) GetLikedPosts(
 	ctx context.Context,
 	userID int,
+	cursorTime *time.Time,
+	cursorID int,
 	loggedInUserID *int,
-) ([]domain.Post, error) {
-	posts, err := p.postRepository.GetLikedByUserID(ctx, userID)
-	if err != nil {
-		return []domain.Post{}, err
-	}
+) ([]domain.Post, bool, error) {
+	var cursor time.Time
 
-	if len(posts) == 0 {
-		return []domain.Post{}, nil
+	if cursorTime != nil {
+		cursor = *cursorTime
+	} else {
+		cursor = time.Now()
 	}
 
-	if loggedInUserID != nil {
-		postIDs := make([]int, 0, len(posts))
-		for _, post := range posts {
-			postIDs = append(postIDs, post.ID)
-		}
+	var posts []domain.Post
+	var hasMore bool
 
-		likedMap, err := p.likeRepository.IsPostsLikedByUser(ctx, *loggedInUserID, postIDs)
+	for {
+		page, more, err := p.postRepository.GetLikedByUserID(ctx, userID, cursor, cursorID)
 		if err != nil {
-			return nil, err
+			return []domain.Post{}, false, err
 		}
 
-		for i := range posts {
-			posts[i].IsLiked = likedMap[posts[i].ID]
-			posts[i].IsOwner = posts[i].UserID == *loggedInUserID
+		// the likes are public, the posts keep their own audience
+		posts, err = p.filterVisible(ctx, page, loggedInUserID)
+		if err != nil {
+			return nil, false, err
 		}
-	} else {
-		for i := range posts {
-			posts[i].IsLiked = false
-			posts[i].IsOwner = false
+
+		hasMore = more
+		if len(posts) > 0 || !hasMore {
+			break
 		}
+
+		last := page[len(page)-1]
+		cursor, cursorID = *last.LikedAt, last.ID
+	}
+
+	if len(posts) == 0 {
+		return []domain.Post{}, false, nil
+	}
+
+	err := p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.applyViewerState(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, false, err
 	}
 
-	return posts, nil
+	return posts, hasMore, nil
 }
@@ -0,0 +1,53 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+// GetMentions implements [domain.PostUsecase].
+func (p *postUsecase) GetMentions(
+	ctx context.Context,
+	cursorTime *time.Time,
+	cursorID int,
+	loggedInUserID int,
+) ([]domain.Post, bool, error) {
+	var cursor time.Time
+	if cursorTime != nil {
+		cursor = *cursorTime
+	} else {
+		cursor = time.Now()
+	}
+
+	posts, hasMore, err := p.postRepository.GetMentioning(ctx, loggedInUserID, cursor, cursorID)
+	if err != nil {
+		return []domain.Post{}, false, err
+	}
+
+	if len(posts) == 0 {
+		return []domain.Post{}, false, nil
+	}
+
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.applyViewerState(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return posts, hasMore, nil
+}
@@ -0,0 +1,38 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// GetPostRevisions implements [domain.PostUsecase]. An image flagged
+// sensitive on the post is flagged in the revisions that still have it.
+func (p *postUsecase) GetPostRevisions(
+	ctx context.Context,
+	postID int,
+	loggedInUserID *int,
+) (*domain.PostHistory, error) {
+	post, err := p.postRepository.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.checkVisible(ctx, post, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := p.postRepository.GetRevisions(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range revisions {
+		keepSensitiveFlags(revisions[i].PostImages, post.PostImages)
+	}
+
+	return &domain.PostHistory{
+		ContentWarning: post.ContentWarning,
+		Revisions:      revisions,
+	}, nil
+}
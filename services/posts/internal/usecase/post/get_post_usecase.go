@@ -16,19 +16,34 @@ func (p *postUsecase) GetPost(
 		return nil, err
 	}
 
-	if loggedInUserID != nil {
-		liked, err := p.likeRepository.IsPostLikedByUser(ctx, *loggedInUserID, postID)
-		if err != nil {
-			return nil, err
-		}
-
-		post.IsLiked = liked
-		post.IsOwner = post.UserID == *loggedInUserID
-	} else {
-		// Guest user
-		post.IsLiked = false
-		post.IsOwner = false
+	err = p.checkVisible(ctx, post, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := []domain.Post{*post}
+
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachPolls(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, err
 	}
 
+	err = p.applyViewerState(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	*post = posts[0]
+
 	return post, nil
 }
@@ -0,0 +1,140 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+const (
+	threadPageSize       = 20
+	threadMaxDepth       = 3
+	threadMaxDescendants = 200
+)
+
+// GetThread implements [domain.PostUsecase].
+func (p *postUsecase) GetThread(
+	ctx context.Context,
+	postID int,
+	cursorTime *time.Time,
+	cursorID int,
+	loggedInUserID *int,
+) (*domain.Thread, error) {
+	post, err := p.postRepository.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.checkVisible(ctx, post, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	thread := &domain.Thread{Post: post}
+
+	// ancestors are only part of the first page
+	if cursorTime == nil {
+		ancestors, err := p.postRepository.GetAncestors(ctx, postID)
+		if err != nil {
+			return nil, err
+		}
+
+		thread.Ancestors, err = p.filterVisible(ctx, ancestors, loggedInUserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cursor time.Time
+	if cursorTime != nil {
+		cursor = *cursorTime
+	}
+
+	var replies []domain.Post
+
+	for {
+		page, err := p.postRepository.GetReplies(ctx, postID, cursor, cursorID, threadPageSize+1)
+		if err != nil {
+			return nil, err
+		}
+
+		thread.HasMore = len(page) > threadPageSize
+		if thread.HasMore {
+			page = page[:threadPageSize]
+		}
+
+		replies, err = p.filterVisible(ctx, page, loggedInUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(replies) > 0 || !thread.HasMore {
+			break
+		}
+
+		last := page[len(page)-1]
+		cursor, cursorID = last.CreatedAt, last.ID
+	}
+
+	parentIDs := make([]int, 0, len(replies))
+	for _, reply := range replies {
+		parentIDs = append(parentIDs, reply.ID)
+	}
+
+	descendants, err := p.postRepository.GetDescendants(ctx, parentIDs, threadMaxDepth-1, threadMaxDescendants)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err = p.filterVisible(ctx, descendants, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// descendants come level by level, a reply under a hidden post is hidden
+	// with it
+	shown := make(map[int]bool, len(replies)+len(descendants))
+	for _, reply := range replies {
+		shown[reply.ID] = true
+	}
+
+	thread.Replies = replies
+	for _, descendant := range descendants {
+		if descendant.ReplyToPostID != nil && shown[*descendant.ReplyToPostID] {
+			shown[descendant.ID] = true
+			thread.Replies = append(thread.Replies, descendant)
+		}
+	}
+
+	// one batch for every post in the thread
+	all := make([]domain.Post, 0, 1+len(thread.Ancestors)+len(thread.Replies))
+	all = append(all, *thread.Post)
+	all = append(all, thread.Ancestors...)
+	all = append(all, thread.Replies...)
+
+	err = p.attachQuotedPosts(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachPolls(ctx, all, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachLinkPreviews(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.applyViewerState(ctx, all, loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	*thread.Post = all[0]
+	copy(thread.Ancestors, all[1:1+len(thread.Ancestors)])
+	copy(thread.Replies, all[1+len(thread.Ancestors):])
+
+	return thread, nil
+}
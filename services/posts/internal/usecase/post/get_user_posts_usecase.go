@@ -2,45 +2,87 @@ package post
 
 import (
 	"context"
+	"time"
 	"voidspace/posts/internal/domain"
 )
 
-// GetUserPosts implements [domain.PostUsecase].
+// GetUserPosts implements [domain.PostUsecase]. Pinned posts come first on
+// the first page and are not repeated in the timeline below them, reposts of
+// them still are.
 func (p *postUsecase) GetUserPosts(
 	ctx context.Context,
 	userID int,
+	cursorTime *time.Time,
+	cursorID int,
 	loggedInUserID *int,
-) ([]domain.Post, error) {
-	posts, err := p.postRepository.GetByUserID(ctx, userID)
-	if err != nil {
-		return []domain.Post{}, err
-	}
+) ([]domain.Post, bool, error) {
+	var cursor time.Time
 
-	if len(posts) == 0 {
-		return []domain.Post{}, nil
+	if cursorTime != nil {
+		cursor = *cursorTime
+	} else {
+		cursor = time.Now()
 	}
 
-	if loggedInUserID != nil {
-		postIDs := make([]int, 0, len(posts))
-		for _, post := range posts {
-			postIDs = append(postIDs, post.ID)
+	followsAuthor := false
+	if loggedInUserID != nil && *loggedInUserID != userID {
+		followed, err := p.followRepository.FilterFollowing(ctx, *loggedInUserID, []int{userID})
+		if err != nil {
+			return []domain.Post{}, false, err
 		}
+		followsAuthor = followed[userID]
+	}
 
-		likedMap, err := p.likeRepository.IsPostsLikedByUser(ctx, *loggedInUserID, postIDs)
+	timeline, hasMore, err := p.postRepository.GetByUserID(ctx, userID, loggedInUserID, followsAuthor, cursor, cursorID)
+	if err != nil {
+		return []domain.Post{}, false, err
+	}
+
+	var posts []domain.Post
+
+	if cursorTime == nil {
+		pinned, err := p.postRepository.GetPinnedByUserID(ctx, userID)
 		if err != nil {
-			return nil, err
+			return []domain.Post{}, false, err
 		}
 
-		for i := range posts {
-			posts[i].IsLiked = likedMap[posts[i].ID]
-			posts[i].IsOwner = posts[i].UserID == *loggedInUserID
+		pinned, err = p.filterVisible(ctx, pinned, loggedInUserID)
+		if err != nil {
+			return nil, false, err
 		}
-	} else {
-		for i := range posts {
-			posts[i].IsLiked = false
-			posts[i].IsOwner = false
+
+		for i := range pinned {
+			pinned[i].IsPinned = true
 		}
+
+		posts = append(posts, pinned...)
+	}
+
+	posts = append(posts, timeline...)
+
+	if len(posts) == 0 {
+		return []domain.Post{}, false, nil
+	}
+
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.applyViewerState(ctx, posts, loggedInUserID)
+	if err != nil {
+		return nil, false, err
 	}
 
-	return posts, nil
+	return posts, hasMore, nil
 }
@@ -0,0 +1,174 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// timelineBackfillPerAuthor is how many of their latest posts, and as many
+// reposts, a newly followed author brings into the timeline.
+const timelineBackfillPerAuthor = 20
+
+// homeTimelinePageSize is how many posts a home timeline page holds.
+const homeTimelinePageSize = 10
+
+// GetHomeTimeline implements [domain.PostUsecase]. Celebrities are not fanned
+// out to, the ones the viewer follows are pulled in at read time.
+func (p *postUsecase) GetHomeTimeline(
+	ctx context.Context,
+	cursorTime *time.Time,
+	cursorID int,
+	loggedInUserID int,
+) ([]domain.Post, bool, error) {
+	built, err := p.postRepository.IsTimelineBuilt(ctx, loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !built {
+		return nil, false, constants.ErrTimelineNotBuilt
+	}
+
+	var cursor time.Time
+
+	if cursorTime != nil {
+		cursor = *cursorTime
+	} else {
+		cursor = time.Now()
+	}
+
+	celebrityIDs, err := p.postRepository.ListTimelineCelebrities(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	followedIDs := []int{}
+	if len(celebrityIDs) > 0 {
+		followed, err := p.followRepository.FilterFollowing(ctx, loggedInUserID, celebrityIDs)
+		if err != nil {
+			return nil, false, err
+		}
+
+		for userID := range followed {
+			followedIDs = append(followedIDs, userID)
+		}
+	}
+
+	posts := []domain.Post{}
+	var hasMore bool
+
+	// rows the viewer may not see are dropped after the fetch, so keep
+	// fetching until the page is full or the timeline runs out
+	for len(posts) < homeTimelinePageSize {
+		page, more, err := p.postRepository.GetHomeTimeline(ctx, loggedInUserID, followedIDs, cursor, cursorID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		visible, err := p.filterVisible(ctx, page, &loggedInUserID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		posts = append(posts, visible...)
+
+		hasMore = more
+		if !hasMore {
+			break
+		}
+
+		last := page[len(page)-1]
+		cursor, cursorID = last.CreatedAt, last.ID
+		if last.RepostedAt != nil {
+			cursor = *last.RepostedAt
+		}
+	}
+
+	if len(posts) > homeTimelinePageSize {
+		posts, hasMore = posts[:homeTimelinePageSize], true
+	}
+
+	if len(posts) == 0 {
+		return []domain.Post{}, false, nil
+	}
+
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachPolls(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = p.applyViewerState(ctx, posts, &loggedInUserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return posts, hasMore, nil
+}
+
+// FanOutPost implements [domain.PostUsecase].
+func (p *postUsecase) FanOutPost(ctx context.Context, postID int, repostedBy *int, userIDs []int) error {
+	if postID <= 0 {
+		return constants.ErrInvalidData
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return p.postRepository.FanOutPost(ctx, postID, repostedBy, userIDs)
+}
+
+// BackfillTimeline implements [domain.PostUsecase]. markBuilt lets the
+// timeline be read, it is set once every follow has been backfilled.
+func (p *postUsecase) BackfillTimeline(ctx context.Context, userID int, authorIDs []int, markBuilt bool) error {
+	if userID <= 0 {
+		return constants.ErrInvalidData
+	}
+
+	if len(authorIDs) > 0 {
+		err := p.postRepository.BackfillTimeline(ctx, userID, authorIDs, timelineBackfillPerAuthor)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !markBuilt {
+		return nil
+	}
+
+	return p.postRepository.MarkTimelineBuilt(ctx, userID)
+}
+
+// ClearTimelineSource implements [domain.PostUsecase]. Posts sourceID
+// reposted stay while their author, or another reposter, is still followed.
+func (p *postUsecase) ClearTimelineSource(ctx context.Context, userID int, sourceID int) error {
+	if userID <= 0 || sourceID <= 0 {
+		return constants.ErrInvalidData
+	}
+
+	return p.postRepository.ClearTimelineSource(ctx, userID, sourceID)
+}
+
+// SetTimelineCelebrity implements [domain.PostUsecase]. Posts fanned out
+// before an author became a celebrity stay, an author dropping back below the
+// threshold is only fanned out to from their next post.
+func (p *postUsecase) SetTimelineCelebrity(ctx context.Context, userID int, celebrity bool) error {
+	if userID <= 0 {
+		return constants.ErrInvalidData
+	}
+
+	return p.postRepository.SetTimelineCelebrity(ctx, userID, celebrity)
+}
@@ -0,0 +1,229 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// fakeTimelineRepository serves pages one GetHomeTimeline call at a time,
+// every page but the last reports more.
+type fakeTimelineRepository struct {
+	domain.PostRepository
+	built        bool
+	celebrityIDs []int
+	pages        [][]domain.Post
+
+	fetches         int
+	cursors         []int
+	gotCelebrityIDs []int
+}
+
+func (f *fakeTimelineRepository) IsTimelineBuilt(ctx context.Context, userID int) (bool, error) {
+	return f.built, nil
+}
+
+func (f *fakeTimelineRepository) ListTimelineCelebrities(ctx context.Context) ([]int, error) {
+	return f.celebrityIDs, nil
+}
+
+func (f *fakeTimelineRepository) GetHomeTimeline(
+	ctx context.Context,
+	viewerID int,
+	celebrityIDs []int,
+	cursorTime time.Time,
+	cursorID int,
+) ([]domain.Post, bool, error) {
+	f.gotCelebrityIDs = celebrityIDs
+	f.cursors = append(f.cursors, cursorID)
+
+	page := f.pages[f.fetches]
+	f.fetches++
+
+	return page, f.fetches < len(f.pages), nil
+}
+
+func (f *fakeTimelineRepository) GetPolls(ctx context.Context, postIDs []int, userID *int) (map[int]*domain.Poll, error) {
+	return nil, nil
+}
+
+func (f *fakeTimelineRepository) GetLinkPreviews(ctx context.Context, postIDs []int) (map[int]*domain.LinkPreview, error) {
+	return nil, nil
+}
+
+type fakeLikeRepository struct{ domain.LikeRepository }
+
+func (f *fakeLikeRepository) GetReactionCounts(ctx context.Context, postIDs []int) (map[int][]domain.ReactionCount, error) {
+	return nil, nil
+}
+
+func (f *fakeLikeRepository) GetUserReactions(ctx context.Context, userID int, postIDs []int) (map[int]string, error) {
+	return nil, nil
+}
+
+type fakeRepostRepository struct{ domain.RepostRepository }
+
+func (f *fakeRepostRepository) IsPostsRepostedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error) {
+	return nil, nil
+}
+
+type fakeBookmarkRepository struct{ domain.BookmarkRepository }
+
+func (f *fakeBookmarkRepository) IsPostsBookmarkedByUser(ctx context.Context, userID int, postIDs []int) (map[int]bool, error) {
+	return nil, nil
+}
+
+// timelinePosts returns posts from through to by userID, newest first.
+func timelinePosts(from, to, userID int, visibility string) []domain.Post {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	posts := make([]domain.Post, 0, to-from+1)
+	for id := from; id <= to; id++ {
+		posts = append(posts, domain.Post{
+			ID:         id,
+			UserID:     userID,
+			Visibility: visibility,
+			CreatedAt:  start.Add(-time.Duration(id) * time.Minute),
+		})
+	}
+
+	return posts
+}
+
+func TestGetHomeTimeline(t *testing.T) {
+	const (
+		viewerID   = 2
+		followedID = 1
+		strangerID = 5
+	)
+
+	mentioning := timelinePosts(4, 4, strangerID, domain.VisibilityFollowers)
+	mentioning[0].Mentions = []domain.Mention{{UserID: viewerID}}
+
+	tests := []struct {
+		name        string
+		pages       [][]domain.Post
+		wantIDs     []int
+		wantMore    bool
+		wantCursors []int
+	}{
+		{
+			name:        "over a page is cut to a page",
+			pages:       [][]domain.Post{timelinePosts(1, 12, strangerID, domain.VisibilityPublic)},
+			wantIDs:     []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			wantMore:    true,
+			wantCursors: []int{0},
+		},
+		{
+			name: "hidden rows are refilled from the next fetch",
+			pages: [][]domain.Post{
+				slices.Concat(
+					timelinePosts(1, 6, strangerID, domain.VisibilityPublic),
+					timelinePosts(7, 10, strangerID, domain.VisibilityFollowers),
+				),
+				timelinePosts(11, 20, strangerID, domain.VisibilityPublic),
+			},
+			wantIDs:     []int{1, 2, 3, 4, 5, 6, 11, 12, 13, 14},
+			wantMore:    true,
+			wantCursors: []int{0, 10},
+		},
+		{
+			name: "followers only posts need a follow or a mention",
+			pages: [][]domain.Post{slices.Concat(
+				timelinePosts(1, 3, followedID, domain.VisibilityFollowers),
+				mentioning,
+				timelinePosts(5, 5, strangerID, domain.VisibilityFollowers),
+				timelinePosts(6, 6, strangerID, domain.VisibilityMentioned),
+			)},
+			wantIDs:     []int{1, 2, 3, 4},
+			wantMore:    false,
+			wantCursors: []int{0},
+		},
+		{
+			name: "the timeline runs out",
+			pages: [][]domain.Post{
+				timelinePosts(1, 10, strangerID, domain.VisibilityFollowers),
+				timelinePosts(11, 12, strangerID, domain.VisibilityPublic),
+			},
+			wantIDs:     []int{11, 12},
+			wantMore:    false,
+			wantCursors: []int{0, 10},
+		},
+		{
+			name:        "nothing visible",
+			pages:       [][]domain.Post{timelinePosts(1, 3, strangerID, domain.VisibilityFollowers)},
+			wantIDs:     []int{},
+			wantMore:    false,
+			wantCursors: []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts := &fakeTimelineRepository{built: true, pages: tt.pages}
+			p := &postUsecase{
+				postRepository:     posts,
+				likeRepository:     &fakeLikeRepository{},
+				repostRepository:   &fakeRepostRepository{},
+				bookmarkRepository: &fakeBookmarkRepository{},
+				followRepository: &fakeFollowRepository{
+					follows: map[int][]int{viewerID: {followedID}},
+				},
+			}
+
+			got, hasMore, err := p.GetHomeTimeline(context.Background(), nil, 0, viewerID)
+			if err != nil {
+				t.Fatalf("GetHomeTimeline: %v", err)
+			}
+
+			ids := make([]int, len(got))
+			for i := range got {
+				ids[i] = got[i].ID
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("posts = %v, want %v", ids, tt.wantIDs)
+			}
+			if hasMore != tt.wantMore {
+				t.Errorf("hasMore = %v, want %v", hasMore, tt.wantMore)
+			}
+			if !slices.Equal(posts.cursors, tt.wantCursors) {
+				t.Errorf("fetched after %v, want %v", posts.cursors, tt.wantCursors)
+			}
+		})
+	}
+}
+
+func TestGetHomeTimelinePullsInFollowedCelebrities(t *testing.T) {
+	posts := &fakeTimelineRepository{
+		built:        true,
+		celebrityIDs: []int{1, 9},
+		pages:        [][]domain.Post{{}},
+	}
+	p := &postUsecase{
+		postRepository:   posts,
+		followRepository: &fakeFollowRepository{follows: map[int][]int{2: {1}}},
+	}
+
+	_, _, err := p.GetHomeTimeline(context.Background(), nil, 0, 2)
+	if err != nil {
+		t.Fatalf("GetHomeTimeline: %v", err)
+	}
+
+	if !slices.Equal(posts.gotCelebrityIDs, []int{1}) {
+		t.Errorf("celebrityIDs = %v, want [1]", posts.gotCelebrityIDs)
+	}
+}
+
+func TestGetHomeTimelineNotBuilt(t *testing.T) {
+	p := &postUsecase{postRepository: &fakeTimelineRepository{}}
+
+	_, _, err := p.GetHomeTimeline(context.Background(), nil, 0, 2)
+	if !errors.Is(err, constants.ErrTimelineNotBuilt) {
+		t.Fatalf("GetHomeTimeline = %v, want ErrTimelineNotBuilt", err)
+	}
+}
@@ -0,0 +1,85 @@
+package post
+
+import (
+	"context"
+	"strings"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// linkPreviewMaxAge is how long a cached preview is reused before the
+// gateway fetches the page again.
+const linkPreviewMaxAge = 24 * time.Hour
+
+// GetLinkPreview implements [domain.PostUsecase].
+func (p *postUsecase) GetLinkPreview(
+	ctx context.Context,
+	url string,
+) (*domain.LinkPreview, error) {
+	preview, err := p.postRepository.GetLinkPreview(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Since(preview.FetchedAt) > linkPreviewMaxAge {
+		return nil, constants.ErrLinkPreviewNotFound
+	}
+
+	return preview, nil
+}
+
+// SetLinkPreview implements [domain.PostUsecase]. A preview needs at least a
+// title or a description to be worth showing.
+func (p *postUsecase) SetLinkPreview(
+	ctx context.Context,
+	postID int,
+	preview *domain.LinkPreview,
+) error {
+	preview.Title = strings.TrimSpace(preview.Title)
+	preview.Description = strings.TrimSpace(preview.Description)
+
+	if preview.URL == "" || (preview.Title == "" && preview.Description == "") {
+		return constants.ErrInvalidLinkPreview
+	}
+
+	if preview.FetchedAt.IsZero() {
+		preview.FetchedAt = time.Now()
+	}
+
+	return p.postRepository.SetLinkPreview(ctx, postID, preview)
+}
+
+// attachLinkPreviews loads the previews of posts and of the posts they quote
+// in one batch.
+func (p *postUsecase) attachLinkPreviews(
+	ctx context.Context,
+	posts []domain.Post,
+) error {
+	postIDs := make([]int, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+		if post.QuotedPost != nil {
+			postIDs = append(postIDs, post.QuotedPost.ID)
+		}
+	}
+
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	previews, err := p.postRepository.GetLinkPreviews(ctx, postIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range posts {
+		posts[i].LinkPreview = previews[posts[i].ID]
+		if posts[i].QuotedPost != nil {
+			posts[i].QuotedPost.LinkPreview = previews[posts[i].QuotedPost.ID]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package post
+
+import (
+	"context"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+const (
+	likersPageSize = 20
+	// likersScanSize is how many likes are read per follow lookup
+	likersScanSize = 100
+	// likersMaxScans bounds the likes read for one page, a page the scan
+	// gives up on comes back short with its cursor where the scan stopped
+	likersMaxScans = 5
+)
+
+// ListPostLikers implements [domain.PostUsecase]. A logged in viewer gets two
+// runs over the likes by like time, first the likers they follow and then
+// everyone else. Follows are looked up for the likes as they are scanned,
+// never for the viewer's whole follow list.
+func (p *postUsecase) ListPostLikers(
+	ctx context.Context,
+	postID int,
+	cursor *domain.LikerCursor,
+	loggedInUserID *int,
+) ([]domain.Liker, *domain.LikerCursor, bool, error) {
+	post, err := p.postRepository.GetByID(ctx, postID)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	err = p.checkVisible(ctx, post, loggedInUserID)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if cursor == nil {
+		cursor = &domain.LikerCursor{LikedAt: time.Now(), Followed: loggedInUserID != nil}
+	}
+
+	// guests follow no one, theirs is a single run
+	if loggedInUserID == nil {
+		likers, hasMore, err := p.likeRepository.GetLikers(ctx, postID, cursor.LikedAt, cursor.UserID, likersPageSize)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		if len(likers) == 0 {
+			return likers, nil, false, nil
+		}
+
+		last := likers[len(likers)-1]
+		return likers, &domain.LikerCursor{LikedAt: last.LikedAt, UserID: last.UserID}, hasMore, nil
+	}
+
+	likers := make([]domain.Liker, 0, likersPageSize)
+	next := *cursor
+
+	for range likersMaxScans {
+		scanned, more, err := p.likeRepository.GetLikers(ctx, postID, next.LikedAt, next.UserID, likersScanSize)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		likerIDs := make([]int, len(scanned))
+		for i, liker := range scanned {
+			likerIDs[i] = liker.UserID
+		}
+
+		followed, err := p.followRepository.FilterFollowing(ctx, *loggedInUserID, likerIDs)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		for i, liker := range scanned {
+			next.LikedAt, next.UserID = liker.LikedAt, liker.UserID
+			if followed[liker.UserID] != next.Followed {
+				continue
+			}
+
+			liker.IsFollowed = next.Followed
+			likers = append(likers, liker)
+
+			if len(likers) == likersPageSize {
+				if !next.Followed && !more && i == len(scanned)-1 {
+					return likers, nil, false, nil
+				}
+				return likers, &next, true, nil
+			}
+		}
+
+		if more {
+			continue
+		}
+
+		if !next.Followed {
+			return likers, nil, false, nil
+		}
+
+		// the rest starts again from the newest like
+		next = domain.LikerCursor{LikedAt: time.Now()}
+	}
+
+	return likers, &next, true, nil
+}
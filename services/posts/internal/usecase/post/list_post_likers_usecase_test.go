@@ -0,0 +1,156 @@
+package post
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+type fakePostRepository struct {
+	domain.PostRepository
+	post *domain.Post
+}
+
+func (f *fakePostRepository) GetByID(ctx context.Context, postID int) (*domain.Post, error) {
+	return f.post, nil
+}
+
+// fakeLikersRepository pages likers, newest like first, the way the
+// repository's keyset does.
+type fakeLikersRepository struct {
+	domain.LikeRepository
+	likers []domain.Liker
+}
+
+func (f *fakeLikersRepository) GetLikers(
+	ctx context.Context,
+	postID int,
+	cursorTime time.Time,
+	cursorID int,
+	limit int,
+) ([]domain.Liker, bool, error) {
+	page := []domain.Liker{}
+	for _, liker := range f.likers {
+		if liker.LikedAt.Before(cursorTime) || (liker.LikedAt.Equal(cursorTime) && liker.UserID < cursorID) {
+			page = append(page, liker)
+		}
+	}
+
+	if len(page) > limit {
+		return page[:limit], true, nil
+	}
+
+	return page, false, nil
+}
+
+// likedBy returns likers 1 through n, user 1 liked last.
+func likedBy(n int) []domain.Liker {
+	start := time.Now().Add(-time.Hour)
+
+	likers := make([]domain.Liker, n)
+	for i := range likers {
+		likers[i] = domain.Liker{UserID: i + 1, LikedAt: start.Add(-time.Duration(i) * time.Second)}
+	}
+
+	return likers
+}
+
+func TestListPostLikersFollowedFirst(t *testing.T) {
+	const viewerID = 100
+
+	p := &postUsecase{
+		postRepository: &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: domain.VisibilityPublic}},
+		likeRepository: &fakeLikersRepository{likers: likedBy(30)},
+		followRepository: &fakeFollowRepository{
+			follows: map[int][]int{viewerID: {25, 3, 12}},
+		},
+	}
+
+	var pages [][]int
+	var followed []int
+
+	var cursor *domain.LikerCursor
+	for {
+		likers, next, hasMore, err := p.ListPostLikers(context.Background(), 10, cursor, ptr(viewerID))
+		if err != nil {
+			t.Fatalf("ListPostLikers: %v", err)
+		}
+
+		ids := make([]int, len(likers))
+		for i, liker := range likers {
+			ids[i] = liker.UserID
+			if liker.IsFollowed {
+				followed = append(followed, liker.UserID)
+			}
+		}
+		pages = append(pages, ids)
+
+		if hasMore != (next != nil) {
+			t.Fatalf("hasMore = %v with cursor %v", hasMore, next)
+		}
+		if next == nil {
+			break
+		}
+		if len(pages) > 5 {
+			t.Fatal("pages never ran out")
+		}
+		cursor = next
+	}
+
+	want := [][]int{
+		{3, 12, 25, 1, 2, 4, 5, 6, 7, 8, 9, 10, 11, 13, 14, 15, 16, 17, 18, 19},
+		{20, 21, 22, 23, 24, 26, 27, 28, 29, 30},
+	}
+	if !slices.EqualFunc(pages, want, slices.Equal) {
+		t.Errorf("pages = %v, want %v", pages, want)
+	}
+	if !slices.Equal(followed, []int{3, 12, 25}) {
+		t.Errorf("followed = %v, want [3 12 25]", followed)
+	}
+}
+
+func TestListPostLikersGuest(t *testing.T) {
+	p := &postUsecase{
+		postRepository: &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: domain.VisibilityPublic}},
+		likeRepository: &fakeLikersRepository{likers: likedBy(30)},
+	}
+
+	likers, next, hasMore, err := p.ListPostLikers(context.Background(), 10, nil, nil)
+	if err != nil {
+		t.Fatalf("ListPostLikers: %v", err)
+	}
+
+	if len(likers) != likersPageSize || likers[0].UserID != 1 || likers[len(likers)-1].UserID != likersPageSize {
+		t.Errorf("likers = %v, want users 1 to %d", likers, likersPageSize)
+	}
+	if !hasMore || next == nil || next.Followed {
+		t.Errorf("next = %v, hasMore = %v", next, hasMore)
+	}
+}
+
+func TestListPostLikersStopsLongScans(t *testing.T) {
+	follows := &fakeFollowRepository{}
+	p := &postUsecase{
+		postRepository:   &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: domain.VisibilityPublic}},
+		likeRepository:   &fakeLikersRepository{likers: likedBy(likersScanSize*likersMaxScans + 50)},
+		followRepository: follows,
+	}
+
+	// the viewer follows none of them, the followed run reads up to the cap
+	likers, next, hasMore, err := p.ListPostLikers(context.Background(), 10, nil, ptr(100))
+	if err != nil {
+		t.Fatalf("ListPostLikers: %v", err)
+	}
+
+	if len(likers) != 0 {
+		t.Errorf("likers = %v, want none", likers)
+	}
+	if !hasMore || next == nil || !next.Followed || next.UserID != likersScanSize*likersMaxScans {
+		t.Errorf("next = %+v, hasMore = %v, want the followed run at the last scanned like", next, hasMore)
+	}
+	if follows.calls != likersMaxScans {
+		t.Errorf("FilterFollowing calls = %d, want %d", follows.calls, likersMaxScans)
+	}
+}
@@ -0,0 +1,36 @@
+package post
+
+import (
+	"context"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+const maxPinnedPosts = 3
+
+// PinPost implements [domain.PostUsecase]. Only the author can pin a post.
+func (p *postUsecase) PinPost(
+	ctx context.Context,
+	postID int,
+	loggedInUserID int,
+) error {
+	post, err := p.postRepository.GetByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	if post.UserID != loggedInUserID {
+		return constants.ErrUnauthorized
+	}
+
+	return p.postRepository.PinPost(ctx, loggedInUserID, postID, maxPinnedPosts)
+}
+
+// UnpinPost implements [domain.PostUsecase].
+func (p *postUsecase) UnpinPost(
+	ctx context.Context,
+	postID int,
+	loggedInUserID int,
+) error {
+	return p.postRepository.UnpinPost(ctx, loggedInUserID, postID)
+}
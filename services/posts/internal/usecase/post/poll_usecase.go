@@ -0,0 +1,204 @@
+package post
+
+import (
+	"context"
+	"strings"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+const (
+	minPollOptions      = 2
+	maxPollOptions      = 4
+	maxPollOptionLength = 25
+	minPollDuration     = 5 * time.Minute
+	maxPollDuration     = 7 * 24 * time.Hour
+
+	// pollVotersPageSize is how many voters a ListPollVoters page holds
+	pollVotersPageSize = 500
+)
+
+// validatePoll checks a poll sent with a new post: 2 to 4 distinct non-empty
+// options and an end time between 5 minutes and 7 days away.
+func validatePoll(poll *domain.Poll, now time.Time) error {
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return constants.ErrInvalidPoll
+	}
+
+	seen := make(map[string]bool, len(poll.Options))
+	for i := range poll.Options {
+		label := strings.TrimSpace(poll.Options[i].Label)
+		if label == "" || helper.GraphemeCount(label) > maxPollOptionLength {
+			return constants.ErrInvalidPoll
+		}
+
+		key := strings.ToLower(label)
+		if seen[key] {
+			return constants.ErrInvalidPoll
+		}
+		seen[key] = true
+
+		poll.Options[i].Label = label
+	}
+
+	duration := poll.EndsAt.Sub(now)
+	if duration < minPollDuration || duration > maxPollDuration {
+		return constants.ErrInvalidPoll
+	}
+
+	return nil
+}
+
+// VotePoll implements [domain.PostUsecase]. A vote is final, single choice
+// polls take exactly one option.
+func (p *postUsecase) VotePoll(
+	ctx context.Context,
+	postID int,
+	optionIDs []int,
+	loggedInUserID int,
+) (*domain.Poll, error) {
+	post, err := p.postRepository.GetByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.checkVisible(ctx, post, &loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	polls, err := p.postRepository.GetPolls(ctx, []int{postID}, &loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	poll := polls[postID]
+	if poll == nil {
+		return nil, constants.ErrPollNotFound
+	}
+
+	if poll.Closed(time.Now()) {
+		return nil, constants.ErrPollClosed
+	}
+
+	if poll.Voted {
+		return nil, constants.ErrAlreadyVoted
+	}
+
+	if len(optionIDs) == 0 || (!poll.MultipleChoice && len(optionIDs) > 1) {
+		return nil, constants.ErrInvalidVote
+	}
+
+	valid := make(map[int]bool, len(poll.Options))
+	for _, option := range poll.Options {
+		valid[option.ID] = true
+	}
+
+	for _, optionID := range optionIDs {
+		if !valid[optionID] {
+			return nil, constants.ErrInvalidVote
+		}
+		// each option counts once
+		valid[optionID] = false
+	}
+
+	err = p.postRepository.VotePoll(ctx, postID, loggedInUserID, optionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	polls, err = p.postRepository.GetPolls(ctx, []int{postID}, &loggedInUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	poll = polls[postID]
+	if poll == nil {
+		return nil, constants.ErrPollNotFound
+	}
+
+	applyTallyVisibility(poll, time.Now())
+
+	return poll, nil
+}
+
+// ClosePoll implements [domain.PostUsecase].
+func (p *postUsecase) ClosePoll(
+	ctx context.Context,
+	postID int,
+) error {
+	return p.postRepository.ClosePoll(ctx, postID)
+}
+
+// ListPollVoters implements [domain.PostUsecase].
+func (p *postUsecase) ListPollVoters(
+	ctx context.Context,
+	postID int,
+	cursorID int,
+) ([]int, bool, error) {
+	voterIDs, err := p.postRepository.GetPollVoters(ctx, postID, cursorID, pollVotersPageSize+1)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(voterIDs) > pollVotersPageSize {
+		return voterIDs[:pollVotersPageSize], true, nil
+	}
+
+	return voterIDs, false, nil
+}
+
+// attachPolls loads the polls on posts and on the posts they quote in one
+// batch and hides the tallies the viewer may not see yet.
+func (p *postUsecase) attachPolls(
+	ctx context.Context,
+	posts []domain.Post,
+	loggedInUserID *int,
+) error {
+	postIDs := make([]int, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+		if post.QuotedPost != nil {
+			postIDs = append(postIDs, post.QuotedPost.ID)
+		}
+	}
+
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	polls, err := p.postRepository.GetPolls(ctx, postIDs, loggedInUserID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, poll := range polls {
+		applyTallyVisibility(poll, now)
+	}
+
+	for i := range posts {
+		posts[i].Poll = polls[posts[i].ID]
+		if posts[i].QuotedPost != nil {
+			posts[i].QuotedPost.Poll = polls[posts[i].QuotedPost.ID]
+		}
+	}
+
+	return nil
+}
+
+// applyTallyVisibility keeps the per option counts only once the viewer has
+// voted or the poll has closed, so results can't sway the vote.
+func applyTallyVisibility(poll *domain.Poll, now time.Time) {
+	poll.TalliesVisible = poll.Voted || poll.Closed(now)
+	if poll.TalliesVisible {
+		return
+	}
+
+	for i := range poll.Options {
+		poll.Options[i].VotesCount = 0
+	}
+}
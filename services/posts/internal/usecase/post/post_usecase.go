@@ -6,19 +6,40 @@ import (
 )
 
 type postUsecase struct {
-	postRepository domain.PostRepository
-	likeRepository domain.LikeRepository
-	contextTimeout time.Duration
+	postRepository     domain.PostRepository
+	likeRepository     domain.LikeRepository
+	repostRepository   domain.RepostRepository
+	bookmarkRepository domain.BookmarkRepository
+	followRepository   domain.FollowRepository
+	commentRepository  domain.CommentRepository
+	rankingConfig      domain.RankingConfig
+	editWindow         time.Duration
+	maxEdits           int
+	contextTimeout     time.Duration
 }
 
 func NewPostUsecase(
 	postRepository domain.PostRepository,
 	likeRepository domain.LikeRepository,
+	repostRepository domain.RepostRepository,
+	bookmarkRepository domain.BookmarkRepository,
+	followRepository domain.FollowRepository,
+	commentRepository domain.CommentRepository,
+	rankingConfig domain.RankingConfig,
+	editWindow time.Duration,
+	maxEdits int,
 	contextTimeout time.Duration,
 ) domain.PostUsecase {
 	return &postUsecase{
-		postRepository: postRepository,
-		likeRepository: likeRepository,
-		contextTimeout: contextTimeout,
+		postRepository:     postRepository,
+		likeRepository:     likeRepository,
+		repostRepository:   repostRepository,
+		bookmarkRepository: bookmarkRepository,
+		followRepository:   followRepository,
+		commentRepository:  commentRepository,
+		rankingConfig:      rankingConfig,
+		editWindow:         editWindow,
+		maxEdits:           maxEdits,
+		contextTimeout:     contextTimeout,
 	}
 }
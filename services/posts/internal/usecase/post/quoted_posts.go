@@ -0,0 +1,42 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// attachQuotedPosts loads the posts quoted by posts in one batch. Quoted posts
+// are embedded one level deep and a deleted quoted post is left nil.
+func (p *postUsecase) attachQuotedPosts(
+	ctx context.Context,
+	posts []domain.Post,
+) error {
+	quoteIDs := make([]int, 0)
+	for _, post := range posts {
+		if post.QuotePostID != nil {
+			quoteIDs = append(quoteIDs, *post.QuotePostID)
+		}
+	}
+
+	if len(quoteIDs) == 0 {
+		return nil
+	}
+
+	quoted, err := p.postRepository.GetByIDs(ctx, quoteIDs)
+	if err != nil {
+		return err
+	}
+
+	quotedByID := make(map[int]*domain.Post, len(quoted))
+	for i := range quoted {
+		quotedByID[quoted[i].ID] = &quoted[i]
+	}
+
+	for i := range posts {
+		if posts[i].QuotePostID != nil {
+			posts[i].QuotedPost = quotedByID[*posts[i].QuotePostID]
+		}
+	}
+
+	return nil
+}
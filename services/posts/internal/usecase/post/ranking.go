@@ -0,0 +1,91 @@
+package post
+
+import (
+	"math"
+	"sort"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+// Engagement counts comments and replies double and reposts and quotes
+// triple, they take more than a like.
+const (
+	commentEngagement = 2
+	shareEngagement   = 3
+)
+
+// scoreCandidate rates a For You candidate. The followed and affinity boosts
+// and the engagement velocity add up, then the sum halves every HalfLife of
+// the post's age.
+func scoreCandidate(candidate *domain.RankCandidate, cfg domain.RankingConfig, now time.Time) float64 {
+	age := max(now.Sub(candidate.CreatedAt).Hours(), 0)
+
+	engagement := float64(candidate.LikesCount) +
+		commentEngagement*float64(candidate.CommentsCount+candidate.RepliesCount) +
+		shareEngagement*float64(candidate.RepostsCount+candidate.QuotesCount)
+	// the two hours keep a brand new post with one like off the top
+	velocity := engagement / (age + 2)
+
+	score := 1 +
+		cfg.EngagementWeight*math.Log1p(velocity) +
+		cfg.AffinityWeight*math.Log1p(float64(candidate.Affinity))
+	if candidate.Followed {
+		score += cfg.FollowedWeight
+	}
+
+	if cfg.HalfLife > 0 {
+		score *= math.Exp2(-age / cfg.HalfLife.Hours())
+	}
+
+	return score
+}
+
+// rankCandidates orders candidates by score, newest first on a tie, and
+// spreads authors out with diversify.
+func rankCandidates(candidates []domain.RankCandidate, cfg domain.RankingConfig, now time.Time) []domain.RankCandidate {
+	scores := make(map[int]float64, len(candidates))
+	for i := range candidates {
+		scores[candidates[i].PostID] = scoreCandidate(&candidates[i], cfg, now)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if scores[a.PostID] != scores[b.PostID] {
+			return scores[a.PostID] > scores[b.PostID]
+		}
+		return a.PostID > b.PostID
+	})
+
+	return diversify(candidates, forYouPageSize, cfg.MaxPerAuthor)
+}
+
+// diversify keeps every page of pageSize to at most maxPerAuthor posts by
+// one author. A post over the cap moves down to the next page with room for
+// it, keeping its order among the moved posts.
+func diversify(ranked []domain.RankCandidate, pageSize int, maxPerAuthor int) []domain.RankCandidate {
+	if maxPerAuthor <= 0 {
+		return ranked
+	}
+
+	result := make([]domain.RankCandidate, 0, len(ranked))
+	pending := ranked
+
+	for len(pending) > 0 {
+		pageStart := len(result)
+		perAuthor := make(map[int]int)
+		next := make([]domain.RankCandidate, 0)
+
+		for _, candidate := range pending {
+			if len(result)-pageStart < pageSize && perAuthor[candidate.UserID] < maxPerAuthor {
+				result = append(result, candidate)
+				perAuthor[candidate.UserID]++
+				continue
+			}
+			next = append(next, candidate)
+		}
+
+		pending = next
+	}
+
+	return result
+}
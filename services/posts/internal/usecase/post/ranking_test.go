@@ -0,0 +1,85 @@
+package post
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+func TestScoreCandidate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cfg := domain.RankingConfig{
+		FollowedWeight:   1,
+		EngagementWeight: 1,
+		HalfLife:         6 * time.Hour,
+	}
+
+	tests := []struct {
+		name      string
+		candidate domain.RankCandidate
+		cfg       domain.RankingConfig
+		want      float64
+	}{
+		{"new post", domain.RankCandidate{CreatedAt: now}, cfg, 1},
+		{"one half life old", domain.RankCandidate{CreatedAt: now.Add(-6 * time.Hour)}, cfg, 0.5},
+		{"two half lives old", domain.RankCandidate{CreatedAt: now.Add(-12 * time.Hour)}, cfg, 0.25},
+		{"written in the future", domain.RankCandidate{CreatedAt: now.Add(time.Hour)}, cfg, 1},
+		{"followed", domain.RankCandidate{CreatedAt: now, Followed: true}, cfg, 2},
+		{"followed decays too", domain.RankCandidate{CreatedAt: now.Add(-6 * time.Hour), Followed: true}, cfg, 1},
+		{"liked", domain.RankCandidate{CreatedAt: now, LikesCount: 2}, cfg, 1 + math.Ln2},
+		{
+			"no half life",
+			domain.RankCandidate{CreatedAt: now.Add(-6 * time.Hour)},
+			domain.RankingConfig{FollowedWeight: 1, EngagementWeight: 1},
+			1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scoreCandidate(&tt.candidate, tt.cfg, now)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("scoreCandidate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiversify(t *testing.T) {
+	tests := []struct {
+		name         string
+		authors      []int
+		pageSize     int
+		maxPerAuthor int
+		want         []int
+	}{
+		{"no cap", []int{1, 1, 1, 2}, 2, 0, []int{1, 2, 3, 4}},
+		{"under the cap", []int{1, 2, 1, 2}, 2, 1, []int{1, 2, 3, 4}},
+		{"spills to the next page", []int{1, 1, 2, 3, 4}, 3, 1, []int{1, 3, 4, 2, 5}},
+		{"spills over several pages", []int{1, 1, 1, 2}, 2, 1, []int{1, 4, 2, 3}},
+		{"cap above one", []int{1, 1, 1, 1, 2}, 3, 2, []int{1, 2, 5, 3, 4}},
+		{"moved posts keep their order", []int{1, 2, 1, 2, 1, 2, 3}, 4, 1, []int{1, 2, 7, 3, 4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// post ids follow the ranked order from 1
+			ranked := make([]domain.RankCandidate, len(tt.authors))
+			for i, author := range tt.authors {
+				ranked[i] = domain.RankCandidate{PostID: i + 1, UserID: author}
+			}
+
+			result := diversify(ranked, tt.pageSize, tt.maxPerAuthor)
+
+			got := make([]int, len(result))
+			for i := range result {
+				got[i] = result[i].PostID
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("diversify = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
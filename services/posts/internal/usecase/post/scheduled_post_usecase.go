@@ -0,0 +1,161 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// scheduledToPost builds the post a scheduled post publishes as.
+func scheduledToPost(scheduled *domain.ScheduledPost) *domain.Post {
+	return &domain.Post{
+		Content:        scheduled.Content,
+		UserID:         scheduled.UserID,
+		PostImages:     scheduled.PostImages,
+		ReplyToPostID:  scheduled.ReplyToPostID,
+		QuotePostID:    scheduled.QuotePostID,
+		Mentions:       scheduled.Mentions,
+		Visibility:     scheduled.Visibility,
+		ContentWarning: scheduled.ContentWarning,
+	}
+}
+
+// SchedulePost implements [domain.PostUsecase]. The post goes through the
+// same checks as CreatePost so a bad post fails now and not at publish time.
+func (p *postUsecase) SchedulePost(
+	ctx context.Context,
+	post *domain.ScheduledPost,
+) (*domain.ScheduledPost, error) {
+	if !post.PublishAt.After(time.Now()) {
+		return nil, constants.ErrInvalidPublishAt
+	}
+
+	err := validateVisibility(&post.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	post.ContentWarning, err = normalizeContentWarning(post.ContentWarning)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.preparePost(ctx, scheduledToPost(post))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.postRepository.CreateScheduled(ctx, post)
+}
+
+// GetScheduledPosts implements [domain.PostUsecase].
+func (p *postUsecase) GetScheduledPosts(
+	ctx context.Context,
+	userID int,
+) ([]domain.ScheduledPost, error) {
+	scheduled, err := p.postRepository.GetScheduledByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// UpdateScheduledPost implements [domain.PostUsecase]. A zero PublishAt keeps
+// the current publish time.
+func (p *postUsecase) UpdateScheduledPost(
+	ctx context.Context,
+	post *domain.ScheduledPost,
+	loggedInUserID int,
+) (*domain.ScheduledPost, error) {
+	existing, err := p.postRepository.GetScheduledByID(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.UserID != loggedInUserID {
+		return nil, constants.ErrUnauthorized
+	}
+
+	existing.Content = post.Content
+	existing.PostImages = post.PostImages
+	existing.Mentions = post.Mentions
+	if !post.PublishAt.IsZero() {
+		existing.PublishAt = post.PublishAt
+	}
+
+	if !existing.PublishAt.After(time.Now()) {
+		return nil, constants.ErrInvalidPublishAt
+	}
+
+	err = p.preparePost(ctx, scheduledToPost(existing))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.postRepository.UpdateScheduled(ctx, existing)
+}
+
+// CancelScheduledPost implements [domain.PostUsecase].
+func (p *postUsecase) CancelScheduledPost(
+	ctx context.Context,
+	scheduledPostID int,
+	loggedInUserID int,
+) error {
+	existing, err := p.postRepository.GetScheduledByID(ctx, scheduledPostID)
+	if err != nil {
+		return err
+	}
+
+	if existing.UserID != loggedInUserID {
+		return constants.ErrUnauthorized
+	}
+
+	return p.postRepository.DeleteScheduled(ctx, scheduledPostID)
+}
+
+// PublishScheduledPost implements [domain.PostUsecase].
+func (p *postUsecase) PublishScheduledPost(
+	ctx context.Context,
+	scheduledPostID int,
+) (*domain.Post, error) {
+	scheduled, err := p.postRepository.GetScheduledByID(ctx, scheduledPostID)
+	if err != nil {
+		return nil, err
+	}
+
+	post := scheduledToPost(scheduled)
+
+	err = p.preparePost(ctx, post)
+	if err != nil {
+		if !unpublishable(err) {
+			return nil, err
+		}
+
+		// the workflow gives up on this error, drop the row so it isn't
+		// listed as pending forever
+		delErr := p.postRepository.DeleteScheduled(ctx, scheduledPostID)
+		if delErr != nil && !errors.Is(delErr, constants.ErrScheduledPostNotFound) {
+			return nil, delErr
+		}
+
+		return nil, err
+	}
+
+	return p.postRepository.PublishScheduled(ctx, scheduledPostID, post)
+}
+
+// unpublishable reports whether err fails every later publish of the same
+// scheduled post, e.g. the reply or quote target was deleted or hidden since
+// it was scheduled, as opposed to an outage worth retrying.
+func unpublishable(err error) bool {
+	return errors.Is(err, constants.ErrPostNotFound) ||
+		errors.Is(err, constants.ErrPostNotShareable) ||
+		errors.Is(err, constants.ErrPostTooLong) ||
+		errors.Is(err, constants.ErrInvalidMention) ||
+		errors.Is(err, constants.ErrInvalidVisibility) ||
+		errors.Is(err, constants.ErrInvalidContentWarning)
+}
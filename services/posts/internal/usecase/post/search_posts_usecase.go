@@ -17,5 +17,26 @@ func (p *postUsecase) SearchPosts(
 		return nil, errors.New("invalid search query: must be 2-100 characters and only contain letters, digits, spaces, and -_.' ,")
 	}
 
-	return p.postRepository.SearchPosts(ctx, query)
+	posts, err := p.postRepository.SearchPosts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachQuotedPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	// search has no viewer, tallies show once the poll closes
+	err = p.attachPolls(ctx, posts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.attachLinkPreviews(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	return posts, nil
 }
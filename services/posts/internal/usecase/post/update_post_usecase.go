@@ -2,7 +2,9 @@ package post
 
 import (
 	"context"
+	"time"
 	"voidspace/posts/internal/domain"
+	"voidspace/posts/utils"
 
 	"github.com/vhysxl/voidspace/shared/utils/constants"
 )
@@ -22,10 +24,41 @@ func (p *postUsecase) UpdatePost(
 		return constants.ErrUnauthorized
 	}
 
-	err = p.postRepository.Update(ctx, post)
+	// checked again by the update, two concurrent edits can both get here
+	if time.Since(existingPost.CreatedAt) > p.editWindow {
+		return constants.ErrEditWindowClosed
+	}
+
+	if existingPost.EditCount >= p.maxEdits {
+		return constants.ErrEditLimitReached
+	}
+
+	err = validateContent(post)
+	if err != nil {
+		return err
+	}
+
+	keepSensitiveFlags(post.PostImages, existingPost.PostImages)
+
+	post.Hashtags = utils.ExtractHashtags(post.Content)
+	post.Entities = utils.ExtractEntities(post.Content, post.Mentions)
+
+	err = p.postRepository.Update(ctx, post, p.maxEdits, p.editWindow)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// keepSensitiveFlags carries the sensitive flag of images kept through an
+// edit, so editing can't undo a moderator's flag.
+func keepSensitiveFlags(images []domain.PostImage, previous []domain.PostImage) {
+	for i := range images {
+		for _, prev := range previous {
+			if prev.Sensitive && prev.Url == images[i].Url {
+				images[i].Sensitive = true
+			}
+		}
+	}
+}
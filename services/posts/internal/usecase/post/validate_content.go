@@ -0,0 +1,59 @@
+package post
+
+import (
+	"strings"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+// maxContentWarningLength is the content warning limit in grapheme clusters.
+const maxContentWarningLength = 100
+
+// validateContent enforces the length limit in grapheme clusters and checks
+// the mentions sent with the post.
+func validateContent(post *domain.Post) error {
+	if helper.GraphemeCount(post.Content) > helper.MaxPostLength {
+		return constants.ErrPostTooLong
+	}
+
+	return validateMentions(post.Content, post.Mentions)
+}
+
+// validateMentions checks the mentions resolved by the gateway still point at
+// @usernames in content.
+func validateMentions(content string, mentions []domain.Mention) error {
+	spans := make([]helper.MentionSpan, len(mentions))
+	for i, mention := range mentions {
+		if mention.UserID <= 0 {
+			return constants.ErrInvalidMention
+		}
+		spans[i] = helper.MentionSpan{Start: mention.Start, End: mention.End}
+	}
+
+	if !helper.ValidMentions(content, spans) {
+		return constants.ErrInvalidMention
+	}
+
+	return nil
+}
+
+// normalizeContentWarning trims warning and checks its length, a blank
+// warning is no warning.
+func normalizeContentWarning(warning *string) (*string, error) {
+	if warning == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*warning)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	if helper.GraphemeCount(trimmed) > maxContentWarningLength {
+		return nil, constants.ErrInvalidContentWarning
+	}
+
+	return &trimmed, nil
+}
@@ -0,0 +1,62 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// applyViewerState fills the reaction counts for everyone and ViewerReaction,
+// IsLiked, IsReposted, IsBookmarked and IsOwner for the logged in viewer,
+// guests get all false.
+func (p *postUsecase) applyViewerState(
+	ctx context.Context,
+	posts []domain.Post,
+	loggedInUserID *int,
+) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]int, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+	}
+
+	reactionCounts, err := p.likeRepository.GetReactionCounts(ctx, postIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range posts {
+		posts[i].Reactions = reactionCounts[posts[i].ID]
+	}
+
+	if loggedInUserID == nil {
+		return nil
+	}
+
+	reactionMap, err := p.likeRepository.GetUserReactions(ctx, *loggedInUserID, postIDs)
+	if err != nil {
+		return err
+	}
+
+	repostedMap, err := p.repostRepository.IsPostsRepostedByUser(ctx, *loggedInUserID, postIDs)
+	if err != nil {
+		return err
+	}
+
+	bookmarkedMap, err := p.bookmarkRepository.IsPostsBookmarkedByUser(ctx, *loggedInUserID, postIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range posts {
+		posts[i].ViewerReaction = reactionMap[posts[i].ID]
+		posts[i].IsLiked = posts[i].ViewerReaction != ""
+		posts[i].IsReposted = repostedMap[posts[i].ID]
+		posts[i].IsBookmarked = bookmarkedMap[posts[i].ID]
+		posts[i].IsOwner = posts[i].UserID == *loggedInUserID
+	}
+
+	return nil
+}
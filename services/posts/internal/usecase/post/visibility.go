@@ -0,0 +1,86 @@
+package post
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// validateVisibility defaults an unset visibility to public.
+func validateVisibility(visibility *string) error {
+	switch *visibility {
+	case "":
+		*visibility = domain.VisibilityPublic
+	case domain.VisibilityPublic,
+		domain.VisibilityFollowers,
+		domain.VisibilityMentioned,
+		domain.VisibilityUnlisted:
+	default:
+		return constants.ErrInvalidVisibility
+	}
+
+	return nil
+}
+
+// shareable reports whether post may be reposted or quoted, which would
+// otherwise carry it past its audience.
+func shareable(post *domain.Post) bool {
+	return post.Visibility == domain.VisibilityPublic || post.Visibility == domain.VisibilityUnlisted
+}
+
+// filterVisible keeps the posts the viewer may see, guests only see public
+// and unlisted posts. Follows are looked up in one batch for the authors of
+// followers only posts.
+func (p *postUsecase) filterVisible(
+	ctx context.Context,
+	posts []domain.Post,
+	loggedInUserID *int,
+) ([]domain.Post, error) {
+	followed := map[int]bool{}
+
+	if loggedInUserID != nil {
+		authorIDs := make([]int, 0)
+		for i := range posts {
+			if posts[i].Visibility == domain.VisibilityFollowers && !posts[i].Involves(*loggedInUserID) {
+				authorIDs = append(authorIDs, posts[i].UserID)
+			}
+		}
+
+		if len(authorIDs) > 0 {
+			var err error
+			followed, err = p.followRepository.FilterFollowing(ctx, *loggedInUserID, authorIDs)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	visible := make([]domain.Post, 0, len(posts))
+	for i := range posts {
+		if posts[i].VisibleTo(loggedInUserID, followed[posts[i].UserID]) {
+			visible = append(visible, posts[i])
+		}
+	}
+
+	return visible, nil
+}
+
+// checkVisible returns ErrPostNotFound for a post the viewer may not see so
+// its existence isn't leaked.
+func (p *postUsecase) checkVisible(
+	ctx context.Context,
+	post *domain.Post,
+	loggedInUserID *int,
+) error {
+	visible, err := p.filterVisible(ctx, []domain.Post{*post}, loggedInUserID)
+	if err != nil {
+		return err
+	}
+
+	if len(visible) == 0 {
+		return constants.ErrPostNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// fakeFollowRepository answers FilterFollowing from follows, a map of
+// follower id to the ids they follow.
+type fakeFollowRepository struct {
+	follows map[int][]int
+	calls   int
+}
+
+func (f *fakeFollowRepository) FilterFollowing(
+	ctx context.Context,
+	userID int,
+	targetUserIDs []int,
+) (map[int]bool, error) {
+	f.calls++
+
+	followed := map[int]bool{}
+	for _, target := range targetUserIDs {
+		for _, id := range f.follows[userID] {
+			if id == target {
+				followed[target] = true
+			}
+		}
+	}
+
+	return followed, nil
+}
+
+func TestVisibility(t *testing.T) {
+	const (
+		authorID    = 1
+		followerID  = 2
+		mentionedID = 3
+		strangerID  = 4
+	)
+
+	viewers := []struct {
+		name string
+		id   *int
+	}{
+		{"author", ptr(authorID)},
+		{"follower", ptr(followerID)},
+		{"mentioned", ptr(mentionedID)},
+		{"stranger", ptr(strangerID)},
+		{"anonymous", nil},
+	}
+
+	// want is indexed like viewers
+	tests := []struct {
+		visibility string
+		want       [5]bool
+		shareable  bool
+	}{
+		{domain.VisibilityPublic, [5]bool{true, true, true, true, true}, true},
+		{domain.VisibilityUnlisted, [5]bool{true, true, true, true, true}, true},
+		{domain.VisibilityFollowers, [5]bool{true, true, true, false, false}, false},
+		{domain.VisibilityMentioned, [5]bool{true, false, true, false, false}, false},
+	}
+
+	for _, tt := range tests {
+		post := domain.Post{
+			ID:         10,
+			UserID:     authorID,
+			Visibility: tt.visibility,
+			Mentions:   []domain.Mention{{UserID: mentionedID}},
+		}
+
+		if got := shareable(&post); got != tt.shareable {
+			t.Errorf("shareable(%s) = %v, want %v", tt.visibility, got, tt.shareable)
+		}
+
+		for i, viewer := range viewers {
+			t.Run(tt.visibility+"/"+viewer.name, func(t *testing.T) {
+				p := &postUsecase{followRepository: &fakeFollowRepository{
+					follows: map[int][]int{followerID: {authorID}},
+				}}
+
+				visible, err := p.filterVisible(context.Background(), []domain.Post{post}, viewer.id)
+				if err != nil {
+					t.Fatalf("filterVisible: %v", err)
+				}
+				if got := len(visible) == 1; got != tt.want[i] {
+					t.Errorf("filterVisible kept = %v, want %v", got, tt.want[i])
+				}
+
+				err = p.checkVisible(context.Background(), &post, viewer.id)
+				if tt.want[i] && err != nil {
+					t.Errorf("checkVisible: %v", err)
+				}
+				if !tt.want[i] && !errors.Is(err, constants.ErrPostNotFound) {
+					t.Errorf("checkVisible = %v, want ErrPostNotFound", err)
+				}
+			})
+		}
+	}
+}
+
+func TestFilterVisibleLooksUpFollowsOnce(t *testing.T) {
+	follows := &fakeFollowRepository{follows: map[int][]int{2: {1}}}
+	p := &postUsecase{followRepository: follows}
+
+	posts := []domain.Post{
+		{ID: 1, UserID: 1, Visibility: domain.VisibilityFollowers},
+		{ID: 2, UserID: 5, Visibility: domain.VisibilityFollowers},
+		{ID: 3, UserID: 5, Visibility: domain.VisibilityPublic},
+		{ID: 4, UserID: 2, Visibility: domain.VisibilityFollowers},
+	}
+
+	visible, err := p.filterVisible(context.Background(), posts, ptr(2))
+	if err != nil {
+		t.Fatalf("filterVisible: %v", err)
+	}
+
+	ids := make([]int, len(visible))
+	for i := range visible {
+		ids[i] = visible[i].ID
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 3 || ids[2] != 4 {
+		t.Errorf("visible = %v, want [1 3 4]", ids)
+	}
+	if follows.calls != 1 {
+		t.Errorf("FilterFollowing calls = %d, want 1", follows.calls)
+	}
+}
+
+func TestValidateVisibility(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"", domain.VisibilityPublic, false},
+		{domain.VisibilityPublic, domain.VisibilityPublic, false},
+		{domain.VisibilityFollowers, domain.VisibilityFollowers, false},
+		{domain.VisibilityMentioned, domain.VisibilityMentioned, false},
+		{domain.VisibilityUnlisted, domain.VisibilityUnlisted, false},
+		{"private", "private", true},
+		{"PUBLIC", "PUBLIC", true},
+	}
+
+	for _, tt := range tests {
+		visibility := tt.in
+		err := validateVisibility(&visibility)
+		if tt.wantErr != errors.Is(err, constants.ErrInvalidVisibility) {
+			t.Errorf("validateVisibility(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+		}
+		if visibility != tt.want {
+			t.Errorf("validateVisibility(%q) = %q, want %q", tt.in, visibility, tt.want)
+		}
+	}
+}
+
+func ptr(v int) *int {
+	return &v
+}
+
+func TestQuoteVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		follows    bool
+		wantErr    error
+	}{
+		{"public", domain.VisibilityPublic, false, nil},
+		{"followers only, following", domain.VisibilityFollowers, true, constants.ErrPostNotShareable},
+		{"followers only, not following", domain.VisibilityFollowers, false, constants.ErrPostNotFound},
+		{"mentioned only, not mentioned", domain.VisibilityMentioned, true, constants.ErrPostNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			follows := map[int][]int{}
+			if tt.follows {
+				follows[2] = []int{1}
+			}
+
+			p := &postUsecase{
+				postRepository:   &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: tt.visibility}},
+				followRepository: &fakeFollowRepository{follows: follows},
+			}
+
+			err := p.preparePost(context.Background(), &domain.Post{UserID: 2, Content: "quoting", QuotePostID: ptr(10)})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("preparePost = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
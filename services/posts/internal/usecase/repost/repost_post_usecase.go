@@ -0,0 +1,38 @@
+package repost
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// Repost implements [domain.RepostUsecase]. Only public and unlisted posts
+// can be reposted, a repost would show the rest to the reposter's followers.
+// A post the user may not see is not found rather than unshareable.
+func (r *repostUsecase) Repost(ctx context.Context, repost *domain.Repost) error {
+	// the foreign key still accepts soft deleted posts
+	post, err := r.postRepository.GetByID(ctx, repost.PostID)
+	if err != nil {
+		return err
+	}
+
+	followsAuthor := false
+	if post.Visibility == domain.VisibilityFollowers && !post.Involves(repost.UserID) {
+		followed, err := r.followRepository.FilterFollowing(ctx, repost.UserID, []int{post.UserID})
+		if err != nil {
+			return err
+		}
+		followsAuthor = followed[post.UserID]
+	}
+
+	if !post.VisibleTo(&repost.UserID, followsAuthor) {
+		return constants.ErrPostNotFound
+	}
+
+	if post.Visibility != domain.VisibilityPublic && post.Visibility != domain.VisibilityUnlisted {
+		return constants.ErrPostNotShareable
+	}
+
+	return r.repostRepository.Repost(ctx, repost)
+}
@@ -0,0 +1,68 @@
+package repost
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"voidspace/posts/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type fakePostRepository struct {
+	domain.PostRepository
+	post *domain.Post
+}
+
+func (f *fakePostRepository) GetByID(ctx context.Context, postID int) (*domain.Post, error) {
+	return f.post, nil
+}
+
+type fakeRepostRepository struct {
+	domain.RepostRepository
+	reposted bool
+}
+
+func (f *fakeRepostRepository) Repost(ctx context.Context, repost *domain.Repost) error {
+	f.reposted = true
+	return nil
+}
+
+type fakeFollowRepository struct {
+	follows bool
+}
+
+func (f *fakeFollowRepository) FilterFollowing(ctx context.Context, userID int, targetUserIDs []int) (map[int]bool, error) {
+	return map[int]bool{targetUserIDs[0]: f.follows}, nil
+}
+
+func TestRepostVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		follows    bool
+		wantErr    error
+	}{
+		{"public", domain.VisibilityPublic, false, nil},
+		{"unlisted", domain.VisibilityUnlisted, false, nil},
+		{"followers only, following", domain.VisibilityFollowers, true, constants.ErrPostNotShareable},
+		{"followers only, not following", domain.VisibilityFollowers, false, constants.ErrPostNotFound},
+		{"mentioned only, not mentioned", domain.VisibilityMentioned, true, constants.ErrPostNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reposts := &fakeRepostRepository{}
+			posts := &fakePostRepository{post: &domain.Post{ID: 10, UserID: 1, Visibility: tt.visibility}}
+			usecase := NewRepostUsecase(reposts, posts, &fakeFollowRepository{follows: tt.follows}, 0)
+
+			err := usecase.Repost(context.Background(), &domain.Repost{PostID: 10, UserID: 2})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Repost = %v, want %v", err, tt.wantErr)
+			}
+			if reposts.reposted != (tt.wantErr == nil) {
+				t.Fatalf("reposted = %v", reposts.reposted)
+			}
+		})
+	}
+}
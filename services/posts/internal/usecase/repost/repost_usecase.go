@@ -0,0 +1,27 @@
+package repost
+
+import (
+	"time"
+	"voidspace/posts/internal/domain"
+)
+
+type repostUsecase struct {
+	repostRepository domain.RepostRepository
+	postRepository   domain.PostRepository
+	followRepository domain.FollowRepository
+	contextTimeout   time.Duration
+}
+
+func NewRepostUsecase(
+	repostRepository domain.RepostRepository,
+	postRepository domain.PostRepository,
+	followRepository domain.FollowRepository,
+	contextTimeout time.Duration,
+) domain.RepostUsecase {
+	return &repostUsecase{
+		repostRepository: repostRepository,
+		postRepository:   postRepository,
+		followRepository: followRepository,
+		contextTimeout:   contextTimeout,
+	}
+}
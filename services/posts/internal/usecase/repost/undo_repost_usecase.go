@@ -0,0 +1,16 @@
+package repost
+
+import (
+	"context"
+	"voidspace/posts/internal/domain"
+)
+
+// UndoRepost implements [domain.RepostUsecase].
+func (r *repostUsecase) UndoRepost(ctx context.Context, repost *domain.Repost) error {
+	err := r.repostRepository.UndoRepost(ctx, repost)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
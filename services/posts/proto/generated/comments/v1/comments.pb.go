@@ -0,0 +1,1044 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v6.32.0
+// source: comments/v1/comments.proto
+
+package commentsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCommentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PostId  int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// resolved by the gateway, offsets must point at "@username" in content
+	Mentions      []*Mention `protobuf:"bytes,3,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCommentRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type GetAllCommentsByPostIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCommentsByPostIdRequest) Reset() {
+	*x = GetAllCommentsByPostIdRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCommentsByPostIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCommentsByPostIdRequest) ProtoMessage() {}
+
+func (x *GetAllCommentsByPostIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCommentsByPostIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCommentsByPostIdRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllCommentsByPostIdRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetAllCommentsByUserIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCommentsByUserIdRequest) Reset() {
+	*x = GetAllCommentsByUserIdRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCommentsByUserIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCommentsByUserIdRequest) ProtoMessage() {}
+
+func (x *GetAllCommentsByUserIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCommentsByUserIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCommentsByUserIdRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAllCommentsByUserIdRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetFeedCommentCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostIds       []int64                `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedCommentCountRequest) Reset() {
+	*x = GetFeedCommentCountRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedCommentCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedCommentCountRequest) ProtoMessage() {}
+
+func (x *GetFeedCommentCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedCommentCountRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedCommentCountRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFeedCommentCountRequest) GetPostIds() []int64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type GetMentionedCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMentionedCommentsRequest) Reset() {
+	*x = GetMentionedCommentsRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMentionedCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMentionedCommentsRequest) ProtoMessage() {}
+
+func (x *GetMentionedCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMentionedCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetMentionedCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMentionedCommentsRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetMentionedCommentsRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type HandleAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleAccountDeletionRequest) Reset() {
+	*x = HandleAccountDeletionRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleAccountDeletionRequest) ProtoMessage() {}
+
+func (x *HandleAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*HandleAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HandleAccountDeletionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type HandleAccountRestorationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleAccountRestorationRequest) Reset() {
+	*x = HandleAccountRestorationRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleAccountRestorationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleAccountRestorationRequest) ProtoMessage() {}
+
+func (x *HandleAccountRestorationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleAccountRestorationRequest.ProtoReflect.Descriptor instead.
+func (*HandleAccountRestorationRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HandleAccountRestorationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type HandlePostDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePostDeletionRequest) Reset() {
+	*x = HandlePostDeletionRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePostDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePostDeletionRequest) ProtoMessage() {}
+
+func (x *HandlePostDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePostDeletionRequest.ProtoReflect.Descriptor instead.
+func (*HandlePostDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HandlePostDeletionRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type SearchCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCommentsRequest) Reset() {
+	*x = SearchCommentsRequest{}
+	mi := &file_comments_v1_comments_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCommentsRequest) ProtoMessage() {}
+
+func (x *SearchCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCommentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchCommentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type GetBatchCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	CommentsCount int64                  `protobuf:"varint,2,opt,name=comments_count,json=commentsCount,proto3" json:"comments_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBatchCommentsResponse) Reset() {
+	*x = GetBatchCommentsResponse{}
+	mi := &file_comments_v1_comments_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchCommentsResponse) ProtoMessage() {}
+
+func (x *GetBatchCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBatchCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetBatchCommentsResponse) GetCommentsCount() int64 {
+	if x != nil {
+		return x.CommentsCount
+	}
+	return 0
+}
+
+type GetFeedCommentCountResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PostCommentsCount []*CommentCount        `protobuf:"bytes,1,rep,name=post_comments_count,json=postCommentsCount,proto3" json:"post_comments_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetFeedCommentCountResponse) Reset() {
+	*x = GetFeedCommentCountResponse{}
+	mi := &file_comments_v1_comments_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedCommentCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedCommentCountResponse) ProtoMessage() {}
+
+func (x *GetFeedCommentCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedCommentCountResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedCommentCountResponse) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFeedCommentCountResponse) GetPostCommentsCount() []*CommentCount {
+	if x != nil {
+		return x.PostCommentsCount
+	}
+	return nil
+}
+
+type SearchCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCommentsResponse) Reset() {
+	*x = SearchCommentsResponse{}
+	mi := &file_comments_v1_comments_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCommentsResponse) ProtoMessage() {}
+
+func (x *SearchCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCommentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type GetMentionedCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMentionedCommentsResponse) Reset() {
+	*x = GetMentionedCommentsResponse{}
+	mi := &file_comments_v1_comments_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMentionedCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMentionedCommentsResponse) ProtoMessage() {}
+
+func (x *GetMentionedCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMentionedCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetMentionedCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMentionedCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetMentionedCommentsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Mentions      []*Mention             `protobuf:"bytes,6,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_comments_v1_comments_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Comment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Comment) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+// Mention is a resolved @username, start and end are byte offsets into the
+// content.
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_comments_v1_comments_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Mention) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Mention) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Mention) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type CommentCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentCount) Reset() {
+	*x = CommentCount{}
+	mi := &file_comments_v1_comments_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentCount) ProtoMessage() {}
+
+func (x *CommentCount) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_v1_comments_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentCount.ProtoReflect.Descriptor instead.
+func (*CommentCount) Descriptor() ([]byte, []int) {
+	return file_comments_v1_comments_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CommentCount) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_comments_v1_comments_proto protoreflect.FileDescriptor
+
+const file_comments_v1_comments_proto_rawDesc = "" +
+	"\n" +
+	"\x1acomments/v1/comments.proto\x12\vcomments.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"{\n" +
+	"\x14CreateCommentRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x120\n" +
+	"\bmentions\x18\x03 \x03(\v2\x14.comments.v1.MentionR\bmentions\"5\n" +
+	"\x14DeleteCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\"8\n" +
+	"\x1dGetAllCommentsByPostIdRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"8\n" +
+	"\x1dGetAllCommentsByUserIdRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"7\n" +
+	"\x1aGetFeedCommentCountRequest\x12\x19\n" +
+	"\bpost_ids\x18\x01 \x03(\x03R\apostIds\"\x9f\x01\n" +
+	"\x1bGetMentionedCommentsRequest\x12@\n" +
+	"\vcursor_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x02 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"7\n" +
+	"\x1cHandleAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\":\n" +
+	"\x1fHandleAccountRestorationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"4\n" +
+	"\x19HandlePostDeletionRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"-\n" +
+	"\x15SearchCommentsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"s\n" +
+	"\x18GetBatchCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.comments.v1.CommentR\bcomments\x12%\n" +
+	"\x0ecomments_count\x18\x02 \x01(\x03R\rcommentsCount\"h\n" +
+	"\x1bGetFeedCommentCountResponse\x12I\n" +
+	"\x13post_comments_count\x18\x01 \x03(\v2\x19.comments.v1.CommentCountR\x11postCommentsCount\"J\n" +
+	"\x16SearchCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.comments.v1.CommentR\bcomments\"k\n" +
+	"\x1cGetMentionedCommentsResponse\x120\n" +
+	"\bcomments\x18\x01 \x03(\v2\x14.comments.v1.CommentR\bcomments\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xd2\x01\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x120\n" +
+	"\bmentions\x18\x06 \x03(\v2\x14.comments.v1.MentionR\bmentions\"J\n" +
+	"\aMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\"=\n" +
+	"\fCommentCount\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count2\xc6\a\n" +
+	"\x0eCommentService\x12H\n" +
+	"\rCreateComment\x12!.comments.v1.CreateCommentRequest\x1a\x14.comments.v1.Comment\x12J\n" +
+	"\rDeleteComment\x12!.comments.v1.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\x12k\n" +
+	"\x16GetAllCommentsByPostId\x12*.comments.v1.GetAllCommentsByPostIdRequest\x1a%.comments.v1.GetBatchCommentsResponse\x12k\n" +
+	"\x16GetAllCommentsByUserId\x12*.comments.v1.GetAllCommentsByUserIdRequest\x1a%.comments.v1.GetBatchCommentsResponse\x12h\n" +
+	"\x13GetFeedCommentCount\x12'.comments.v1.GetFeedCommentCountRequest\x1a(.comments.v1.GetFeedCommentCountResponse\x12k\n" +
+	"\x14GetMentionedComments\x12(.comments.v1.GetMentionedCommentsRequest\x1a).comments.v1.GetMentionedCommentsResponse\x12Z\n" +
+	"\x15HandleAccountDeletion\x12).comments.v1.HandleAccountDeletionRequest\x1a\x16.google.protobuf.Empty\x12`\n" +
+	"\x18HandleAccountRestoration\x12,.comments.v1.HandleAccountRestorationRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x12HandlePostDeletion\x12&.comments.v1.HandlePostDeletionRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x0eSearchComments\x12\".comments.v1.SearchCommentsRequest\x1a#.comments.v1.SearchCommentsResponseB\x1aZ\x18./comments/v1;commentsv1b\x06proto3"
+
+var (
+	file_comments_v1_comments_proto_rawDescOnce sync.Once
+	file_comments_v1_comments_proto_rawDescData []byte
+)
+
+func file_comments_v1_comments_proto_rawDescGZIP() []byte {
+	file_comments_v1_comments_proto_rawDescOnce.Do(func() {
+		file_comments_v1_comments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_comments_v1_comments_proto_rawDesc), len(file_comments_v1_comments_proto_rawDesc)))
+	})
+	return file_comments_v1_comments_proto_rawDescData
+}
+
+var file_comments_v1_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_comments_v1_comments_proto_goTypes = []any{
+	(*CreateCommentRequest)(nil),            // 0: comments.v1.CreateCommentRequest
+	(*DeleteCommentRequest)(nil),            // 1: comments.v1.DeleteCommentRequest
+	(*GetAllCommentsByPostIdRequest)(nil),   // 2: comments.v1.GetAllCommentsByPostIdRequest
+	(*GetAllCommentsByUserIdRequest)(nil),   // 3: comments.v1.GetAllCommentsByUserIdRequest
+	(*GetFeedCommentCountRequest)(nil),      // 4: comments.v1.GetFeedCommentCountRequest
+	(*GetMentionedCommentsRequest)(nil),     // 5: comments.v1.GetMentionedCommentsRequest
+	(*HandleAccountDeletionRequest)(nil),    // 6: comments.v1.HandleAccountDeletionRequest
+	(*HandleAccountRestorationRequest)(nil), // 7: comments.v1.HandleAccountRestorationRequest
+	(*HandlePostDeletionRequest)(nil),       // 8: comments.v1.HandlePostDeletionRequest
+	(*SearchCommentsRequest)(nil),           // 9: comments.v1.SearchCommentsRequest
+	(*GetBatchCommentsResponse)(nil),        // 10: comments.v1.GetBatchCommentsResponse
+	(*GetFeedCommentCountResponse)(nil),     // 11: comments.v1.GetFeedCommentCountResponse
+	(*SearchCommentsResponse)(nil),          // 12: comments.v1.SearchCommentsResponse
+	(*GetMentionedCommentsResponse)(nil),    // 13: comments.v1.GetMentionedCommentsResponse
+	(*Comment)(nil),                         // 14: comments.v1.Comment
+	(*Mention)(nil),                         // 15: comments.v1.Mention
+	(*CommentCount)(nil),                    // 16: comments.v1.CommentCount
+	(*timestamppb.Timestamp)(nil),           // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 18: google.protobuf.Empty
+}
+var file_comments_v1_comments_proto_depIdxs = []int32{
+	15, // 0: comments.v1.CreateCommentRequest.mentions:type_name -> comments.v1.Mention
+	17, // 1: comments.v1.GetMentionedCommentsRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	14, // 2: comments.v1.GetBatchCommentsResponse.comments:type_name -> comments.v1.Comment
+	16, // 3: comments.v1.GetFeedCommentCountResponse.post_comments_count:type_name -> comments.v1.CommentCount
+	14, // 4: comments.v1.SearchCommentsResponse.comments:type_name -> comments.v1.Comment
+	14, // 5: comments.v1.GetMentionedCommentsResponse.comments:type_name -> comments.v1.Comment
+	17, // 6: comments.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	15, // 7: comments.v1.Comment.mentions:type_name -> comments.v1.Mention
+	0,  // 8: comments.v1.CommentService.CreateComment:input_type -> comments.v1.CreateCommentRequest
+	1,  // 9: comments.v1.CommentService.DeleteComment:input_type -> comments.v1.DeleteCommentRequest
+	2,  // 10: comments.v1.CommentService.GetAllCommentsByPostId:input_type -> comments.v1.GetAllCommentsByPostIdRequest
+	3,  // 11: comments.v1.CommentService.GetAllCommentsByUserId:input_type -> comments.v1.GetAllCommentsByUserIdRequest
+	4,  // 12: comments.v1.CommentService.GetFeedCommentCount:input_type -> comments.v1.GetFeedCommentCountRequest
+	5,  // 13: comments.v1.CommentService.GetMentionedComments:input_type -> comments.v1.GetMentionedCommentsRequest
+	6,  // 14: comments.v1.CommentService.HandleAccountDeletion:input_type -> comments.v1.HandleAccountDeletionRequest
+	7,  // 15: comments.v1.CommentService.HandleAccountRestoration:input_type -> comments.v1.HandleAccountRestorationRequest
+	8,  // 16: comments.v1.CommentService.HandlePostDeletion:input_type -> comments.v1.HandlePostDeletionRequest
+	9,  // 17: comments.v1.CommentService.SearchComments:input_type -> comments.v1.SearchCommentsRequest
+	14, // 18: comments.v1.CommentService.CreateComment:output_type -> comments.v1.Comment
+	18, // 19: comments.v1.CommentService.DeleteComment:output_type -> google.protobuf.Empty
+	10, // 20: comments.v1.CommentService.GetAllCommentsByPostId:output_type -> comments.v1.GetBatchCommentsResponse
+	10, // 21: comments.v1.CommentService.GetAllCommentsByUserId:output_type -> comments.v1.GetBatchCommentsResponse
+	11, // 22: comments.v1.CommentService.GetFeedCommentCount:output_type -> comments.v1.GetFeedCommentCountResponse
+	13, // 23: comments.v1.CommentService.GetMentionedComments:output_type -> comments.v1.GetMentionedCommentsResponse
+	18, // 24: comments.v1.CommentService.HandleAccountDeletion:output_type -> google.protobuf.Empty
+	18, // 25: comments.v1.CommentService.HandleAccountRestoration:output_type -> google.protobuf.Empty
+	18, // 26: comments.v1.CommentService.HandlePostDeletion:output_type -> google.protobuf.Empty
+	12, // 27: comments.v1.CommentService.SearchComments:output_type -> comments.v1.SearchCommentsResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_comments_v1_comments_proto_init() }
+func file_comments_v1_comments_proto_init() {
+	if File_comments_v1_comments_proto != nil {
+		return
+	}
+	file_comments_v1_comments_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comments_v1_comments_proto_rawDesc), len(file_comments_v1_comments_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_comments_v1_comments_proto_goTypes,
+		DependencyIndexes: file_comments_v1_comments_proto_depIdxs,
+		MessageInfos:      file_comments_v1_comments_proto_msgTypes,
+	}.Build()
+	File_comments_v1_comments_proto = out.File
+	file_comments_v1_comments_proto_goTypes = nil
+	file_comments_v1_comments_proto_depIdxs = nil
+}
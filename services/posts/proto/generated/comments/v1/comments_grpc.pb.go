@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: comments/v1/comments.proto
+
+package commentsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CommentService_CreateComment_FullMethodName            = "/comments.v1.CommentService/CreateComment"
+	CommentService_DeleteComment_FullMethodName            = "/comments.v1.CommentService/DeleteComment"
+	CommentService_GetAllCommentsByPostId_FullMethodName   = "/comments.v1.CommentService/GetAllCommentsByPostId"
+	CommentService_GetAllCommentsByUserId_FullMethodName   = "/comments.v1.CommentService/GetAllCommentsByUserId"
+	CommentService_GetFeedCommentCount_FullMethodName      = "/comments.v1.CommentService/GetFeedCommentCount"
+	CommentService_GetMentionedComments_FullMethodName     = "/comments.v1.CommentService/GetMentionedComments"
+	CommentService_HandleAccountDeletion_FullMethodName    = "/comments.v1.CommentService/HandleAccountDeletion"
+	CommentService_HandleAccountRestoration_FullMethodName = "/comments.v1.CommentService/HandleAccountRestoration"
+	CommentService_HandlePostDeletion_FullMethodName       = "/comments.v1.CommentService/HandlePostDeletion"
+	CommentService_SearchComments_FullMethodName           = "/comments.v1.CommentService/SearchComments"
+)
+
+// CommentServiceClient is the client API for CommentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentServiceClient interface {
+	// ---------------------- COMMENT ----------------------
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAllCommentsByPostId(ctx context.Context, in *GetAllCommentsByPostIdRequest, opts ...grpc.CallOption) (*GetBatchCommentsResponse, error)
+	GetAllCommentsByUserId(ctx context.Context, in *GetAllCommentsByUserIdRequest, opts ...grpc.CallOption) (*GetBatchCommentsResponse, error)
+	GetFeedCommentCount(ctx context.Context, in *GetFeedCommentCountRequest, opts ...grpc.CallOption) (*GetFeedCommentCountResponse, error)
+	GetMentionedComments(ctx context.Context, in *GetMentionedCommentsRequest, opts ...grpc.CallOption) (*GetMentionedCommentsResponse, error)
+	// ---------------------- ACCOUNT LIFECYCLE ----------------------
+	HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HandleAccountRestoration(ctx context.Context, in *HandleAccountRestorationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ---------------------- POST LIFECYCLE ----------------------
+	HandlePostDeletion(ctx context.Context, in *HandlePostDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (*SearchCommentsResponse, error)
+}
+
+type commentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentServiceClient(cc grpc.ClientConnInterface) CommentServiceClient {
+	return &commentServiceClient{cc}
+}
+
+func (c *commentServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetAllCommentsByPostId(ctx context.Context, in *GetAllCommentsByPostIdRequest, opts ...grpc.CallOption) (*GetBatchCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBatchCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetAllCommentsByPostId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetAllCommentsByUserId(ctx context.Context, in *GetAllCommentsByUserIdRequest, opts ...grpc.CallOption) (*GetBatchCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBatchCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetAllCommentsByUserId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetFeedCommentCount(ctx context.Context, in *GetFeedCommentCountRequest, opts ...grpc.CallOption) (*GetFeedCommentCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedCommentCountResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetFeedCommentCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetMentionedComments(ctx context.Context, in *GetMentionedCommentsRequest, opts ...grpc.CallOption) (*GetMentionedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMentionedCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetMentionedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) HandleAccountDeletion(ctx context.Context, in *HandleAccountDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_HandleAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) HandleAccountRestoration(ctx context.Context, in *HandleAccountRestorationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_HandleAccountRestoration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) HandlePostDeletion(ctx context.Context, in *HandlePostDeletionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CommentService_HandlePostDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (*SearchCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_SearchComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentServiceServer is the server API for CommentService service.
+// All implementations must embed UnimplementedCommentServiceServer
+// for forward compatibility.
+type CommentServiceServer interface {
+	// ---------------------- COMMENT ----------------------
+	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	GetAllCommentsByPostId(context.Context, *GetAllCommentsByPostIdRequest) (*GetBatchCommentsResponse, error)
+	GetAllCommentsByUserId(context.Context, *GetAllCommentsByUserIdRequest) (*GetBatchCommentsResponse, error)
+	GetFeedCommentCount(context.Context, *GetFeedCommentCountRequest) (*GetFeedCommentCountResponse, error)
+	GetMentionedComments(context.Context, *GetMentionedCommentsRequest) (*GetMentionedCommentsResponse, error)
+	// ---------------------- ACCOUNT LIFECYCLE ----------------------
+	HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error)
+	HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error)
+	// ---------------------- POST LIFECYCLE ----------------------
+	HandlePostDeletion(context.Context, *HandlePostDeletionRequest) (*emptypb.Empty, error)
+	SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error)
+	mustEmbedUnimplementedCommentServiceServer()
+}
+
+// UnimplementedCommentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommentServiceServer struct{}
+
+func (UnimplementedCommentServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedCommentServiceServer) GetAllCommentsByPostId(context.Context, *GetAllCommentsByPostIdRequest) (*GetBatchCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommentsByPostId not implemented")
+}
+func (UnimplementedCommentServiceServer) GetAllCommentsByUserId(context.Context, *GetAllCommentsByUserIdRequest) (*GetBatchCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommentsByUserId not implemented")
+}
+func (UnimplementedCommentServiceServer) GetFeedCommentCount(context.Context, *GetFeedCommentCountRequest) (*GetFeedCommentCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedCommentCount not implemented")
+}
+func (UnimplementedCommentServiceServer) GetMentionedComments(context.Context, *GetMentionedCommentsRequest) (*GetMentionedCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentionedComments not implemented")
+}
+func (UnimplementedCommentServiceServer) HandleAccountDeletion(context.Context, *HandleAccountDeletionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountDeletion not implemented")
+}
+func (UnimplementedCommentServiceServer) HandleAccountRestoration(context.Context, *HandleAccountRestorationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleAccountRestoration not implemented")
+}
+func (UnimplementedCommentServiceServer) HandlePostDeletion(context.Context, *HandlePostDeletionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePostDeletion not implemented")
+}
+func (UnimplementedCommentServiceServer) SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchComments not implemented")
+}
+func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
+func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentServiceServer will
+// result in compilation errors.
+type UnsafeCommentServiceServer interface {
+	mustEmbedUnimplementedCommentServiceServer()
+}
+
+func RegisterCommentServiceServer(s grpc.ServiceRegistrar, srv CommentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommentService_ServiceDesc, srv)
+}
+
+func _CommentService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetAllCommentsByPostId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCommentsByPostIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetAllCommentsByPostId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetAllCommentsByPostId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetAllCommentsByPostId(ctx, req.(*GetAllCommentsByPostIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetAllCommentsByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCommentsByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetAllCommentsByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetAllCommentsByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetAllCommentsByUserId(ctx, req.(*GetAllCommentsByUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetFeedCommentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedCommentCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetFeedCommentCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetFeedCommentCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetFeedCommentCount(ctx, req.(*GetFeedCommentCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetMentionedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMentionedCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetMentionedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetMentionedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetMentionedComments(ctx, req.(*GetMentionedCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_HandleAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).HandleAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_HandleAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).HandleAccountDeletion(ctx, req.(*HandleAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_HandleAccountRestoration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleAccountRestorationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).HandleAccountRestoration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_HandleAccountRestoration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).HandleAccountRestoration(ctx, req.(*HandleAccountRestorationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_HandlePostDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlePostDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).HandlePostDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_HandlePostDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).HandlePostDeletion(ctx, req.(*HandlePostDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_SearchComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).SearchComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_SearchComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).SearchComments(ctx, req.(*SearchCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comments.v1.CommentService",
+	HandlerType: (*CommentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateComment",
+			Handler:    _CommentService_CreateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _CommentService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetAllCommentsByPostId",
+			Handler:    _CommentService_GetAllCommentsByPostId_Handler,
+		},
+		{
+			MethodName: "GetAllCommentsByUserId",
+			Handler:    _CommentService_GetAllCommentsByUserId_Handler,
+		},
+		{
+			MethodName: "GetFeedCommentCount",
+			Handler:    _CommentService_GetFeedCommentCount_Handler,
+		},
+		{
+			MethodName: "GetMentionedComments",
+			Handler:    _CommentService_GetMentionedComments_Handler,
+		},
+		{
+			MethodName: "HandleAccountDeletion",
+			Handler:    _CommentService_HandleAccountDeletion_Handler,
+		},
+		{
+			MethodName: "HandleAccountRestoration",
+			Handler:    _CommentService_HandleAccountRestoration_Handler,
+		},
+		{
+			MethodName: "HandlePostDeletion",
+			Handler:    _CommentService_HandlePostDeletion_Handler,
+		},
+		{
+			MethodName: "SearchComments",
+			Handler:    _CommentService_SearchComments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comments/v1/comments.proto",
+}
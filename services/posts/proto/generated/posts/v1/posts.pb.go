@@ -24,11 +24,22 @@ const (
 )
 
 type CreatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Images        []*PostImage           `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Content     string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Images      []*PostImage           `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	ReplyTo     *int64                 `protobuf:"varint,3,opt,name=reply_to,json=replyTo,proto3,oneof" json:"reply_to,omitempty"`
+	QuotePostId *int64                 `protobuf:"varint,4,opt,name=quote_post_id,json=quotePostId,proto3,oneof" json:"quote_post_id,omitempty"`
+	// resolved by the gateway, offsets must point at "@username" in content
+	Mentions []*Mention `protobuf:"bytes,5,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// when set the post is scheduled instead of published
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	Poll      *PollInput             `protobuf:"bytes,7,opt,name=poll,proto3,oneof" json:"poll,omitempty"`
+	// public, followers, mentioned or unlisted, empty means public
+	Visibility string `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// shown in place of the content until the viewer expands the post
+	ContentWarning *string `protobuf:"bytes,9,opt,name=content_warning,json=contentWarning,proto3,oneof" json:"content_warning,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreatePostRequest) Reset() {
@@ -75,6 +86,116 @@ func (x *CreatePostRequest) GetImages() []*PostImage {
 	return nil
 }
 
+func (x *CreatePostRequest) GetReplyTo() int64 {
+	if x != nil && x.ReplyTo != nil {
+		return *x.ReplyTo
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetQuotePostId() int64 {
+	if x != nil && x.QuotePostId != nil {
+		return *x.QuotePostId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetPoll() *PollInput {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContentWarning() string {
+	if x != nil && x.ContentWarning != nil {
+		return *x.ContentWarning
+	}
+	return ""
+}
+
+// PollInput is the poll attached to a new post, 2 to 4 options
+type PollInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Options        []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MultipleChoice bool                   `protobuf:"varint,3,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PollInput) Reset() {
+	*x = PollInput{}
+	mi := &file_posts_v1_posts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollInput) ProtoMessage() {}
+
+func (x *PollInput) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollInput.ProtoReflect.Descriptor instead.
+func (*PollInput) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PollInput) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollInput) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PollInput) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
 type GetPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -84,7 +205,7 @@ type GetPostRequest struct {
 
 func (x *GetPostRequest) Reset() {
 	*x = GetPostRequest{}
-	mi := &file_posts_v1_posts_proto_msgTypes[1]
+	mi := &file_posts_v1_posts_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +217,7 @@ func (x *GetPostRequest) String() string {
 func (*GetPostRequest) ProtoMessage() {}
 
 func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[1]
+	mi := &file_posts_v1_posts_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +230,7 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
 func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{1}
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetPostRequest) GetPostId() int64 {
@@ -124,13 +245,14 @@ type UpdatePostRequest struct {
 	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Images        []*PostImage           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Mentions      []*Mention             `protobuf:"bytes,4,rep,name=mentions,proto3" json:"mentions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePostRequest) Reset() {
 	*x = UpdatePostRequest{}
-	mi := &file_posts_v1_posts_proto_msgTypes[2]
+	mi := &file_posts_v1_posts_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -142,7 +264,7 @@ func (x *UpdatePostRequest) String() string {
 func (*UpdatePostRequest) ProtoMessage() {}
 
 func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[2]
+	mi := &file_posts_v1_posts_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +277,7 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{2}
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdatePostRequest) GetPostId() int64 {
@@ -179,6 +301,13 @@ func (x *UpdatePostRequest) GetImages() []*PostImage {
 	return nil
 }
 
+func (x *UpdatePostRequest) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
 type DeletePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -188,7 +317,7 @@ type DeletePostRequest struct {
 
 func (x *DeletePostRequest) Reset() {
 	*x = DeletePostRequest{}
-	mi := &file_posts_v1_posts_proto_msgTypes[3]
+	mi := &file_posts_v1_posts_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -200,7 +329,7 @@ func (x *DeletePostRequest) String() string {
 func (*DeletePostRequest) ProtoMessage() {}
 
 func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[3]
+	mi := &file_posts_v1_posts_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +342,7 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
 func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{3}
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DeletePostRequest) GetPostId() int64 {
@@ -223,28 +352,33 @@ func (x *DeletePostRequest) GetPostId() int64 {
 	return 0
 }
 
-type GetUserPostsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type UpdateScheduledPostRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledPostId int64                  `protobuf:"varint,1,opt,name=scheduled_post_id,json=scheduledPostId,proto3" json:"scheduled_post_id,omitempty"`
+	Content         string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images          []*PostImage           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Mentions        []*Mention             `protobuf:"bytes,4,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// keeps the current publish time when unset
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPostsRequest) Reset() {
-	*x = GetUserPostsRequest{}
-	mi := &file_posts_v1_posts_proto_msgTypes[4]
+func (x *UpdateScheduledPostRequest) Reset() {
+	*x = UpdateScheduledPostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPostsRequest) String() string {
+func (x *UpdateScheduledPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPostsRequest) ProtoMessage() {}
+func (*UpdateScheduledPostRequest) ProtoMessage() {}
 
-func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[4]
+func (x *UpdateScheduledPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,93 +389,67 @@ func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateScheduledPostRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetUserPostsRequest) GetUserId() int64 {
+func (x *UpdateScheduledPostRequest) GetScheduledPostId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.ScheduledPostId
 	}
 	return 0
 }
 
-type GetGlobalFeedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
-	CursorId      *int64                 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetGlobalFeedRequest) Reset() {
-	*x = GetGlobalFeedRequest{}
-	mi := &file_posts_v1_posts_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetGlobalFeedRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGlobalFeedRequest) ProtoMessage() {}
-
-func (x *GetGlobalFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[5]
+func (x *UpdateScheduledPostRequest) GetContent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Content
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetGlobalFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetGlobalFeedRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{5}
+func (x *UpdateScheduledPostRequest) GetImages() []*PostImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
 }
 
-func (x *GetGlobalFeedRequest) GetCursorTime() *timestamppb.Timestamp {
+func (x *UpdateScheduledPostRequest) GetMentions() []*Mention {
 	if x != nil {
-		return x.CursorTime
+		return x.Mentions
 	}
 	return nil
 }
 
-func (x *GetGlobalFeedRequest) GetCursorId() int64 {
-	if x != nil && x.CursorId != nil {
-		return *x.CursorId
+func (x *UpdateScheduledPostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
 	}
-	return 0
+	return nil
 }
 
-type GetFollowingFeedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
-	CursorId      *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ScheduledPostRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledPostId int64                  `protobuf:"varint,1,opt,name=scheduled_post_id,json=scheduledPostId,proto3" json:"scheduled_post_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetFollowingFeedRequest) Reset() {
-	*x = GetFollowingFeedRequest{}
+func (x *ScheduledPostRequest) Reset() {
+	*x = ScheduledPostRequest{}
 	mi := &file_posts_v1_posts_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingFeedRequest) String() string {
+func (x *ScheduledPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingFeedRequest) ProtoMessage() {}
+func (*ScheduledPostRequest) ProtoMessage() {}
 
-func (x *GetFollowingFeedRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduledPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_v1_posts_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,53 +461,48 @@ func (x *GetFollowingFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowingFeedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduledPostRequest.ProtoReflect.Descriptor instead.
+func (*ScheduledPostRequest) Descriptor() ([]byte, []int) {
 	return file_posts_v1_posts_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetFollowingFeedRequest) GetUserIds() []int64 {
-	if x != nil {
-		return x.UserIds
-	}
-	return nil
-}
-
-func (x *GetFollowingFeedRequest) GetCursorTime() *timestamppb.Timestamp {
+func (x *ScheduledPostRequest) GetScheduledPostId() int64 {
 	if x != nil {
-		return x.CursorTime
-	}
-	return nil
-}
-
-func (x *GetFollowingFeedRequest) GetCursorId() int64 {
-	if x != nil && x.CursorId != nil {
-		return *x.CursorId
+		return x.ScheduledPostId
 	}
 	return 0
 }
 
-type LikePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SaveDraftRequest creates a draft, or replaces draft_id when set
+type SaveDraftRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	DraftId *int64                 `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3,oneof" json:"draft_id,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images  []*PostImage           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	ReplyTo *int64                 `protobuf:"varint,4,opt,name=reply_to,json=replyTo,proto3,oneof" json:"reply_to,omitempty"`
+	// resolved by the gateway, used when the draft is published
+	Mentions   []*Mention `protobuf:"bytes,5,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Visibility string     `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// applied to the post when the draft is published
+	ContentWarning *string `protobuf:"bytes,7,opt,name=content_warning,json=contentWarning,proto3,oneof" json:"content_warning,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
 	mi := &file_posts_v1_posts_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostRequest) String() string {
+func (x *SaveDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+func (*SaveDraftRequest) ProtoMessage() {}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_v1_posts_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,39 +514,81 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
 	return file_posts_v1_posts_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LikePostRequest) GetPostId() int64 {
+func (x *SaveDraftRequest) GetDraftId() int64 {
+	if x != nil && x.DraftId != nil {
+		return *x.DraftId
+	}
+	return 0
+}
+
+func (x *SaveDraftRequest) GetContent() string {
 	if x != nil {
-		return x.PostId
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SaveDraftRequest) GetImages() []*PostImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *SaveDraftRequest) GetReplyTo() int64 {
+	if x != nil && x.ReplyTo != nil {
+		return *x.ReplyTo
 	}
 	return 0
 }
 
-type UnlikePostRequest struct {
+func (x *SaveDraftRequest) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *SaveDraftRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *SaveDraftRequest) GetContentWarning() string {
+	if x != nil && x.ContentWarning != nil {
+		return *x.ContentWarning
+	}
+	return ""
+}
+
+type DraftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	DraftId       int64                  `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikePostRequest) Reset() {
-	*x = UnlikePostRequest{}
+func (x *DraftRequest) Reset() {
+	*x = DraftRequest{}
 	mi := &file_posts_v1_posts_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikePostRequest) String() string {
+func (x *DraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikePostRequest) ProtoMessage() {}
+func (*DraftRequest) ProtoMessage() {}
 
-func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
+func (x *DraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_v1_posts_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -455,39 +600,46 @@ func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
-func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DraftRequest.ProtoReflect.Descriptor instead.
+func (*DraftRequest) Descriptor() ([]byte, []int) {
 	return file_posts_v1_posts_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UnlikePostRequest) GetPostId() int64 {
+func (x *DraftRequest) GetDraftId() int64 {
 	if x != nil {
-		return x.PostId
+		return x.DraftId
 	}
 	return 0
 }
 
-type HandleAccountDeletionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Draft struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images         []*PostImage           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	ReplyToPostId  *int64                 `protobuf:"varint,4,opt,name=reply_to_post_id,json=replyToPostId,proto3,oneof" json:"reply_to_post_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Visibility     string                 `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	ContentWarning *string                `protobuf:"bytes,8,opt,name=content_warning,json=contentWarning,proto3,oneof" json:"content_warning,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *HandleAccountDeletionRequest) Reset() {
-	*x = HandleAccountDeletionRequest{}
+func (x *Draft) Reset() {
+	*x = Draft{}
 	mi := &file_posts_v1_posts_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleAccountDeletionRequest) String() string {
+func (x *Draft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleAccountDeletionRequest) ProtoMessage() {}
+func (*Draft) ProtoMessage() {}
 
-func (x *HandleAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+func (x *Draft) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_v1_posts_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,39 +651,89 @@ func (x *HandleAccountDeletionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleAccountDeletionRequest.ProtoReflect.Descriptor instead.
-func (*HandleAccountDeletionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
 	return file_posts_v1_posts_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *HandleAccountDeletionRequest) GetUserId() int64 {
+func (x *Draft) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-type HandleAccountRestorationRequest struct {
+func (x *Draft) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Draft) GetImages() []*PostImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Draft) GetReplyToPostId() int64 {
+	if x != nil && x.ReplyToPostId != nil {
+		return *x.ReplyToPostId
+	}
+	return 0
+}
+
+func (x *Draft) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Draft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Draft) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Draft) GetContentWarning() string {
+	if x != nil && x.ContentWarning != nil {
+		return *x.ContentWarning
+	}
+	return ""
+}
+
+// drafts are most recently updated first
+type ListDraftsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Drafts        []*Draft               `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandleAccountRestorationRequest) Reset() {
-	*x = HandleAccountRestorationRequest{}
+func (x *ListDraftsResponse) Reset() {
+	*x = ListDraftsResponse{}
 	mi := &file_posts_v1_posts_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleAccountRestorationRequest) String() string {
+func (x *ListDraftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleAccountRestorationRequest) ProtoMessage() {}
+func (*ListDraftsResponse) ProtoMessage() {}
 
-func (x *HandleAccountRestorationRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDraftsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_v1_posts_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,40 +745,3131 @@ func (x *HandleAccountRestorationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleAccountRestorationRequest.ProtoReflect.Descriptor instead.
-func (*HandleAccountRestorationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDraftsResponse.ProtoReflect.Descriptor instead.
+func (*ListDraftsResponse) Descriptor() ([]byte, []int) {
 	return file_posts_v1_posts_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *HandleAccountRestorationRequest) GetUserId() int64 {
+func (x *ListDraftsResponse) GetDrafts() []*Draft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+// VotePollRequest takes exactly one option unless the poll is multiple choice
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OptionIds     []int64                `protobuf:"varint,2,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VotePollRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type ClosePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePollRequest) Reset() {
+	*x = ClosePollRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePollRequest) ProtoMessage() {}
+
+func (x *ClosePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePollRequest.ProtoReflect.Descriptor instead.
+func (*ClosePollRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClosePollRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// voters are ordered by user id, cursor_id is the last voter of the previous
+// page
+type ListPollVotersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollVotersRequest) Reset() {
+	*x = ListPollVotersRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollVotersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollVotersRequest) ProtoMessage() {}
+
+func (x *ListPollVotersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollVotersRequest.ProtoReflect.Descriptor instead.
+func (*ListPollVotersRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPollVotersRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListPollVotersRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type ListPollVotersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoterIds      []int64                `protobuf:"varint,1,rep,packed,name=voter_ids,json=voterIds,proto3" json:"voter_ids,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollVotersResponse) Reset() {
+	*x = ListPollVotersResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollVotersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollVotersResponse) ProtoMessage() {}
+
+func (x *ListPollVotersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollVotersResponse.ProtoReflect.Descriptor instead.
+func (*ListPollVotersResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPollVotersResponse) GetVoterIds() []int64 {
+	if x != nil {
+		return x.VoterIds
+	}
+	return nil
+}
+
+func (x *ListPollVotersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// AddBookmarkRequest bookmarks post_id into folder, bookmarking a post again
+// moves it to the new folder. Unset folder means unfiled.
+type AddBookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Folder        *string                `protobuf:"bytes,2,opt,name=folder,proto3,oneof" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookmarkRequest) Reset() {
+	*x = AddBookmarkRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkRequest) ProtoMessage() {}
+
+func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*AddBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddBookmarkRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *AddBookmarkRequest) GetFolder() string {
+	if x != nil && x.Folder != nil {
+		return *x.Folder
+	}
+	return ""
+}
+
+type BookmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookmarkRequest) Reset() {
+	*x = BookmarkRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkRequest) ProtoMessage() {}
+
+func (x *BookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkRequest.ProtoReflect.Descriptor instead.
+func (*BookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BookmarkRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// ListBookmarksRequest lists every bookmark when folder is unset, most
+// recently bookmarked first
+type ListBookmarksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folder        *string                `protobuf:"bytes,1,opt,name=folder,proto3,oneof" json:"folder,omitempty"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarksRequest) Reset() {
+	*x = ListBookmarksRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarksRequest) ProtoMessage() {}
+
+func (x *ListBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListBookmarksRequest) GetFolder() string {
+	if x != nil && x.Folder != nil {
+		return *x.Folder
+	}
+	return ""
+}
+
+func (x *ListBookmarksRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *ListBookmarksRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type BookmarkFolder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BookmarksCount int64                  `protobuf:"varint,2,opt,name=bookmarks_count,json=bookmarksCount,proto3" json:"bookmarks_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookmarkFolder) Reset() {
+	*x = BookmarkFolder{}
+	mi := &file_posts_v1_posts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookmarkFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookmarkFolder) ProtoMessage() {}
+
+func (x *BookmarkFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookmarkFolder.ProtoReflect.Descriptor instead.
+func (*BookmarkFolder) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BookmarkFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BookmarkFolder) GetBookmarksCount() int64 {
+	if x != nil {
+		return x.BookmarksCount
+	}
+	return 0
+}
+
+type ListBookmarkFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*BookmarkFolder      `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookmarkFoldersResponse) Reset() {
+	*x = ListBookmarkFoldersResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookmarkFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookmarkFoldersResponse) ProtoMessage() {}
+
+func (x *ListBookmarkFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookmarkFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListBookmarkFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListBookmarkFoldersResponse) GetFolders() []*BookmarkFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type GetPostRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRevisionsRequest) Reset() {
+	*x = GetPostRevisionsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPostRevisionsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+// PostRevision is a previous version of a post, version 1 is the original
+// and created_at is when that version was written.
+type PostRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []*PostImage           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Entities      []*Entity              `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_posts_v1_posts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PostRevision) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostRevision) GetImages() []*PostImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *PostRevision) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *PostRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// revisions are newest first and never include the current version, the
+// post's current content warning covers them too
+type GetPostRevisionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Revisions      []*PostRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	ContentWarning *string                `protobuf:"bytes,2,opt,name=content_warning,json=contentWarning,proto3,oneof" json:"content_warning,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPostRevisionsResponse) Reset() {
+	*x = GetPostRevisionsResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsResponse) ProtoMessage() {}
+
+func (x *GetPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPostRevisionsResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetPostRevisionsResponse) GetContentWarning() string {
+	if x != nil && x.ContentWarning != nil {
+		return *x.ContentWarning
+	}
+	return ""
+}
+
+// cursor pages over the direct replies of post_id, oldest first
+type GetThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetThreadRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetThreadRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type GetUserPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPostsRequest) Reset() {
+	*x = GetUserPostsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsRequest) ProtoMessage() {}
+
+func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserPostsRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetUserPostsRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type GetGlobalFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGlobalFeedRequest) Reset() {
+	*x = GetGlobalFeedRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGlobalFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGlobalFeedRequest) ProtoMessage() {}
+
+func (x *GetGlobalFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGlobalFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetGlobalFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetGlobalFeedRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetGlobalFeedRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type GetHashtagFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHashtagFeedRequest) Reset() {
+	*x = GetHashtagFeedRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHashtagFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagFeedRequest) ProtoMessage() {}
+
+func (x *GetHashtagFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetHashtagFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetHashtagFeedRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetHashtagFeedRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetHashtagFeedRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type GetMentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMentionsRequest) Reset() {
+	*x = GetMentionsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMentionsRequest) ProtoMessage() {}
+
+func (x *GetMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMentionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMentionsRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetMentionsRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type GetTrendingHashtagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingHashtagsRequest) Reset() {
+	*x = GetTrendingHashtagsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsRequest) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTrendingHashtagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingHashtag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Uses          int64                  `protobuf:"varint,2,opt,name=uses,proto3" json:"uses,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingHashtag) Reset() {
+	*x = TrendingHashtag{}
+	mi := &file_posts_v1_posts_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingHashtag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingHashtag) ProtoMessage() {}
+
+func (x *TrendingHashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingHashtag.ProtoReflect.Descriptor instead.
+func (*TrendingHashtag) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TrendingHashtag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TrendingHashtag) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *TrendingHashtag) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetTrendingHashtagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtags      []*TrendingHashtag     `protobuf:"bytes,1,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingHashtagsResponse) Reset() {
+	*x = GetTrendingHashtagsResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsResponse) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTrendingHashtagsResponse) GetHashtags() []*TrendingHashtag {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+func (x *GetTrendingHashtagsResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetFollowingFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingFeedRequest) Reset() {
+	*x = GetFollowingFeedRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingFeedRequest) ProtoMessage() {}
+
+func (x *GetFollowingFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetFollowingFeedRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetFollowingFeedRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetFollowingFeedRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+// GetForYouFeedRequest reads the first page and ranks a new snapshot when
+// snapshot_id is unset.
+type GetForYouFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    *int64                 `protobuf:"varint,2,opt,name=snapshot_id,json=snapshotId,proto3,oneof" json:"snapshot_id,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForYouFeedRequest) Reset() {
+	*x = GetForYouFeedRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForYouFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForYouFeedRequest) ProtoMessage() {}
+
+func (x *GetForYouFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForYouFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetForYouFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetForYouFeedRequest) GetSnapshotId() int64 {
+	if x != nil && x.SnapshotId != nil {
+		return *x.SnapshotId
+	}
+	return 0
+}
+
+func (x *GetForYouFeedRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetHomeTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CursorTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeTimelineRequest) Reset() {
+	*x = GetHomeTimelineRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeTimelineRequest) ProtoMessage() {}
+
+func (x *GetHomeTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetHomeTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetHomeTimelineRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *GetHomeTimelineRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+// FanOutPostRequest adds the post to the timelines of user_ids, as a repost
+// when reposted_by is set.
+type FanOutPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	RepostedBy    *int64                 `protobuf:"varint,2,opt,name=reposted_by,json=repostedBy,proto3,oneof" json:"reposted_by,omitempty"`
+	UserIds       []int64                `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutPostRequest) Reset() {
+	*x = FanOutPostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutPostRequest) ProtoMessage() {}
+
+func (x *FanOutPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutPostRequest.ProtoReflect.Descriptor instead.
+func (*FanOutPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FanOutPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *FanOutPostRequest) GetRepostedBy() int64 {
+	if x != nil && x.RepostedBy != nil {
+		return *x.RepostedBy
+	}
+	return 0
+}
+
+func (x *FanOutPostRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// BackfillTimelineRequest adds the recent posts and reposts of author_ids to
+// the timeline of user_id, mark_built is set on the last batch of a rebuild.
+type BackfillTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AuthorIds     []int64                `protobuf:"varint,2,rep,packed,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	MarkBuilt     bool                   `protobuf:"varint,3,opt,name=mark_built,json=markBuilt,proto3" json:"mark_built,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillTimelineRequest) Reset() {
+	*x = BackfillTimelineRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillTimelineRequest) ProtoMessage() {}
+
+func (x *BackfillTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillTimelineRequest.ProtoReflect.Descriptor instead.
+func (*BackfillTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BackfillTimelineRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BackfillTimelineRequest) GetAuthorIds() []int64 {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+func (x *BackfillTimelineRequest) GetMarkBuilt() bool {
+	if x != nil {
+		return x.MarkBuilt
+	}
+	return false
+}
+
+// ClearTimelineSourceRequest drops what source_id brought into the timeline
+// of user_id, on unfollow.
+type ClearTimelineSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceId      int64                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearTimelineSourceRequest) Reset() {
+	*x = ClearTimelineSourceRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearTimelineSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearTimelineSourceRequest) ProtoMessage() {}
+
+func (x *ClearTimelineSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearTimelineSourceRequest.ProtoReflect.Descriptor instead.
+func (*ClearTimelineSourceRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClearTimelineSourceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClearTimelineSourceRequest) GetSourceId() int64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+// SetTimelineCelebrityRequest switches user_id between fan-out on write and
+// being pulled into timelines at read time.
+type SetTimelineCelebrityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Celebrity     bool                   `protobuf:"varint,2,opt,name=celebrity,proto3" json:"celebrity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimelineCelebrityRequest) Reset() {
+	*x = SetTimelineCelebrityRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimelineCelebrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimelineCelebrityRequest) ProtoMessage() {}
+
+func (x *SetTimelineCelebrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimelineCelebrityRequest.ProtoReflect.Descriptor instead.
+func (*SetTimelineCelebrityRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetTimelineCelebrityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetTimelineCelebrityRequest) GetCelebrity() bool {
+	if x != nil {
+		return x.Celebrity
+	}
+	return false
+}
+
+type LikePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRequest) ProtoMessage() {}
+
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LikePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnlikePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikePostRequest) Reset() {
+	*x = UnlikePostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikePostRequest) ProtoMessage() {}
+
+func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
+func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnlikePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetLinkPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLinkPreviewRequest) Reset() {
+	*x = GetLinkPreviewRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLinkPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkPreviewRequest) ProtoMessage() {}
+
+func (x *GetLinkPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetLinkPreviewRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SetLinkPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Preview       *LinkPreview           `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLinkPreviewRequest) Reset() {
+	*x = SetLinkPreviewRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLinkPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLinkPreviewRequest) ProtoMessage() {}
+
+func (x *SetLinkPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLinkPreviewRequest.ProtoReflect.Descriptor instead.
+func (*SetLinkPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetLinkPreviewRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetLinkPreviewRequest) GetPreview() *LinkPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+// SetContentFlagsRequest leaves the warning as it is when content_warning is
+// unset, an empty one removes it. Images not listed keep their flag.
+type SetContentFlagsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PostId         int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentWarning *string                `protobuf:"bytes,2,opt,name=content_warning,json=contentWarning,proto3,oneof" json:"content_warning,omitempty"`
+	Images         []*ImageFlag           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetContentFlagsRequest) Reset() {
+	*x = SetContentFlagsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContentFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContentFlagsRequest) ProtoMessage() {}
+
+func (x *SetContentFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContentFlagsRequest.ProtoReflect.Descriptor instead.
+func (*SetContentFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetContentFlagsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetContentFlagsRequest) GetContentWarning() string {
+	if x != nil && x.ContentWarning != nil {
+		return *x.ContentWarning
+	}
+	return ""
+}
+
+func (x *SetContentFlagsRequest) GetImages() []*ImageFlag {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type ImageFlag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the order of the image on the post
+	Order         int64 `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	Sensitive     bool  `protobuf:"varint,2,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageFlag) Reset() {
+	*x = ImageFlag{}
+	mi := &file_posts_v1_posts_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageFlag) ProtoMessage() {}
+
+func (x *ImageFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageFlag.ProtoReflect.Descriptor instead.
+func (*ImageFlag) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImageFlag) GetOrder() int64 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *ImageFlag) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+type ReactToPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reaction      string                 `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactToPostRequest) Reset() {
+	*x = ReactToPostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactToPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactToPostRequest) ProtoMessage() {}
+
+func (x *ReactToPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactToPostRequest.ProtoReflect.Descriptor instead.
+func (*ReactToPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReactToPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReactToPostRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveReactionRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type ListReactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// display order, the default reaction comes first
+	Reactions       []string `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	DefaultReaction string   `protobuf:"bytes,2,opt,name=default_reaction,json=defaultReaction,proto3" json:"default_reaction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListReactionsResponse) Reset() {
+	*x = ListReactionsResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionsResponse) ProtoMessage() {}
+
+func (x *ListReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListReactionsResponse) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListReactionsResponse) GetDefaultReaction() string {
+	if x != nil {
+		return x.DefaultReaction
+	}
+	return ""
+}
+
+type ListPostLikersRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PostId     int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CursorTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cursor_time,json=cursorTime,proto3,oneof" json:"cursor_time,omitempty"`
+	CursorId   *int64                 `protobuf:"varint,3,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	// which of the two runs the cursor is in
+	CursorFollowed *bool `protobuf:"varint,4,opt,name=cursor_followed,json=cursorFollowed,proto3,oneof" json:"cursor_followed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPostLikersRequest) Reset() {
+	*x = ListPostLikersRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostLikersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostLikersRequest) ProtoMessage() {}
+
+func (x *ListPostLikersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostLikersRequest.ProtoReflect.Descriptor instead.
+func (*ListPostLikersRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListPostLikersRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListPostLikersRequest) GetCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CursorTime
+	}
+	return nil
+}
+
+func (x *ListPostLikersRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+func (x *ListPostLikersRequest) GetCursorFollowed() bool {
+	if x != nil && x.CursorFollowed != nil {
+		return *x.CursorFollowed
+	}
+	return false
+}
+
+type PostLiker struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LikedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"`
+	IsFollowed    bool                   `protobuf:"varint,3,opt,name=is_followed,json=isFollowed,proto3" json:"is_followed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLiker) Reset() {
+	*x = PostLiker{}
+	mi := &file_posts_v1_posts_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLiker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLiker) ProtoMessage() {}
+
+func (x *PostLiker) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLiker.ProtoReflect.Descriptor instead.
+func (*PostLiker) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PostLiker) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PostLiker) GetLikedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LikedAt
+	}
+	return nil
+}
+
+func (x *PostLiker) GetIsFollowed() bool {
+	if x != nil {
+		return x.IsFollowed
+	}
+	return false
+}
+
+type ListPostLikersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Likers             []*PostLiker           `protobuf:"bytes,1,rep,name=likers,proto3" json:"likers,omitempty"`
+	HasMore            bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursorTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_cursor_time,json=nextCursorTime,proto3,oneof" json:"next_cursor_time,omitempty"`
+	NextCursorId       *int64                 `protobuf:"varint,4,opt,name=next_cursor_id,json=nextCursorId,proto3,oneof" json:"next_cursor_id,omitempty"`
+	NextCursorFollowed *bool                  `protobuf:"varint,5,opt,name=next_cursor_followed,json=nextCursorFollowed,proto3,oneof" json:"next_cursor_followed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListPostLikersResponse) Reset() {
+	*x = ListPostLikersResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostLikersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostLikersResponse) ProtoMessage() {}
+
+func (x *ListPostLikersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostLikersResponse.ProtoReflect.Descriptor instead.
+func (*ListPostLikersResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListPostLikersResponse) GetLikers() []*PostLiker {
+	if x != nil {
+		return x.Likers
+	}
+	return nil
+}
+
+func (x *ListPostLikersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListPostLikersResponse) GetNextCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCursorTime
+	}
+	return nil
+}
+
+func (x *ListPostLikersResponse) GetNextCursorId() int64 {
+	if x != nil && x.NextCursorId != nil {
+		return *x.NextCursorId
+	}
+	return 0
+}
+
+func (x *ListPostLikersResponse) GetNextCursorFollowed() bool {
+	if x != nil && x.NextCursorFollowed != nil {
+		return *x.NextCursorFollowed
+	}
+	return false
+}
+
+type ReconcileLikesCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FixedPosts    int64                  `protobuf:"varint,1,opt,name=fixed_posts,json=fixedPosts,proto3" json:"fixed_posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileLikesCountResponse) Reset() {
+	*x = ReconcileLikesCountResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileLikesCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileLikesCountResponse) ProtoMessage() {}
+
+func (x *ReconcileLikesCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileLikesCountResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileLikesCountResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReconcileLikesCountResponse) GetFixedPosts() int64 {
+	if x != nil {
+		return x.FixedPosts
+	}
+	return 0
+}
+
+type RepostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepostRequest) Reset() {
+	*x = RepostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepostRequest) ProtoMessage() {}
+
+func (x *RepostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepostRequest.ProtoReflect.Descriptor instead.
+func (*RepostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RepostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PinPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type HandleAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleAccountDeletionRequest) Reset() {
+	*x = HandleAccountDeletionRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleAccountDeletionRequest) ProtoMessage() {}
+
+func (x *HandleAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*HandleAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *HandleAccountDeletionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type HandleAccountRestorationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleAccountRestorationRequest) Reset() {
+	*x = HandleAccountRestorationRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleAccountRestorationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleAccountRestorationRequest) ProtoMessage() {}
+
+func (x *HandleAccountRestorationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleAccountRestorationRequest.ProtoReflect.Descriptor instead.
+func (*HandleAccountRestorationRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HandleAccountRestorationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_posts_v1_posts_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type GetPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsResponse) ProtoMessage() {}
+
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetFeedResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Posts   []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	HasMore bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// reposts sort by repost time, so the cursor is not always the last post's
+	// created_at
+	NextCursorTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_cursor_time,json=nextCursorTime,proto3,oneof" json:"next_cursor_time,omitempty"`
+	NextCursorId   *int64                 `protobuf:"varint,4,opt,name=next_cursor_id,json=nextCursorId,proto3,oneof" json:"next_cursor_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFeedResponse) Reset() {
+	*x = GetFeedResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedResponse) ProtoMessage() {}
+
+func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetFeedResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetFeedResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetFeedResponse) GetNextCursorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCursorTime
+	}
+	return nil
+}
+
+func (x *GetFeedResponse) GetNextCursorId() int64 {
+	if x != nil && x.NextCursorId != nil {
+		return *x.NextCursorId
+	}
+	return 0
+}
+
+// GetForYouFeedResponse carries the snapshot to pass back with next_offset
+// for the next page, snapshots expire after a while.
+type GetForYouFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	SnapshotId    int64                  `protobuf:"varint,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	NextOffset    int32                  `protobuf:"varint,4,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForYouFeedResponse) Reset() {
+	*x = GetForYouFeedResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForYouFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForYouFeedResponse) ProtoMessage() {}
+
+func (x *GetForYouFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForYouFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetForYouFeedResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetForYouFeedResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetForYouFeedResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetForYouFeedResponse) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *GetForYouFeedResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+type SearchPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetThreadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Post  *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	// root first, ends with the direct parent of post
+	Ancestors []*Post `protobuf:"bytes,2,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	// a page of direct replies followed by their nested replies, rebuild the
+	// tree with reply_to_post_id
+	Replies       []*Post `protobuf:"bytes,3,rep,name=replies,proto3" json:"replies,omitempty"`
+	HasMore       bool    `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	mi := &file_posts_v1_posts_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetThreadResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetAncestors() []*Post {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetReplies() []*Post {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type Post struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Images        []*PostImage           `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	LikesCount    int64                  `protobuf:"varint,5,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsLiked       bool                   `protobuf:"varint,9,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	IsOwner       bool                   `protobuf:"varint,10,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+	ReplyToPostId *int64                 `protobuf:"varint,11,opt,name=reply_to_post_id,json=replyToPostId,proto3,oneof" json:"reply_to_post_id,omitempty"`
+	RootPostId    *int64                 `protobuf:"varint,12,opt,name=root_post_id,json=rootPostId,proto3,oneof" json:"root_post_id,omitempty"`
+	RepliesCount  int64                  `protobuf:"varint,13,opt,name=replies_count,json=repliesCount,proto3" json:"replies_count,omitempty"`
+	RepostsCount  int64                  `protobuf:"varint,14,opt,name=reposts_count,json=repostsCount,proto3" json:"reposts_count,omitempty"`
+	QuotesCount   int64                  `protobuf:"varint,15,opt,name=quotes_count,json=quotesCount,proto3" json:"quotes_count,omitempty"`
+	IsReposted    bool                   `protobuf:"varint,16,opt,name=is_reposted,json=isReposted,proto3" json:"is_reposted,omitempty"`
+	QuotePostId   *int64                 `protobuf:"varint,17,opt,name=quote_post_id,json=quotePostId,proto3,oneof" json:"quote_post_id,omitempty"`
+	// filled one level deep, a quoted post never carries its own quote
+	QuotedPost *Post `protobuf:"bytes,18,opt,name=quoted_post,json=quotedPost,proto3" json:"quoted_post,omitempty"`
+	// set when the post shows up in a feed because someone reposted it
+	RepostedBy *int64                 `protobuf:"varint,19,opt,name=reposted_by,json=repostedBy,proto3,oneof" json:"reposted_by,omitempty"`
+	RepostedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=reposted_at,json=repostedAt,proto3,oneof" json:"reposted_at,omitempty"`
+	Mentions   []*Mention             `protobuf:"bytes,21,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	Entities   []*Entity              `protobuf:"bytes,22,rep,name=entities,proto3" json:"entities,omitempty"`
+	Edited     bool                   `protobuf:"varint,23,opt,name=edited,proto3" json:"edited,omitempty"`
+	EditCount  int64                  `protobuf:"varint,24,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
+	// only set on scheduled posts
+	PublishAt    *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	Poll         *Poll                  `protobuf:"bytes,26,opt,name=poll,proto3" json:"poll,omitempty"`
+	IsBookmarked bool                   `protobuf:"varint,27,opt,name=is_bookmarked,json=isBookmarked,proto3" json:"is_bookmarked,omitempty"`
+	// only set on posts listed by ListBookmarks
+	BookmarkedAt   *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=bookmarked_at,json=bookmarkedAt,proto3,oneof" json:"bookmarked_at,omitempty"`
+	BookmarkFolder *string                `protobuf:"bytes,29,opt,name=bookmark_folder,json=bookmarkFolder,proto3,oneof" json:"bookmark_folder,omitempty"`
+	// only set by GetUserPosts, pinned posts come first
+	IsPinned   bool   `protobuf:"varint,30,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
+	Visibility string `protobuf:"bytes,31,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// most used first, likes_count is their total
+	Reactions []*ReactionCount `protobuf:"bytes,32,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// empty when the viewer hasn't reacted
+	ViewerReaction string `protobuf:"bytes,33,opt,name=viewer_reaction,json=viewerReaction,proto3" json:"viewer_reaction,omitempty"`
+	// the first link in content, once it has been unfurled
+	LinkPreview    *LinkPreview `protobuf:"bytes,34,opt,name=link_preview,json=linkPreview,proto3" json:"link_preview,omitempty"`
+	ContentWarning *string      `protobuf:"bytes,35,opt,name=content_warning,json=contentWarning,proto3,oneof" json:"content_warning,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_posts_v1_posts_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Post) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Post) GetImages() []*PostImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Post) GetLikesCount() int64 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *Post) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+func (x *Post) GetReplyToPostId() int64 {
+	if x != nil && x.ReplyToPostId != nil {
+		return *x.ReplyToPostId
+	}
+	return 0
+}
+
+func (x *Post) GetRootPostId() int64 {
+	if x != nil && x.RootPostId != nil {
+		return *x.RootPostId
+	}
+	return 0
+}
+
+func (x *Post) GetRepliesCount() int64 {
+	if x != nil {
+		return x.RepliesCount
+	}
+	return 0
+}
+
+func (x *Post) GetRepostsCount() int64 {
+	if x != nil {
+		return x.RepostsCount
+	}
+	return 0
+}
+
+func (x *Post) GetQuotesCount() int64 {
+	if x != nil {
+		return x.QuotesCount
+	}
+	return 0
+}
+
+func (x *Post) GetIsReposted() bool {
+	if x != nil {
+		return x.IsReposted
+	}
+	return false
+}
+
+func (x *Post) GetQuotePostId() int64 {
+	if x != nil && x.QuotePostId != nil {
+		return *x.QuotePostId
+	}
+	return 0
+}
+
+func (x *Post) GetQuotedPost() *Post {
+	if x != nil {
+		return x.QuotedPost
+	}
+	return nil
+}
+
+func (x *Post) GetRepostedBy() int64 {
+	if x != nil && x.RepostedBy != nil {
+		return *x.RepostedBy
+	}
+	return 0
+}
+
+func (x *Post) GetRepostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RepostedAt
+	}
+	return nil
+}
+
+func (x *Post) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Post) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Post) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Post) GetEditCount() int64 {
+	if x != nil {
+		return x.EditCount
+	}
+	return 0
+}
+
+func (x *Post) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Post) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Post) GetIsBookmarked() bool {
+	if x != nil {
+		return x.IsBookmarked
+	}
+	return false
+}
+
+func (x *Post) GetBookmarkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookmarkedAt
+	}
+	return nil
+}
+
+func (x *Post) GetBookmarkFolder() string {
+	if x != nil && x.BookmarkFolder != nil {
+		return *x.BookmarkFolder
+	}
+	return ""
+}
+
+func (x *Post) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *Post) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Post) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Post) GetViewerReaction() string {
+	if x != nil {
+		return x.ViewerReaction
+	}
+	return ""
+}
+
+func (x *Post) GetLinkPreview() *LinkPreview {
+	if x != nil {
+		return x.LinkPreview
+	}
+	return nil
+}
+
+func (x *Post) GetContentWarning() string {
+	if x != nil && x.ContentWarning != nil {
+		return *x.ContentWarning
+	}
+	return ""
+}
+
+type LinkPreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	SiteName      string                 `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	FetchedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_posts_v1_posts_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetFetchedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.FetchedAt
 	}
-	return 0
+	return nil
 }
 
-type SearchPostsRequest struct {
+type ReactionCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Reaction      string                 `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchPostsRequest) Reset() {
-	*x = SearchPostsRequest{}
-	mi := &file_posts_v1_posts_proto_msgTypes[11]
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_posts_v1_posts_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchPostsRequest) String() string {
+func (x *ReactionCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchPostsRequest) ProtoMessage() {}
+func (*ReactionCount) ProtoMessage() {}
 
-func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[11]
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,40 +3880,55 @@ func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
-func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SearchPostsRequest) GetQuery() string {
+func (x *ReactionCount) GetReaction() string {
 	if x != nil {
-		return x.Query
+		return x.Reaction
 	}
 	return ""
 }
 
-type GetPostsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReactionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *GetPostsResponse) Reset() {
-	*x = GetPostsResponse{}
-	mi := &file_posts_v1_posts_proto_msgTypes[12]
+// Poll tallies are only filled when tallies_visible is true, which is once
+// the viewer has voted or the poll has closed.
+type Poll struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Options        []*PollOption          `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	MultipleChoice bool                   `protobuf:"varint,2,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Closed         bool                   `protobuf:"varint,4,opt,name=closed,proto3" json:"closed,omitempty"`
+	Voted          bool                   `protobuf:"varint,5,opt,name=voted,proto3" json:"voted,omitempty"`
+	TalliesVisible bool                   `protobuf:"varint,6,opt,name=tallies_visible,json=talliesVisible,proto3" json:"tallies_visible,omitempty"`
+	VotersCount    int64                  `protobuf:"varint,7,opt,name=voters_count,json=votersCount,proto3" json:"voters_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_posts_v1_posts_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsResponse) String() string {
+func (x *Poll) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsResponse) ProtoMessage() {}
+func (*Poll) ProtoMessage() {}
 
-func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[12]
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,41 +3939,85 @@ func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetPostsResponse) GetPosts() []*Post {
+func (x *Poll) GetOptions() []*PollOption {
 	if x != nil {
-		return x.Posts
+		return x.Options
 	}
 	return nil
 }
 
-type GetFeedResponse struct {
+func (x *Poll) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *Poll) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetVoted() bool {
+	if x != nil {
+		return x.Voted
+	}
+	return false
+}
+
+func (x *Poll) GetTalliesVisible() bool {
+	if x != nil {
+		return x.TalliesVisible
+	}
+	return false
+}
+
+func (x *Poll) GetVotersCount() int64 {
+	if x != nil {
+		return x.VotersCount
+	}
+	return 0
+}
+
+type PollOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	VotesCount    *int64                 `protobuf:"varint,3,opt,name=votes_count,json=votesCount,proto3,oneof" json:"votes_count,omitempty"`
+	IsVoted       bool                   `protobuf:"varint,4,opt,name=is_voted,json=isVoted,proto3" json:"is_voted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedResponse) Reset() {
-	*x = GetFeedResponse{}
-	mi := &file_posts_v1_posts_proto_msgTypes[13]
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_posts_v1_posts_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedResponse) String() string {
+func (x *PollOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedResponse) ProtoMessage() {}
+func (*PollOption) ProtoMessage() {}
 
-func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[13]
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,47 +4028,65 @@ func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
-func (*GetFeedResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetFeedResponse) GetPosts() []*Post {
+func (x *PollOption) GetId() int64 {
 	if x != nil {
-		return x.Posts
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetFeedResponse) GetHasMore() bool {
+func (x *PollOption) GetLabel() string {
 	if x != nil {
-		return x.HasMore
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotesCount() int64 {
+	if x != nil && x.VotesCount != nil {
+		return *x.VotesCount
+	}
+	return 0
+}
+
+func (x *PollOption) GetIsVoted() bool {
+	if x != nil {
+		return x.IsVoted
 	}
 	return false
 }
 
-type SearchPostsResponse struct {
+// Mention is a resolved @username, start and end are byte offsets into the
+// content.
+type Mention struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchPostsResponse) Reset() {
-	*x = SearchPostsResponse{}
-	mi := &file_posts_v1_posts_proto_msgTypes[14]
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_posts_v1_posts_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchPostsResponse) String() string {
+func (x *Mention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchPostsResponse) ProtoMessage() {}
+func (*Mention) ProtoMessage() {}
 
-func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[14]
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,48 +4097,64 @@ func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
-func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SearchPostsResponse) GetPosts() []*Post {
+func (x *Mention) GetUserId() int64 {
 	if x != nil {
-		return x.Posts
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type Post struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Images        []*PostImage           `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
-	LikesCount    int64                  `protobuf:"varint,5,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	IsLiked       bool                   `protobuf:"varint,9,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	IsOwner       bool                   `protobuf:"varint,10,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+func (x *Mention) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Mention) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Entity is a typed span of the content: url, mention, hashtag or cashtag.
+// start and end are byte offsets, utf16_start and utf16_end are UTF-16 code
+// unit offsets for clients that index strings that way.
+type Entity struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Type       string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text       string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Start      int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End        int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Utf16Start int32                  `protobuf:"varint,5,opt,name=utf16_start,json=utf16Start,proto3" json:"utf16_start,omitempty"`
+	Utf16End   int32                  `protobuf:"varint,6,opt,name=utf16_end,json=utf16End,proto3" json:"utf16_end,omitempty"`
+	// only set on mentions
+	UserId        *int64 `protobuf:"varint,7,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	mi := &file_posts_v1_posts_proto_msgTypes[15]
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_posts_v1_posts_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *Entity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*Entity) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[15]
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_v1_posts_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,87 +4165,75 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Post) GetId() int64 {
+func (x *Entity) GetType() string {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetContent() string {
+func (x *Entity) GetText() string {
 	if x != nil {
-		return x.Content
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Post) GetUserId() int64 {
+func (x *Entity) GetStart() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Start
 	}
 	return 0
 }
 
-func (x *Post) GetImages() []*PostImage {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-func (x *Post) GetLikesCount() int64 {
+func (x *Entity) GetEnd() int32 {
 	if x != nil {
-		return x.LikesCount
+		return x.End
 	}
 	return 0
 }
 
-func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Entity) GetUtf16Start() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Utf16Start
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetIsLiked() bool {
+func (x *Entity) GetUtf16End() int32 {
 	if x != nil {
-		return x.IsLiked
+		return x.Utf16End
 	}
-	return false
+	return 0
 }
 
-func (x *Post) GetIsOwner() bool {
-	if x != nil {
-		return x.IsOwner
+func (x *Entity) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
-	return false
+	return 0
 }
 
 type PostImage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Order         int64                  `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
-	Width         int64                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
-	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Url    string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Order  int64                  `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
+	Width  int64                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// clients blur or hide it depending on the viewer's sensitive_media setting
+	Sensitive     bool `protobuf:"varint,5,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PostImage) Reset() {
 	*x = PostImage{}
-	mi := &file_posts_v1_posts_proto_msgTypes[16]
+	mi := &file_posts_v1_posts_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -871,7 +4245,7 @@ func (x *PostImage) String() string {
 func (*PostImage) ProtoMessage() {}
 
 func (x *PostImage) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_v1_posts_proto_msgTypes[16]
+	mi := &file_posts_v1_posts_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,7 +4258,7 @@ func (x *PostImage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostImage.ProtoReflect.Descriptor instead.
 func (*PostImage) Descriptor() ([]byte, []int) {
-	return file_posts_v1_posts_proto_rawDescGZIP(), []int{16}
+	return file_posts_v1_posts_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *PostImage) GetUrl() string {
@@ -915,31 +4289,186 @@ func (x *PostImage) GetHeight() int64 {
 	return 0
 }
 
+func (x *PostImage) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
 var File_posts_v1_posts_proto protoreflect.FileDescriptor
 
 const file_posts_v1_posts_proto_rawDesc = "" +
 	"\n" +
-	"\x14posts/v1/posts.proto\x12\bposts.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\x14posts/v1/posts.proto\x12\bposts.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd9\x03\n" +
 	"\x11CreatePostRequest\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12+\n" +
-	"\x06images\x18\x02 \x03(\v2\x13.posts.v1.PostImageR\x06images\")\n" +
+	"\x06images\x18\x02 \x03(\v2\x13.posts.v1.PostImageR\x06images\x12\x1e\n" +
+	"\breply_to\x18\x03 \x01(\x03H\x00R\areplyTo\x88\x01\x01\x12'\n" +
+	"\rquote_post_id\x18\x04 \x01(\x03H\x01R\vquotePostId\x88\x01\x01\x12-\n" +
+	"\bmentions\x18\x05 \x03(\v2\x11.posts.v1.MentionR\bmentions\x12>\n" +
+	"\n" +
+	"publish_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tpublishAt\x88\x01\x01\x12,\n" +
+	"\x04poll\x18\a \x01(\v2\x13.posts.v1.PollInputH\x03R\x04poll\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tR\n" +
+	"visibility\x12,\n" +
+	"\x0fcontent_warning\x18\t \x01(\tH\x04R\x0econtentWarning\x88\x01\x01B\v\n" +
+	"\t_reply_toB\x10\n" +
+	"\x0e_quote_post_idB\r\n" +
+	"\v_publish_atB\a\n" +
+	"\x05_pollB\x12\n" +
+	"\x10_content_warning\"\x83\x01\n" +
+	"\tPollInput\x12\x18\n" +
+	"\aoptions\x18\x01 \x03(\tR\aoptions\x123\n" +
+	"\aends_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12'\n" +
+	"\x0fmultiple_choice\x18\x03 \x01(\bR\x0emultipleChoice\")\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\"s\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"\xa2\x01\n" +
 	"\x11UpdatePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12+\n" +
-	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.PostImageR\x06images\",\n" +
+	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.PostImageR\x06images\x12-\n" +
+	"\bmentions\x18\x04 \x03(\v2\x11.posts.v1.MentionR\bmentions\",\n" +
 	"\x11DeletePostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\x03R\x06postId\".\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"\x8d\x02\n" +
+	"\x1aUpdateScheduledPostRequest\x12*\n" +
+	"\x11scheduled_post_id\x18\x01 \x01(\x03R\x0fscheduledPostId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12+\n" +
+	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.PostImageR\x06images\x12-\n" +
+	"\bmentions\x18\x04 \x03(\v2\x11.posts.v1.MentionR\bmentions\x12>\n" +
+	"\n" +
+	"publish_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tpublishAt\x88\x01\x01B\r\n" +
+	"\v_publish_at\"B\n" +
+	"\x14ScheduledPostRequest\x12*\n" +
+	"\x11scheduled_post_id\x18\x01 \x01(\x03R\x0fscheduledPostId\"\xc4\x02\n" +
+	"\x10SaveDraftRequest\x12\x1e\n" +
+	"\bdraft_id\x18\x01 \x01(\x03H\x00R\adraftId\x88\x01\x01\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12+\n" +
+	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.PostImageR\x06images\x12\x1e\n" +
+	"\breply_to\x18\x04 \x01(\x03H\x01R\areplyTo\x88\x01\x01\x12-\n" +
+	"\bmentions\x18\x05 \x03(\v2\x11.posts.v1.MentionR\bmentions\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\x12,\n" +
+	"\x0fcontent_warning\x18\a \x01(\tH\x02R\x0econtentWarning\x88\x01\x01B\v\n" +
+	"\t_draft_idB\v\n" +
+	"\t_reply_toB\x12\n" +
+	"\x10_content_warning\")\n" +
+	"\fDraftRequest\x12\x19\n" +
+	"\bdraft_id\x18\x01 \x01(\x03R\adraftId\"\xf9\x02\n" +
+	"\x05Draft\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12+\n" +
+	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.PostImageR\x06images\x12,\n" +
+	"\x10reply_to_post_id\x18\x04 \x01(\x03H\x00R\rreplyToPostId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\tR\n" +
+	"visibility\x12,\n" +
+	"\x0fcontent_warning\x18\b \x01(\tH\x01R\x0econtentWarning\x88\x01\x01B\x13\n" +
+	"\x11_reply_to_post_idB\x12\n" +
+	"\x10_content_warning\"=\n" +
+	"\x12ListDraftsResponse\x12'\n" +
+	"\x06drafts\x18\x01 \x03(\v2\x0f.posts.v1.DraftR\x06drafts\"I\n" +
+	"\x0fVotePollRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x02 \x03(\x03R\toptionIds\"+\n" +
+	"\x10ClosePollRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"`\n" +
+	"\x15ListPollVotersRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12 \n" +
+	"\tcursor_id\x18\x02 \x01(\x03H\x00R\bcursorId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_cursor_id\"P\n" +
+	"\x16ListPollVotersResponse\x12\x1b\n" +
+	"\tvoter_ids\x18\x01 \x03(\x03R\bvoterIds\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"U\n" +
+	"\x12AddBookmarkRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\x06folder\x18\x02 \x01(\tH\x00R\x06folder\x88\x01\x01B\t\n" +
+	"\a_folder\"*\n" +
+	"\x0fBookmarkRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"\xc0\x01\n" +
+	"\x14ListBookmarksRequest\x12\x1b\n" +
+	"\x06folder\x18\x01 \x01(\tH\x00R\x06folder\x88\x01\x01\x12@\n" +
+	"\vcursor_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x03 \x01(\x03H\x02R\bcursorId\x88\x01\x01B\t\n" +
+	"\a_folderB\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"M\n" +
+	"\x0eBookmarkFolder\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x0fbookmarks_count\x18\x02 \x01(\x03R\x0ebookmarksCount\"Q\n" +
+	"\x1bListBookmarkFoldersResponse\x122\n" +
+	"\afolders\x18\x01 \x03(\v2\x18.posts.v1.BookmarkFolderR\afolders\"2\n" +
+	"\x17GetPostRevisionsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"\xd8\x01\n" +
+	"\fPostRevision\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12+\n" +
+	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.PostImageR\x06images\x12,\n" +
+	"\bentities\x18\x04 \x03(\v2\x10.posts.v1.EntityR\bentities\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x92\x01\n" +
+	"\x18GetPostRevisionsResponse\x124\n" +
+	"\trevisions\x18\x01 \x03(\v2\x16.posts.v1.PostRevisionR\trevisions\x12,\n" +
+	"\x0fcontent_warning\x18\x02 \x01(\tH\x00R\x0econtentWarning\x88\x01\x01B\x12\n" +
+	"\x10_content_warning\"\xad\x01\n" +
+	"\x10GetThreadRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12@\n" +
+	"\vcursor_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x03 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"\xb0\x01\n" +
 	"\x13GetUserPostsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x98\x01\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12@\n" +
+	"\vcursor_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x03 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"\x98\x01\n" +
 	"\x14GetGlobalFeedRequest\x12@\n" +
 	"\vcursor_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
 	"cursorTime\x88\x01\x01\x12 \n" +
 	"\tcursor_id\x18\x02 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
 	"\f_cursor_timeB\f\n" +
 	"\n" +
-	"_cursor_id\"\xb6\x01\n" +
+	"_cursor_id\"\xab\x01\n" +
+	"\x15GetHashtagFeedRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12@\n" +
+	"\vcursor_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x03 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"\x96\x01\n" +
+	"\x12GetMentionsRequest\x12@\n" +
+	"\vcursor_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x02 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"2\n" +
+	"\x1aGetTrendingHashtagsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"M\n" +
+	"\x0fTrendingHashtag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04uses\x18\x02 \x01(\x03R\x04uses\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"\x91\x01\n" +
+	"\x1bGetTrendingHashtagsResponse\x125\n" +
+	"\bhashtags\x18\x01 \x03(\v2\x19.posts.v1.TrendingHashtagR\bhashtags\x12;\n" +
+	"\vcomputed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\xb6\x01\n" +
 	"\x17GetFollowingFeedRequest\x12\x19\n" +
 	"\buser_ids\x18\x01 \x03(\x03R\auserIds\x12@\n" +
 	"\vcursor_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
@@ -947,10 +4476,92 @@ const file_posts_v1_posts_proto_rawDesc = "" +
 	"\tcursor_id\x18\x03 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
 	"\f_cursor_timeB\f\n" +
 	"\n" +
-	"_cursor_id\"*\n" +
+	"_cursor_id\"j\n" +
+	"\x14GetForYouFeedRequest\x12$\n" +
+	"\vsnapshot_id\x18\x02 \x01(\x03H\x00R\n" +
+	"snapshotId\x88\x01\x01\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offsetB\x0e\n" +
+	"\f_snapshot_idJ\x04\b\x01\x10\x02\"\x9a\x01\n" +
+	"\x16GetHomeTimelineRequest\x12@\n" +
+	"\vcursor_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x02 \x01(\x03H\x01R\bcursorId\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_id\"}\n" +
+	"\x11FanOutPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12$\n" +
+	"\vreposted_by\x18\x02 \x01(\x03H\x00R\n" +
+	"repostedBy\x88\x01\x01\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\x03R\auserIdsB\x0e\n" +
+	"\f_reposted_by\"p\n" +
+	"\x17BackfillTimelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"author_ids\x18\x02 \x03(\x03R\tauthorIds\x12\x1d\n" +
+	"\n" +
+	"mark_built\x18\x03 \x01(\bR\tmarkBuilt\"R\n" +
+	"\x1aClearTimelineSourceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x03R\bsourceId\"T\n" +
+	"\x1bSetTimelineCelebrityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\tcelebrity\x18\x02 \x01(\bR\tcelebrity\"*\n" +
 	"\x0fLikePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\",\n" +
 	"\x11UnlikePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\")\n" +
+	"\x15GetLinkPreviewRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"a\n" +
+	"\x15SetLinkPreviewRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12/\n" +
+	"\apreview\x18\x02 \x01(\v2\x15.posts.v1.LinkPreviewR\apreview\"\xa0\x01\n" +
+	"\x16SetContentFlagsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12,\n" +
+	"\x0fcontent_warning\x18\x02 \x01(\tH\x00R\x0econtentWarning\x88\x01\x01\x12+\n" +
+	"\x06images\x18\x03 \x03(\v2\x13.posts.v1.ImageFlagR\x06imagesB\x12\n" +
+	"\x10_content_warning\"?\n" +
+	"\tImageFlag\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\x03R\x05order\x12\x1c\n" +
+	"\tsensitive\x18\x02 \x01(\bR\tsensitive\"I\n" +
+	"\x12ReactToPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1a\n" +
+	"\breaction\x18\x02 \x01(\tR\breaction\"0\n" +
+	"\x15RemoveReactionRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"`\n" +
+	"\x15ListReactionsResponse\x12\x1c\n" +
+	"\treactions\x18\x01 \x03(\tR\treactions\x12)\n" +
+	"\x10default_reaction\x18\x02 \x01(\tR\x0fdefaultReaction\"\xfa\x01\n" +
+	"\x15ListPostLikersRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12@\n" +
+	"\vcursor_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"cursorTime\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x03 \x01(\x03H\x01R\bcursorId\x88\x01\x01\x12,\n" +
+	"\x0fcursor_followed\x18\x04 \x01(\bH\x02R\x0ecursorFollowed\x88\x01\x01B\x0e\n" +
+	"\f_cursor_timeB\f\n" +
+	"\n" +
+	"_cursor_idB\x12\n" +
+	"\x10_cursor_followedJ\x04\b\x05\x10\x06\"|\n" +
+	"\tPostLiker\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x125\n" +
+	"\bliked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\alikedAt\x12\x1f\n" +
+	"\vis_followed\x18\x03 \x01(\bR\n" +
+	"isFollowed\"\xce\x02\n" +
+	"\x16ListPostLikersResponse\x12+\n" +
+	"\x06likers\x18\x01 \x03(\v2\x13.posts.v1.PostLikerR\x06likers\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12I\n" +
+	"\x10next_cursor_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0enextCursorTime\x88\x01\x01\x12)\n" +
+	"\x0enext_cursor_id\x18\x04 \x01(\x03H\x01R\fnextCursorId\x88\x01\x01\x125\n" +
+	"\x14next_cursor_followed\x18\x05 \x01(\bH\x02R\x12nextCursorFollowed\x88\x01\x01B\x13\n" +
+	"\x11_next_cursor_timeB\x11\n" +
+	"\x0f_next_cursor_idB\x17\n" +
+	"\x15_next_cursor_followed\">\n" +
+	"\x1bReconcileLikesCountResponse\x12\x1f\n" +
+	"\vfixed_posts\x18\x01 \x01(\x03R\n" +
+	"fixedPosts\"(\n" +
+	"\rRepostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\")\n" +
+	"\x0ePinPostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\"7\n" +
 	"\x1cHandleAccountDeletionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\":\n" +
@@ -959,12 +4570,28 @@ const file_posts_v1_posts_proto_rawDesc = "" +
 	"\x12SearchPostsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"8\n" +
 	"\x10GetPostsResponse\x12$\n" +
-	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\"R\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\"\xf0\x01\n" +
 	"\x0fGetFeedResponse\x12$\n" +
 	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\";\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12I\n" +
+	"\x10next_cursor_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0enextCursorTime\x88\x01\x01\x12)\n" +
+	"\x0enext_cursor_id\x18\x04 \x01(\x03H\x01R\fnextCursorId\x88\x01\x01B\x13\n" +
+	"\x11_next_cursor_timeB\x11\n" +
+	"\x0f_next_cursor_id\"\x9a\x01\n" +
+	"\x15GetForYouFeedResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vsnapshot_id\x18\x03 \x01(\x03R\n" +
+	"snapshotId\x12\x1f\n" +
+	"\vnext_offset\x18\x04 \x01(\x05R\n" +
+	"nextOffset\";\n" +
 	"\x13SearchPostsResponse\x12$\n" +
-	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\"\xc9\x02\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.posts.v1.PostR\x05posts\"\xaa\x01\n" +
+	"\x11GetThreadResponse\x12\"\n" +
+	"\x04post\x18\x01 \x01(\v2\x0e.posts.v1.PostR\x04post\x12,\n" +
+	"\tancestors\x18\x02 \x03(\v2\x0e.posts.v1.PostR\tancestors\x12(\n" +
+	"\areplies\x18\x03 \x03(\v2\x0e.posts.v1.PostR\areplies\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\xa5\f\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x17\n" +
@@ -978,12 +4605,98 @@ const file_posts_v1_posts_proto_rawDesc = "" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
 	"\bis_liked\x18\t \x01(\bR\aisLiked\x12\x19\n" +
 	"\bis_owner\x18\n" +
-	" \x01(\bR\aisOwnerJ\x04\b\x06\x10\a\"a\n" +
+	" \x01(\bR\aisOwner\x12,\n" +
+	"\x10reply_to_post_id\x18\v \x01(\x03H\x00R\rreplyToPostId\x88\x01\x01\x12%\n" +
+	"\froot_post_id\x18\f \x01(\x03H\x01R\n" +
+	"rootPostId\x88\x01\x01\x12#\n" +
+	"\rreplies_count\x18\r \x01(\x03R\frepliesCount\x12#\n" +
+	"\rreposts_count\x18\x0e \x01(\x03R\frepostsCount\x12!\n" +
+	"\fquotes_count\x18\x0f \x01(\x03R\vquotesCount\x12\x1f\n" +
+	"\vis_reposted\x18\x10 \x01(\bR\n" +
+	"isReposted\x12'\n" +
+	"\rquote_post_id\x18\x11 \x01(\x03H\x02R\vquotePostId\x88\x01\x01\x12/\n" +
+	"\vquoted_post\x18\x12 \x01(\v2\x0e.posts.v1.PostR\n" +
+	"quotedPost\x12$\n" +
+	"\vreposted_by\x18\x13 \x01(\x03H\x03R\n" +
+	"repostedBy\x88\x01\x01\x12@\n" +
+	"\vreposted_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"repostedAt\x88\x01\x01\x12-\n" +
+	"\bmentions\x18\x15 \x03(\v2\x11.posts.v1.MentionR\bmentions\x12,\n" +
+	"\bentities\x18\x16 \x03(\v2\x10.posts.v1.EntityR\bentities\x12\x16\n" +
+	"\x06edited\x18\x17 \x01(\bR\x06edited\x12\x1d\n" +
+	"\n" +
+	"edit_count\x18\x18 \x01(\x03R\teditCount\x12>\n" +
+	"\n" +
+	"publish_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\tpublishAt\x88\x01\x01\x12\"\n" +
+	"\x04poll\x18\x1a \x01(\v2\x0e.posts.v1.PollR\x04poll\x12#\n" +
+	"\ris_bookmarked\x18\x1b \x01(\bR\fisBookmarked\x12D\n" +
+	"\rbookmarked_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampH\x06R\fbookmarkedAt\x88\x01\x01\x12,\n" +
+	"\x0fbookmark_folder\x18\x1d \x01(\tH\aR\x0ebookmarkFolder\x88\x01\x01\x12\x1b\n" +
+	"\tis_pinned\x18\x1e \x01(\bR\bisPinned\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x1f \x01(\tR\n" +
+	"visibility\x125\n" +
+	"\treactions\x18  \x03(\v2\x17.posts.v1.ReactionCountR\treactions\x12'\n" +
+	"\x0fviewer_reaction\x18! \x01(\tR\x0eviewerReaction\x128\n" +
+	"\flink_preview\x18\" \x01(\v2\x15.posts.v1.LinkPreviewR\vlinkPreview\x12,\n" +
+	"\x0fcontent_warning\x18# \x01(\tH\bR\x0econtentWarning\x88\x01\x01B\x13\n" +
+	"\x11_reply_to_post_idB\x0f\n" +
+	"\r_root_post_idB\x10\n" +
+	"\x0e_quote_post_idB\x0e\n" +
+	"\f_reposted_byB\x0e\n" +
+	"\f_reposted_atB\r\n" +
+	"\v_publish_atB\x10\n" +
+	"\x0e_bookmarked_atB\x12\n" +
+	"\x10_bookmark_folderB\x12\n" +
+	"\x10_content_warningJ\x04\b\x06\x10\a\"\xcc\x01\n" +
+	"\vLinkPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x1b\n" +
+	"\tsite_name\x18\x05 \x01(\tR\bsiteName\x129\n" +
+	"\n" +
+	"fetched_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tfetchedAt\"A\n" +
+	"\rReactionCount\x12\x1a\n" +
+	"\breaction\x18\x01 \x01(\tR\breaction\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x8e\x02\n" +
+	"\x04Poll\x12.\n" +
+	"\aoptions\x18\x01 \x03(\v2\x14.posts.v1.PollOptionR\aoptions\x12'\n" +
+	"\x0fmultiple_choice\x18\x02 \x01(\bR\x0emultipleChoice\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06closed\x18\x04 \x01(\bR\x06closed\x12\x14\n" +
+	"\x05voted\x18\x05 \x01(\bR\x05voted\x12'\n" +
+	"\x0ftallies_visible\x18\x06 \x01(\bR\x0etalliesVisible\x12!\n" +
+	"\fvoters_count\x18\a \x01(\x03R\vvotersCount\"\x83\x01\n" +
+	"\n" +
+	"PollOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12$\n" +
+	"\vvotes_count\x18\x03 \x01(\x03H\x00R\n" +
+	"votesCount\x88\x01\x01\x12\x19\n" +
+	"\bis_voted\x18\x04 \x01(\bR\aisVotedB\x0e\n" +
+	"\f_votes_count\"J\n" +
+	"\aMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\"\xc0\x01\n" +
+	"\x06Entity\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\x12\x1f\n" +
+	"\vutf16_start\x18\x05 \x01(\x05R\n" +
+	"utf16Start\x12\x1b\n" +
+	"\tutf16_end\x18\x06 \x01(\x05R\butf16End\x12\x1c\n" +
+	"\auser_id\x18\a \x01(\x03H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"\x7f\n" +
 	"\tPostImage\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
 	"\x05order\x18\x02 \x01(\x03R\x05order\x12\x14\n" +
 	"\x05width\x18\x03 \x01(\x03R\x05width\x12\x16\n" +
-	"\x06height\x18\x04 \x01(\x03R\x06height2\xbe\a\n" +
+	"\x06height\x18\x04 \x01(\x03R\x06height\x12\x1c\n" +
+	"\tsensitive\x18\x05 \x01(\bR\tsensitive2\xf3\x1d\n" +
 	"\vPostService\x129\n" +
 	"\n" +
 	"CreatePost\x12\x1b.posts.v1.CreatePostRequest\x1a\x0e.posts.v1.Post\x123\n" +
@@ -991,14 +4704,56 @@ const file_posts_v1_posts_proto_rawDesc = "" +
 	"\n" +
 	"UpdatePost\x12\x1b.posts.v1.UpdatePostRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
 	"\n" +
-	"DeletePost\x12\x1b.posts.v1.DeletePostRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
-	"\fGetUserPosts\x12\x1d.posts.v1.GetUserPostsRequest\x1a\x1a.posts.v1.GetPostsResponse\x12J\n" +
-	"\rGetLikedPosts\x12\x1d.posts.v1.GetUserPostsRequest\x1a\x1a.posts.v1.GetPostsResponse\x12J\n" +
+	"DeletePost\x12\x1b.posts.v1.DeletePostRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\tGetThread\x12\x1a.posts.v1.GetThreadRequest\x1a\x1b.posts.v1.GetThreadResponse\x12Y\n" +
+	"\x10GetPostRevisions\x12!.posts.v1.GetPostRevisionsRequest\x1a\".posts.v1.GetPostRevisionsResponse\x12H\n" +
+	"\x12ListScheduledPosts\x12\x16.google.protobuf.Empty\x1a\x1a.posts.v1.GetPostsResponse\x12K\n" +
+	"\x13UpdateScheduledPost\x12$.posts.v1.UpdateScheduledPostRequest\x1a\x0e.posts.v1.Post\x12M\n" +
+	"\x13CancelScheduledPost\x12\x1e.posts.v1.ScheduledPostRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x14PublishScheduledPost\x12\x1e.posts.v1.ScheduledPostRequest\x1a\x0e.posts.v1.Post\x128\n" +
+	"\tSaveDraft\x12\x1a.posts.v1.SaveDraftRequest\x1a\x0f.posts.v1.Draft\x12B\n" +
+	"\n" +
+	"ListDrafts\x12\x16.google.protobuf.Empty\x1a\x1c.posts.v1.ListDraftsResponse\x12=\n" +
+	"\vDeleteDraft\x12\x16.posts.v1.DraftRequest\x1a\x16.google.protobuf.Empty\x126\n" +
+	"\fPublishDraft\x12\x16.posts.v1.DraftRequest\x1a\x0e.posts.v1.Post\x125\n" +
+	"\bVotePoll\x12\x19.posts.v1.VotePollRequest\x1a\x0e.posts.v1.Poll\x12?\n" +
+	"\tClosePoll\x12\x1a.posts.v1.ClosePollRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x0eListPollVoters\x12\x1f.posts.v1.ListPollVotersRequest\x1a .posts.v1.ListPollVotersResponse\x12H\n" +
+	"\x0eGetLinkPreview\x12\x1f.posts.v1.GetLinkPreviewRequest\x1a\x15.posts.v1.LinkPreview\x12I\n" +
+	"\x0eSetLinkPreview\x12\x1f.posts.v1.SetLinkPreviewRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x0fSetContentFlags\x12 .posts.v1.SetContentFlagsRequest\x1a\x0e.posts.v1.Post\x12C\n" +
+	"\vAddBookmark\x12\x1c.posts.v1.AddBookmarkRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x0eRemoveBookmark\x12\x19.posts.v1.BookmarkRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\rListBookmarks\x12\x1e.posts.v1.ListBookmarksRequest\x1a\x19.posts.v1.GetFeedResponse\x12T\n" +
+	"\x13ListBookmarkFolders\x12\x16.google.protobuf.Empty\x1a%.posts.v1.ListBookmarkFoldersResponse\x12H\n" +
+	"\fGetUserPosts\x12\x1d.posts.v1.GetUserPostsRequest\x1a\x19.posts.v1.GetFeedResponse\x12I\n" +
+	"\rGetLikedPosts\x12\x1d.posts.v1.GetUserPostsRequest\x1a\x19.posts.v1.GetFeedResponse\x12J\n" +
 	"\rGetGlobalFeed\x12\x1e.posts.v1.GetGlobalFeedRequest\x1a\x19.posts.v1.GetFeedResponse\x12P\n" +
-	"\x10GetFollowingFeed\x12!.posts.v1.GetFollowingFeedRequest\x1a\x19.posts.v1.GetFeedResponse\x12=\n" +
+	"\x10GetFollowingFeed\x12!.posts.v1.GetFollowingFeedRequest\x1a\x19.posts.v1.GetFeedResponse\x12P\n" +
+	"\rGetForYouFeed\x12\x1e.posts.v1.GetForYouFeedRequest\x1a\x1f.posts.v1.GetForYouFeedResponse\x12N\n" +
+	"\x0fGetHomeTimeline\x12 .posts.v1.GetHomeTimelineRequest\x1a\x19.posts.v1.GetFeedResponse\x12A\n" +
+	"\n" +
+	"FanOutPost\x12\x1b.posts.v1.FanOutPostRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x10BackfillTimeline\x12!.posts.v1.BackfillTimelineRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x13ClearTimelineSource\x12$.posts.v1.ClearTimelineSourceRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x14SetTimelineCelebrity\x12%.posts.v1.SetTimelineCelebrityRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x0eGetHashtagFeed\x12\x1f.posts.v1.GetHashtagFeedRequest\x1a\x19.posts.v1.GetFeedResponse\x12b\n" +
+	"\x13GetTrendingHashtags\x12$.posts.v1.GetTrendingHashtagsRequest\x1a%.posts.v1.GetTrendingHashtagsResponse\x12F\n" +
+	"\vGetMentions\x12\x1c.posts.v1.GetMentionsRequest\x1a\x19.posts.v1.GetFeedResponse\x12I\n" +
+	"\x17RefreshTrendingHashtags\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12=\n" +
 	"\bLikePost\x12\x19.posts.v1.LikePostRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
 	"\n" +
-	"UnlikePost\x12\x1b.posts.v1.UnlikePostRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"UnlikePost\x12\x1b.posts.v1.UnlikePostRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\vReactToPost\x12\x1c.posts.v1.ReactToPostRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x0eRemoveReaction\x12\x1f.posts.v1.RemoveReactionRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\rListReactions\x12\x16.google.protobuf.Empty\x1a\x1f.posts.v1.ListReactionsResponse\x12T\n" +
+	"\x13ReconcileLikesCount\x12\x16.google.protobuf.Empty\x1a%.posts.v1.ReconcileLikesCountResponse\x12S\n" +
+	"\x0eListPostLikers\x12\x1f.posts.v1.ListPostLikersRequest\x1a .posts.v1.ListPostLikersResponse\x129\n" +
+	"\x06Repost\x12\x17.posts.v1.RepostRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\n" +
+	"UndoRepost\x12\x17.posts.v1.RepostRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\aPinPost\x12\x18.posts.v1.PinPostRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\tUnpinPost\x12\x18.posts.v1.PinPostRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
 	"\x15HandleAccountDeletion\x12&.posts.v1.HandleAccountDeletionRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
 	"\x18HandleAccountRestoration\x12).posts.v1.HandleAccountRestorationRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
 	"\vSearchPosts\x12\x1c.posts.v1.SearchPostsRequest\x1a\x1d.posts.v1.SearchPostsResponseB\x14Z\x12./posts/v1;postsv1b\x06proto3"
@@ -1015,70 +4770,250 @@ func file_posts_v1_posts_proto_rawDescGZIP() []byte {
 	return file_posts_v1_posts_proto_rawDescData
 }
 
-var file_posts_v1_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_posts_v1_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_posts_v1_posts_proto_goTypes = []any{
 	(*CreatePostRequest)(nil),               // 0: posts.v1.CreatePostRequest
-	(*GetPostRequest)(nil),                  // 1: posts.v1.GetPostRequest
-	(*UpdatePostRequest)(nil),               // 2: posts.v1.UpdatePostRequest
-	(*DeletePostRequest)(nil),               // 3: posts.v1.DeletePostRequest
-	(*GetUserPostsRequest)(nil),             // 4: posts.v1.GetUserPostsRequest
-	(*GetGlobalFeedRequest)(nil),            // 5: posts.v1.GetGlobalFeedRequest
-	(*GetFollowingFeedRequest)(nil),         // 6: posts.v1.GetFollowingFeedRequest
-	(*LikePostRequest)(nil),                 // 7: posts.v1.LikePostRequest
-	(*UnlikePostRequest)(nil),               // 8: posts.v1.UnlikePostRequest
-	(*HandleAccountDeletionRequest)(nil),    // 9: posts.v1.HandleAccountDeletionRequest
-	(*HandleAccountRestorationRequest)(nil), // 10: posts.v1.HandleAccountRestorationRequest
-	(*SearchPostsRequest)(nil),              // 11: posts.v1.SearchPostsRequest
-	(*GetPostsResponse)(nil),                // 12: posts.v1.GetPostsResponse
-	(*GetFeedResponse)(nil),                 // 13: posts.v1.GetFeedResponse
-	(*SearchPostsResponse)(nil),             // 14: posts.v1.SearchPostsResponse
-	(*Post)(nil),                            // 15: posts.v1.Post
-	(*PostImage)(nil),                       // 16: posts.v1.PostImage
-	(*timestamppb.Timestamp)(nil),           // 17: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 18: google.protobuf.Empty
+	(*PollInput)(nil),                       // 1: posts.v1.PollInput
+	(*GetPostRequest)(nil),                  // 2: posts.v1.GetPostRequest
+	(*UpdatePostRequest)(nil),               // 3: posts.v1.UpdatePostRequest
+	(*DeletePostRequest)(nil),               // 4: posts.v1.DeletePostRequest
+	(*UpdateScheduledPostRequest)(nil),      // 5: posts.v1.UpdateScheduledPostRequest
+	(*ScheduledPostRequest)(nil),            // 6: posts.v1.ScheduledPostRequest
+	(*SaveDraftRequest)(nil),                // 7: posts.v1.SaveDraftRequest
+	(*DraftRequest)(nil),                    // 8: posts.v1.DraftRequest
+	(*Draft)(nil),                           // 9: posts.v1.Draft
+	(*ListDraftsResponse)(nil),              // 10: posts.v1.ListDraftsResponse
+	(*VotePollRequest)(nil),                 // 11: posts.v1.VotePollRequest
+	(*ClosePollRequest)(nil),                // 12: posts.v1.ClosePollRequest
+	(*ListPollVotersRequest)(nil),           // 13: posts.v1.ListPollVotersRequest
+	(*ListPollVotersResponse)(nil),          // 14: posts.v1.ListPollVotersResponse
+	(*AddBookmarkRequest)(nil),              // 15: posts.v1.AddBookmarkRequest
+	(*BookmarkRequest)(nil),                 // 16: posts.v1.BookmarkRequest
+	(*ListBookmarksRequest)(nil),            // 17: posts.v1.ListBookmarksRequest
+	(*BookmarkFolder)(nil),                  // 18: posts.v1.BookmarkFolder
+	(*ListBookmarkFoldersResponse)(nil),     // 19: posts.v1.ListBookmarkFoldersResponse
+	(*GetPostRevisionsRequest)(nil),         // 20: posts.v1.GetPostRevisionsRequest
+	(*PostRevision)(nil),                    // 21: posts.v1.PostRevision
+	(*GetPostRevisionsResponse)(nil),        // 22: posts.v1.GetPostRevisionsResponse
+	(*GetThreadRequest)(nil),                // 23: posts.v1.GetThreadRequest
+	(*GetUserPostsRequest)(nil),             // 24: posts.v1.GetUserPostsRequest
+	(*GetGlobalFeedRequest)(nil),            // 25: posts.v1.GetGlobalFeedRequest
+	(*GetHashtagFeedRequest)(nil),           // 26: posts.v1.GetHashtagFeedRequest
+	(*GetMentionsRequest)(nil),              // 27: posts.v1.GetMentionsRequest
+	(*GetTrendingHashtagsRequest)(nil),      // 28: posts.v1.GetTrendingHashtagsRequest
+	(*TrendingHashtag)(nil),                 // 29: posts.v1.TrendingHashtag
+	(*GetTrendingHashtagsResponse)(nil),     // 30: posts.v1.GetTrendingHashtagsResponse
+	(*GetFollowingFeedRequest)(nil),         // 31: posts.v1.GetFollowingFeedRequest
+	(*GetForYouFeedRequest)(nil),            // 32: posts.v1.GetForYouFeedRequest
+	(*GetHomeTimelineRequest)(nil),          // 33: posts.v1.GetHomeTimelineRequest
+	(*FanOutPostRequest)(nil),               // 34: posts.v1.FanOutPostRequest
+	(*BackfillTimelineRequest)(nil),         // 35: posts.v1.BackfillTimelineRequest
+	(*ClearTimelineSourceRequest)(nil),      // 36: posts.v1.ClearTimelineSourceRequest
+	(*SetTimelineCelebrityRequest)(nil),     // 37: posts.v1.SetTimelineCelebrityRequest
+	(*LikePostRequest)(nil),                 // 38: posts.v1.LikePostRequest
+	(*UnlikePostRequest)(nil),               // 39: posts.v1.UnlikePostRequest
+	(*GetLinkPreviewRequest)(nil),           // 40: posts.v1.GetLinkPreviewRequest
+	(*SetLinkPreviewRequest)(nil),           // 41: posts.v1.SetLinkPreviewRequest
+	(*SetContentFlagsRequest)(nil),          // 42: posts.v1.SetContentFlagsRequest
+	(*ImageFlag)(nil),                       // 43: posts.v1.ImageFlag
+	(*ReactToPostRequest)(nil),              // 44: posts.v1.ReactToPostRequest
+	(*RemoveReactionRequest)(nil),           // 45: posts.v1.RemoveReactionRequest
+	(*ListReactionsResponse)(nil),           // 46: posts.v1.ListReactionsResponse
+	(*ListPostLikersRequest)(nil),           // 47: posts.v1.ListPostLikersRequest
+	(*PostLiker)(nil),                       // 48: posts.v1.PostLiker
+	(*ListPostLikersResponse)(nil),          // 49: posts.v1.ListPostLikersResponse
+	(*ReconcileLikesCountResponse)(nil),     // 50: posts.v1.ReconcileLikesCountResponse
+	(*RepostRequest)(nil),                   // 51: posts.v1.RepostRequest
+	(*PinPostRequest)(nil),                  // 52: posts.v1.PinPostRequest
+	(*HandleAccountDeletionRequest)(nil),    // 53: posts.v1.HandleAccountDeletionRequest
+	(*HandleAccountRestorationRequest)(nil), // 54: posts.v1.HandleAccountRestorationRequest
+	(*SearchPostsRequest)(nil),              // 55: posts.v1.SearchPostsRequest
+	(*GetPostsResponse)(nil),                // 56: posts.v1.GetPostsResponse
+	(*GetFeedResponse)(nil),                 // 57: posts.v1.GetFeedResponse
+	(*GetForYouFeedResponse)(nil),           // 58: posts.v1.GetForYouFeedResponse
+	(*SearchPostsResponse)(nil),             // 59: posts.v1.SearchPostsResponse
+	(*GetThreadResponse)(nil),               // 60: posts.v1.GetThreadResponse
+	(*Post)(nil),                            // 61: posts.v1.Post
+	(*LinkPreview)(nil),                     // 62: posts.v1.LinkPreview
+	(*ReactionCount)(nil),                   // 63: posts.v1.ReactionCount
+	(*Poll)(nil),                            // 64: posts.v1.Poll
+	(*PollOption)(nil),                      // 65: posts.v1.PollOption
+	(*Mention)(nil),                         // 66: posts.v1.Mention
+	(*Entity)(nil),                          // 67: posts.v1.Entity
+	(*PostImage)(nil),                       // 68: posts.v1.PostImage
+	(*timestamppb.Timestamp)(nil),           // 69: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 70: google.protobuf.Empty
 }
 var file_posts_v1_posts_proto_depIdxs = []int32{
-	16, // 0: posts.v1.CreatePostRequest.images:type_name -> posts.v1.PostImage
-	16, // 1: posts.v1.UpdatePostRequest.images:type_name -> posts.v1.PostImage
-	17, // 2: posts.v1.GetGlobalFeedRequest.cursor_time:type_name -> google.protobuf.Timestamp
-	17, // 3: posts.v1.GetFollowingFeedRequest.cursor_time:type_name -> google.protobuf.Timestamp
-	15, // 4: posts.v1.GetPostsResponse.posts:type_name -> posts.v1.Post
-	15, // 5: posts.v1.GetFeedResponse.posts:type_name -> posts.v1.Post
-	15, // 6: posts.v1.SearchPostsResponse.posts:type_name -> posts.v1.Post
-	16, // 7: posts.v1.Post.images:type_name -> posts.v1.PostImage
-	17, // 8: posts.v1.Post.created_at:type_name -> google.protobuf.Timestamp
-	17, // 9: posts.v1.Post.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 10: posts.v1.PostService.CreatePost:input_type -> posts.v1.CreatePostRequest
-	1,  // 11: posts.v1.PostService.GetPost:input_type -> posts.v1.GetPostRequest
-	2,  // 12: posts.v1.PostService.UpdatePost:input_type -> posts.v1.UpdatePostRequest
-	3,  // 13: posts.v1.PostService.DeletePost:input_type -> posts.v1.DeletePostRequest
-	4,  // 14: posts.v1.PostService.GetUserPosts:input_type -> posts.v1.GetUserPostsRequest
-	4,  // 15: posts.v1.PostService.GetLikedPosts:input_type -> posts.v1.GetUserPostsRequest
-	5,  // 16: posts.v1.PostService.GetGlobalFeed:input_type -> posts.v1.GetGlobalFeedRequest
-	6,  // 17: posts.v1.PostService.GetFollowingFeed:input_type -> posts.v1.GetFollowingFeedRequest
-	7,  // 18: posts.v1.PostService.LikePost:input_type -> posts.v1.LikePostRequest
-	8,  // 19: posts.v1.PostService.UnlikePost:input_type -> posts.v1.UnlikePostRequest
-	9,  // 20: posts.v1.PostService.HandleAccountDeletion:input_type -> posts.v1.HandleAccountDeletionRequest
-	10, // 21: posts.v1.PostService.HandleAccountRestoration:input_type -> posts.v1.HandleAccountRestorationRequest
-	11, // 22: posts.v1.PostService.SearchPosts:input_type -> posts.v1.SearchPostsRequest
-	15, // 23: posts.v1.PostService.CreatePost:output_type -> posts.v1.Post
-	15, // 24: posts.v1.PostService.GetPost:output_type -> posts.v1.Post
-	18, // 25: posts.v1.PostService.UpdatePost:output_type -> google.protobuf.Empty
-	18, // 26: posts.v1.PostService.DeletePost:output_type -> google.protobuf.Empty
-	12, // 27: posts.v1.PostService.GetUserPosts:output_type -> posts.v1.GetPostsResponse
-	12, // 28: posts.v1.PostService.GetLikedPosts:output_type -> posts.v1.GetPostsResponse
-	13, // 29: posts.v1.PostService.GetGlobalFeed:output_type -> posts.v1.GetFeedResponse
-	13, // 30: posts.v1.PostService.GetFollowingFeed:output_type -> posts.v1.GetFeedResponse
-	18, // 31: posts.v1.PostService.LikePost:output_type -> google.protobuf.Empty
-	18, // 32: posts.v1.PostService.UnlikePost:output_type -> google.protobuf.Empty
-	18, // 33: posts.v1.PostService.HandleAccountDeletion:output_type -> google.protobuf.Empty
-	18, // 34: posts.v1.PostService.HandleAccountRestoration:output_type -> google.protobuf.Empty
-	14, // 35: posts.v1.PostService.SearchPosts:output_type -> posts.v1.SearchPostsResponse
-	23, // [23:36] is the sub-list for method output_type
-	10, // [10:23] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	68,  // 0: posts.v1.CreatePostRequest.images:type_name -> posts.v1.PostImage
+	66,  // 1: posts.v1.CreatePostRequest.mentions:type_name -> posts.v1.Mention
+	69,  // 2: posts.v1.CreatePostRequest.publish_at:type_name -> google.protobuf.Timestamp
+	1,   // 3: posts.v1.CreatePostRequest.poll:type_name -> posts.v1.PollInput
+	69,  // 4: posts.v1.PollInput.ends_at:type_name -> google.protobuf.Timestamp
+	68,  // 5: posts.v1.UpdatePostRequest.images:type_name -> posts.v1.PostImage
+	66,  // 6: posts.v1.UpdatePostRequest.mentions:type_name -> posts.v1.Mention
+	68,  // 7: posts.v1.UpdateScheduledPostRequest.images:type_name -> posts.v1.PostImage
+	66,  // 8: posts.v1.UpdateScheduledPostRequest.mentions:type_name -> posts.v1.Mention
+	69,  // 9: posts.v1.UpdateScheduledPostRequest.publish_at:type_name -> google.protobuf.Timestamp
+	68,  // 10: posts.v1.SaveDraftRequest.images:type_name -> posts.v1.PostImage
+	66,  // 11: posts.v1.SaveDraftRequest.mentions:type_name -> posts.v1.Mention
+	68,  // 12: posts.v1.Draft.images:type_name -> posts.v1.PostImage
+	69,  // 13: posts.v1.Draft.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 14: posts.v1.Draft.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 15: posts.v1.ListDraftsResponse.drafts:type_name -> posts.v1.Draft
+	69,  // 16: posts.v1.ListBookmarksRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	18,  // 17: posts.v1.ListBookmarkFoldersResponse.folders:type_name -> posts.v1.BookmarkFolder
+	68,  // 18: posts.v1.PostRevision.images:type_name -> posts.v1.PostImage
+	67,  // 19: posts.v1.PostRevision.entities:type_name -> posts.v1.Entity
+	69,  // 20: posts.v1.PostRevision.created_at:type_name -> google.protobuf.Timestamp
+	21,  // 21: posts.v1.GetPostRevisionsResponse.revisions:type_name -> posts.v1.PostRevision
+	69,  // 22: posts.v1.GetThreadRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	69,  // 23: posts.v1.GetUserPostsRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	69,  // 24: posts.v1.GetGlobalFeedRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	69,  // 25: posts.v1.GetHashtagFeedRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	69,  // 26: posts.v1.GetMentionsRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	29,  // 27: posts.v1.GetTrendingHashtagsResponse.hashtags:type_name -> posts.v1.TrendingHashtag
+	69,  // 28: posts.v1.GetTrendingHashtagsResponse.computed_at:type_name -> google.protobuf.Timestamp
+	69,  // 29: posts.v1.GetFollowingFeedRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	69,  // 30: posts.v1.GetHomeTimelineRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	62,  // 31: posts.v1.SetLinkPreviewRequest.preview:type_name -> posts.v1.LinkPreview
+	43,  // 32: posts.v1.SetContentFlagsRequest.images:type_name -> posts.v1.ImageFlag
+	69,  // 33: posts.v1.ListPostLikersRequest.cursor_time:type_name -> google.protobuf.Timestamp
+	69,  // 34: posts.v1.PostLiker.liked_at:type_name -> google.protobuf.Timestamp
+	48,  // 35: posts.v1.ListPostLikersResponse.likers:type_name -> posts.v1.PostLiker
+	69,  // 36: posts.v1.ListPostLikersResponse.next_cursor_time:type_name -> google.protobuf.Timestamp
+	61,  // 37: posts.v1.GetPostsResponse.posts:type_name -> posts.v1.Post
+	61,  // 38: posts.v1.GetFeedResponse.posts:type_name -> posts.v1.Post
+	69,  // 39: posts.v1.GetFeedResponse.next_cursor_time:type_name -> google.protobuf.Timestamp
+	61,  // 40: posts.v1.GetForYouFeedResponse.posts:type_name -> posts.v1.Post
+	61,  // 41: posts.v1.SearchPostsResponse.posts:type_name -> posts.v1.Post
+	61,  // 42: posts.v1.GetThreadResponse.post:type_name -> posts.v1.Post
+	61,  // 43: posts.v1.GetThreadResponse.ancestors:type_name -> posts.v1.Post
+	61,  // 44: posts.v1.GetThreadResponse.replies:type_name -> posts.v1.Post
+	68,  // 45: posts.v1.Post.images:type_name -> posts.v1.PostImage
+	69,  // 46: posts.v1.Post.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 47: posts.v1.Post.updated_at:type_name -> google.protobuf.Timestamp
+	61,  // 48: posts.v1.Post.quoted_post:type_name -> posts.v1.Post
+	69,  // 49: posts.v1.Post.reposted_at:type_name -> google.protobuf.Timestamp
+	66,  // 50: posts.v1.Post.mentions:type_name -> posts.v1.Mention
+	67,  // 51: posts.v1.Post.entities:type_name -> posts.v1.Entity
+	69,  // 52: posts.v1.Post.publish_at:type_name -> google.protobuf.Timestamp
+	64,  // 53: posts.v1.Post.poll:type_name -> posts.v1.Poll
+	69,  // 54: posts.v1.Post.bookmarked_at:type_name -> google.protobuf.Timestamp
+	63,  // 55: posts.v1.Post.reactions:type_name -> posts.v1.ReactionCount
+	62,  // 56: posts.v1.Post.link_preview:type_name -> posts.v1.LinkPreview
+	69,  // 57: posts.v1.LinkPreview.fetched_at:type_name -> google.protobuf.Timestamp
+	65,  // 58: posts.v1.Poll.options:type_name -> posts.v1.PollOption
+	69,  // 59: posts.v1.Poll.ends_at:type_name -> google.protobuf.Timestamp
+	0,   // 60: posts.v1.PostService.CreatePost:input_type -> posts.v1.CreatePostRequest
+	2,   // 61: posts.v1.PostService.GetPost:input_type -> posts.v1.GetPostRequest
+	3,   // 62: posts.v1.PostService.UpdatePost:input_type -> posts.v1.UpdatePostRequest
+	4,   // 63: posts.v1.PostService.DeletePost:input_type -> posts.v1.DeletePostRequest
+	23,  // 64: posts.v1.PostService.GetThread:input_type -> posts.v1.GetThreadRequest
+	20,  // 65: posts.v1.PostService.GetPostRevisions:input_type -> posts.v1.GetPostRevisionsRequest
+	70,  // 66: posts.v1.PostService.ListScheduledPosts:input_type -> google.protobuf.Empty
+	5,   // 67: posts.v1.PostService.UpdateScheduledPost:input_type -> posts.v1.UpdateScheduledPostRequest
+	6,   // 68: posts.v1.PostService.CancelScheduledPost:input_type -> posts.v1.ScheduledPostRequest
+	6,   // 69: posts.v1.PostService.PublishScheduledPost:input_type -> posts.v1.ScheduledPostRequest
+	7,   // 70: posts.v1.PostService.SaveDraft:input_type -> posts.v1.SaveDraftRequest
+	70,  // 71: posts.v1.PostService.ListDrafts:input_type -> google.protobuf.Empty
+	8,   // 72: posts.v1.PostService.DeleteDraft:input_type -> posts.v1.DraftRequest
+	8,   // 73: posts.v1.PostService.PublishDraft:input_type -> posts.v1.DraftRequest
+	11,  // 74: posts.v1.PostService.VotePoll:input_type -> posts.v1.VotePollRequest
+	12,  // 75: posts.v1.PostService.ClosePoll:input_type -> posts.v1.ClosePollRequest
+	13,  // 76: posts.v1.PostService.ListPollVoters:input_type -> posts.v1.ListPollVotersRequest
+	40,  // 77: posts.v1.PostService.GetLinkPreview:input_type -> posts.v1.GetLinkPreviewRequest
+	41,  // 78: posts.v1.PostService.SetLinkPreview:input_type -> posts.v1.SetLinkPreviewRequest
+	42,  // 79: posts.v1.PostService.SetContentFlags:input_type -> posts.v1.SetContentFlagsRequest
+	15,  // 80: posts.v1.PostService.AddBookmark:input_type -> posts.v1.AddBookmarkRequest
+	16,  // 81: posts.v1.PostService.RemoveBookmark:input_type -> posts.v1.BookmarkRequest
+	17,  // 82: posts.v1.PostService.ListBookmarks:input_type -> posts.v1.ListBookmarksRequest
+	70,  // 83: posts.v1.PostService.ListBookmarkFolders:input_type -> google.protobuf.Empty
+	24,  // 84: posts.v1.PostService.GetUserPosts:input_type -> posts.v1.GetUserPostsRequest
+	24,  // 85: posts.v1.PostService.GetLikedPosts:input_type -> posts.v1.GetUserPostsRequest
+	25,  // 86: posts.v1.PostService.GetGlobalFeed:input_type -> posts.v1.GetGlobalFeedRequest
+	31,  // 87: posts.v1.PostService.GetFollowingFeed:input_type -> posts.v1.GetFollowingFeedRequest
+	32,  // 88: posts.v1.PostService.GetForYouFeed:input_type -> posts.v1.GetForYouFeedRequest
+	33,  // 89: posts.v1.PostService.GetHomeTimeline:input_type -> posts.v1.GetHomeTimelineRequest
+	34,  // 90: posts.v1.PostService.FanOutPost:input_type -> posts.v1.FanOutPostRequest
+	35,  // 91: posts.v1.PostService.BackfillTimeline:input_type -> posts.v1.BackfillTimelineRequest
+	36,  // 92: posts.v1.PostService.ClearTimelineSource:input_type -> posts.v1.ClearTimelineSourceRequest
+	37,  // 93: posts.v1.PostService.SetTimelineCelebrity:input_type -> posts.v1.SetTimelineCelebrityRequest
+	26,  // 94: posts.v1.PostService.GetHashtagFeed:input_type -> posts.v1.GetHashtagFeedRequest
+	28,  // 95: posts.v1.PostService.GetTrendingHashtags:input_type -> posts.v1.GetTrendingHashtagsRequest
+	27,  // 96: posts.v1.PostService.GetMentions:input_type -> posts.v1.GetMentionsRequest
+	70,  // 97: posts.v1.PostService.RefreshTrendingHashtags:input_type -> google.protobuf.Empty
+	38,  // 98: posts.v1.PostService.LikePost:input_type -> posts.v1.LikePostRequest
+	39,  // 99: posts.v1.PostService.UnlikePost:input_type -> posts.v1.UnlikePostRequest
+	44,  // 100: posts.v1.PostService.ReactToPost:input_type -> posts.v1.ReactToPostRequest
+	45,  // 101: posts.v1.PostService.RemoveReaction:input_type -> posts.v1.RemoveReactionRequest
+	70,  // 102: posts.v1.PostService.ListReactions:input_type -> google.protobuf.Empty
+	70,  // 103: posts.v1.PostService.ReconcileLikesCount:input_type -> google.protobuf.Empty
+	47,  // 104: posts.v1.PostService.ListPostLikers:input_type -> posts.v1.ListPostLikersRequest
+	51,  // 105: posts.v1.PostService.Repost:input_type -> posts.v1.RepostRequest
+	51,  // 106: posts.v1.PostService.UndoRepost:input_type -> posts.v1.RepostRequest
+	52,  // 107: posts.v1.PostService.PinPost:input_type -> posts.v1.PinPostRequest
+	52,  // 108: posts.v1.PostService.UnpinPost:input_type -> posts.v1.PinPostRequest
+	53,  // 109: posts.v1.PostService.HandleAccountDeletion:input_type -> posts.v1.HandleAccountDeletionRequest
+	54,  // 110: posts.v1.PostService.HandleAccountRestoration:input_type -> posts.v1.HandleAccountRestorationRequest
+	55,  // 111: posts.v1.PostService.SearchPosts:input_type -> posts.v1.SearchPostsRequest
+	61,  // 112: posts.v1.PostService.CreatePost:output_type -> posts.v1.Post
+	61,  // 113: posts.v1.PostService.GetPost:output_type -> posts.v1.Post
+	70,  // 114: posts.v1.PostService.UpdatePost:output_type -> google.protobuf.Empty
+	70,  // 115: posts.v1.PostService.DeletePost:output_type -> google.protobuf.Empty
+	60,  // 116: posts.v1.PostService.GetThread:output_type -> posts.v1.GetThreadResponse
+	22,  // 117: posts.v1.PostService.GetPostRevisions:output_type -> posts.v1.GetPostRevisionsResponse
+	56,  // 118: posts.v1.PostService.ListScheduledPosts:output_type -> posts.v1.GetPostsResponse
+	61,  // 119: posts.v1.PostService.UpdateScheduledPost:output_type -> posts.v1.Post
+	70,  // 120: posts.v1.PostService.CancelScheduledPost:output_type -> google.protobuf.Empty
+	61,  // 121: posts.v1.PostService.PublishScheduledPost:output_type -> posts.v1.Post
+	9,   // 122: posts.v1.PostService.SaveDraft:output_type -> posts.v1.Draft
+	10,  // 123: posts.v1.PostService.ListDrafts:output_type -> posts.v1.ListDraftsResponse
+	70,  // 124: posts.v1.PostService.DeleteDraft:output_type -> google.protobuf.Empty
+	61,  // 125: posts.v1.PostService.PublishDraft:output_type -> posts.v1.Post
+	64,  // 126: posts.v1.PostService.VotePoll:output_type -> posts.v1.Poll
+	70,  // 127: posts.v1.PostService.ClosePoll:output_type -> google.protobuf.Empty
+	14,  // 128: posts.v1.PostService.ListPollVoters:output_type -> posts.v1.ListPollVotersResponse
+	62,  // 129: posts.v1.PostService.GetLinkPreview:output_type -> posts.v1.LinkPreview
+	70,  // 130: posts.v1.PostService.SetLinkPreview:output_type -> google.protobuf.Empty
+	61,  // 131: posts.v1.PostService.SetContentFlags:output_type -> posts.v1.Post
+	70,  // 132: posts.v1.PostService.AddBookmark:output_type -> google.protobuf.Empty
+	70,  // 133: posts.v1.PostService.RemoveBookmark:output_type -> google.protobuf.Empty
+	57,  // 134: posts.v1.PostService.ListBookmarks:output_type -> posts.v1.GetFeedResponse
+	19,  // 135: posts.v1.PostService.ListBookmarkFolders:output_type -> posts.v1.ListBookmarkFoldersResponse
+	57,  // 136: posts.v1.PostService.GetUserPosts:output_type -> posts.v1.GetFeedResponse
+	57,  // 137: posts.v1.PostService.GetLikedPosts:output_type -> posts.v1.GetFeedResponse
+	57,  // 138: posts.v1.PostService.GetGlobalFeed:output_type -> posts.v1.GetFeedResponse
+	57,  // 139: posts.v1.PostService.GetFollowingFeed:output_type -> posts.v1.GetFeedResponse
+	58,  // 140: posts.v1.PostService.GetForYouFeed:output_type -> posts.v1.GetForYouFeedResponse
+	57,  // 141: posts.v1.PostService.GetHomeTimeline:output_type -> posts.v1.GetFeedResponse
+	70,  // 142: posts.v1.PostService.FanOutPost:output_type -> google.protobuf.Empty
+	70,  // 143: posts.v1.PostService.BackfillTimeline:output_type -> google.protobuf.Empty
+	70,  // 144: posts.v1.PostService.ClearTimelineSource:output_type -> google.protobuf.Empty
+	70,  // 145: posts.v1.PostService.SetTimelineCelebrity:output_type -> google.protobuf.Empty
+	57,  // 146: posts.v1.PostService.GetHashtagFeed:output_type -> posts.v1.GetFeedResponse
+	30,  // 147: posts.v1.PostService.GetTrendingHashtags:output_type -> posts.v1.GetTrendingHashtagsResponse
+	57,  // 148: posts.v1.PostService.GetMentions:output_type -> posts.v1.GetFeedResponse
+	70,  // 149: posts.v1.PostService.RefreshTrendingHashtags:output_type -> google.protobuf.Empty
+	70,  // 150: posts.v1.PostService.LikePost:output_type -> google.protobuf.Empty
+	70,  // 151: posts.v1.PostService.UnlikePost:output_type -> google.protobuf.Empty
+	70,  // 152: posts.v1.PostService.ReactToPost:output_type -> google.protobuf.Empty
+	70,  // 153: posts.v1.PostService.RemoveReaction:output_type -> google.protobuf.Empty
+	46,  // 154: posts.v1.PostService.ListReactions:output_type -> posts.v1.ListReactionsResponse
+	50,  // 155: posts.v1.PostService.ReconcileLikesCount:output_type -> posts.v1.ReconcileLikesCountResponse
+	49,  // 156: posts.v1.PostService.ListPostLikers:output_type -> posts.v1.ListPostLikersResponse
+	70,  // 157: posts.v1.PostService.Repost:output_type -> google.protobuf.Empty
+	70,  // 158: posts.v1.PostService.UndoRepost:output_type -> google.protobuf.Empty
+	70,  // 159: posts.v1.PostService.PinPost:output_type -> google.protobuf.Empty
+	70,  // 160: posts.v1.PostService.UnpinPost:output_type -> google.protobuf.Empty
+	70,  // 161: posts.v1.PostService.HandleAccountDeletion:output_type -> google.protobuf.Empty
+	70,  // 162: posts.v1.PostService.HandleAccountRestoration:output_type -> google.protobuf.Empty
+	59,  // 163: posts.v1.PostService.SearchPosts:output_type -> posts.v1.SearchPostsResponse
+	112, // [112:164] is the sub-list for method output_type
+	60,  // [60:112] is the sub-list for method input_type
+	60,  // [60:60] is the sub-list for extension type_name
+	60,  // [60:60] is the sub-list for extension extendee
+	0,   // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_posts_v1_posts_proto_init() }
@@ -1086,15 +5021,37 @@ func file_posts_v1_posts_proto_init() {
 	if File_posts_v1_posts_proto != nil {
 		return
 	}
+	file_posts_v1_posts_proto_msgTypes[0].OneofWrappers = []any{}
 	file_posts_v1_posts_proto_msgTypes[5].OneofWrappers = []any{}
-	file_posts_v1_posts_proto_msgTypes[6].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[7].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[9].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[13].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[15].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[17].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[22].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[23].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[24].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[25].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[26].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[27].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[31].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[32].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[33].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[34].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[42].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[47].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[49].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[57].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[61].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[65].OneofWrappers = []any{}
+	file_posts_v1_posts_proto_msgTypes[67].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_posts_v1_posts_proto_rawDesc), len(file_posts_v1_posts_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
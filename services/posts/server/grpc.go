@@ -17,6 +17,9 @@ func SetupGRPCServer(app *bootstrap.Application) *grpc.Server {
 	postHandler := service.NewPostHandler(
 		app.PostUsecase,
 		app.LikeUsecase,
+		app.RepostUsecase,
+		app.BookmarkUsecase,
+		app.HashtagUsecase,
 		app.Logger,
 		app.ContextTimeout,
 	)
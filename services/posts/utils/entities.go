@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+	"voidspace/posts/internal/domain"
+)
+
+const maxCashtagLength = 6
+
+var urlPrefixes = []string{"https://", "http://", "www."}
+
+// startsWord reports whether a token may begin at byte offset i, tokens never
+// start in the middle of a word.
+func startsWord(content string, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(content[:i])
+	return !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// urlEnd returns where a url starting at i ends, trailing punctuation and a
+// closing paren without an opening one are left out.
+func urlEnd(content string, i int) int {
+	end := i
+	for end < len(content) {
+		r, size := utf8.DecodeRuneInString(content[end:])
+		if unicode.IsSpace(r) || strings.ContainsRune(`<>"`, r) {
+			break
+		}
+		end += size
+	}
+
+	for end > i {
+		last := content[end-1]
+		if strings.IndexByte(".,;:!?'", last) >= 0 {
+			end--
+			continue
+		}
+		if last == ')' && strings.Count(content[i:end], "(") < strings.Count(content[i:end], ")") {
+			end--
+			continue
+		}
+		break
+	}
+
+	return end
+}
+
+func urlSpans(content string) [][2]int {
+	spans := make([][2]int, 0)
+
+	for i := 0; i < len(content); i++ {
+		if !startsWord(content, i) {
+			continue
+		}
+
+		for _, prefix := range urlPrefixes {
+			if !hasPrefixFold(content[i:], prefix) {
+				continue
+			}
+
+			end := urlEnd(content, i)
+			host := content[i+len(prefix) : end]
+			if host != "" && host[0] != '.' && host[0] != '/' {
+				spans = append(spans, [2]int{i, end})
+				i = end - 1
+			}
+			break
+		}
+	}
+
+	return spans
+}
+
+func isASCIILetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// cashtagSpans finds tickers like $AAPL or $BRK.A, 1 to 6 letters with an
+// optional one or two letter class suffix. $100 is a price, not a cashtag.
+func cashtagSpans(content string) [][2]int {
+	spans := make([][2]int, 0)
+
+	for i := 0; i < len(content); i++ {
+		if content[i] != '$' || !startsWord(content, i) || i > 0 && content[i-1] == '$' {
+			continue
+		}
+
+		end := i + 1
+		for end < len(content) && isASCIILetter(content[end]) {
+			end++
+		}
+
+		length := end - i - 1
+		if length == 0 || length > maxCashtagLength {
+			continue
+		}
+
+		if end+1 < len(content) && (content[end] == '.' || content[end] == '_') && isASCIILetter(content[end+1]) {
+			suffixEnd := end + 1
+			for suffixEnd < len(content) && isASCIILetter(content[suffixEnd]) {
+				suffixEnd++
+			}
+			if suffixEnd-end-1 <= 2 {
+				end = suffixEnd
+			}
+		}
+
+		if end < len(content) {
+			r, _ := utf8.DecodeRuneInString(content[end:])
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+				continue
+			}
+		}
+
+		spans = append(spans, [2]int{i, end})
+		i = end - 1
+	}
+
+	return spans
+}
+
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
+
+// ExtractEntities tokenizes content into url, mention, hashtag and cashtag
+// spans ordered by position. mentions are the resolved mentions of the post,
+// an @username nobody resolved is plain text. When spans overlap the earlier
+// kind in that list wins, so a #fragment inside a url stays part of the url.
+func ExtractEntities(content string, mentions []domain.Mention) []domain.Entity {
+	entities := make([]domain.Entity, 0)
+
+	overlaps := func(start, end int) bool {
+		for _, e := range entities {
+			if start < e.End && e.Start < end {
+				return true
+			}
+		}
+		return false
+	}
+
+	add := func(kind string, start, end int, userID *int) {
+		if overlaps(start, end) {
+			return
+		}
+		entities = append(entities, domain.Entity{
+			Type:   kind,
+			Text:   content[start:end],
+			Start:  start,
+			End:    end,
+			UserID: userID,
+		})
+	}
+
+	for _, span := range urlSpans(content) {
+		add(domain.EntityURL, span[0], span[1], nil)
+	}
+
+	for _, mention := range mentions {
+		if mention.Start < 0 || mention.End > len(content) || mention.Start >= mention.End {
+			continue
+		}
+		userID := mention.UserID
+		add(domain.EntityMention, mention.Start, mention.End, &userID)
+	}
+
+	for _, span := range hashtagSpans(content) {
+		add(domain.EntityHashtag, span.Start, span.End, nil)
+	}
+
+	for _, span := range cashtagSpans(content) {
+		add(domain.EntityCashtag, span[0], span[1], nil)
+	}
+
+	sort.Slice(entities, func(i, j int) bool { return entities[i].Start < entities[j].Start })
+
+	for i := range entities {
+		entities[i].Utf16Start = utf16Len(content[:entities[i].Start])
+		entities[i].Utf16End = entities[i].Utf16Start + utf16Len(entities[i].Text)
+	}
+
+	return entities
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+	"voidspace/posts/internal/domain"
+)
+
+func TestExtractEntities(t *testing.T) {
+	userID := 7
+
+	tests := []struct {
+		name     string
+		content  string
+		mentions []domain.Mention
+		want     []domain.Entity
+	}{
+		{"none", "just text", nil, []domain.Entity{}},
+		{
+			"all kinds",
+			"@alice see https://x.io/a#b for #go and $AAPL",
+			[]domain.Mention{{UserID: userID, Start: 0, End: 6}},
+			[]domain.Entity{
+				{Type: domain.EntityMention, Text: "@alice", Start: 0, End: 6, Utf16Start: 0, Utf16End: 6, UserID: &userID},
+				{Type: domain.EntityURL, Text: "https://x.io/a#b", Start: 11, End: 27, Utf16Start: 11, Utf16End: 27},
+				{Type: domain.EntityHashtag, Text: "#go", Start: 32, End: 35, Utf16Start: 32, Utf16End: 35},
+				{Type: domain.EntityCashtag, Text: "$AAPL", Start: 40, End: 45, Utf16Start: 40, Utf16End: 45},
+			},
+		},
+		{
+			"unresolved mention is text",
+			"@bob hi",
+			nil,
+			[]domain.Entity{},
+		},
+		{
+			"url trailing punctuation",
+			"(see www.example.com/x).",
+			nil,
+			[]domain.Entity{
+				{Type: domain.EntityURL, Text: "www.example.com/x", Start: 5, End: 22, Utf16Start: 5, Utf16End: 22},
+			},
+		},
+		{
+			"utf16 offsets after emoji",
+			"😀é #tag",
+			nil,
+			[]domain.Entity{
+				{Type: domain.EntityHashtag, Text: "#tag", Start: 7, End: 11, Utf16Start: 4, Utf16End: 8},
+			},
+		},
+		{
+			"cashtag rules",
+			"$BRK.A costs $100, not $TOOLONG or a$B",
+			nil,
+			[]domain.Entity{
+				{Type: domain.EntityCashtag, Text: "$BRK.A", Start: 0, End: 6, Utf16Start: 0, Utf16End: 6},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractEntities(tt.content, tt.mentions)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractEntities(%q) = %+v, want %+v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
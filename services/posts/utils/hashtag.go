@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxHashtagLength   = 100
+	MaxHashtagsPerPost = 10
+)
+
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+// validHashtag reports whether tag (without the #) can be stored, a tag made
+// of digits only is a number like #1 and not a hashtag.
+func validHashtag(tag string) bool {
+	length := 0
+	hasNonDigit := false
+	for _, r := range tag {
+		if !isHashtagRune(r) {
+			return false
+		}
+		if !unicode.IsDigit(r) {
+			hasNonDigit = true
+		}
+		length++
+	}
+
+	return length > 0 && length <= MaxHashtagLength && hasNonDigit
+}
+
+// hashtagSpan is a hashtag found in content, Start and End are byte offsets
+// and include the #.
+type hashtagSpan struct {
+	Tag   string
+	Start int
+	End   int
+}
+
+// hashtagSpans returns every valid hashtag in content. A # only starts a
+// hashtag at the beginning of the content or after a character that cannot
+// be part of a tag, so "a#b" and "##b" are not hashtags.
+func hashtagSpans(content string) []hashtagSpan {
+	spans := make([]hashtagSpan, 0)
+	prev := rune(-1)
+
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if r != '#' || isHashtagRune(prev) || prev == '#' {
+			prev = r
+			i += size
+			continue
+		}
+
+		end := i + size
+		for end < len(content) {
+			next, nextSize := utf8.DecodeRuneInString(content[end:])
+			if !isHashtagRune(next) {
+				break
+			}
+			end += nextSize
+		}
+
+		tag := strings.ToLower(content[i+size : end])
+		if validHashtag(tag) {
+			spans = append(spans, hashtagSpan{Tag: tag, Start: i, End: end})
+		}
+
+		prev, _ = utf8.DecodeLastRuneInString(content[:end])
+		i = end
+	}
+
+	return spans
+}
+
+// ExtractHashtags returns the unique lowercased hashtags in content in order of
+// first appearance, at most MaxHashtagsPerPost.
+func ExtractHashtags(content string) []string {
+	seen := make(map[string]struct{})
+	tags := make([]string, 0)
+
+	for _, span := range hashtagSpans(content) {
+		if len(tags) == MaxHashtagsPerPost {
+			break
+		}
+
+		if _, ok := seen[span.Tag]; ok {
+			continue
+		}
+
+		seen[span.Tag] = struct{}{}
+		tags = append(tags, span.Tag)
+	}
+
+	return tags
+}
+
+// NormalizeHashtag lowercases a tag taken from a request and strips an
+// optional leading #.
+func NormalizeHashtag(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if !validHashtag(tag) {
+		return "", false
+	}
+
+	return tag, true
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"none", "just text", []string{}},
+		{"simple", "hello #World", []string{"world"}},
+		{"punctuation ends tag", "#go, #rust! (#zig)", []string{"go", "rust", "zig"}},
+		{"dedup case insensitive", "#Go #go #GO", []string{"go"}},
+		{"digits only", "we are #1", []string{}},
+		{"digits and letters", "#2024vibes", []string{"2024vibes"}},
+		{"inside word", "email#tag and a##b", []string{}},
+		{"unicode", "#café #日本語", []string{"café", "日本語"}},
+		{"underscore", "#snake_case", []string{"snake_case"}},
+		{"bare hash", "# and #", []string{}},
+		{"too long", "#" + strings.Repeat("a", MaxHashtagLength+1), []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractHashtags(tt.content)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractHashtags(%q) = %v, want %v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractHashtagsLimit(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i < MaxHashtagsPerPost+5; i++ {
+		b.WriteString(" #tag")
+		b.WriteByte(byte('a' + i))
+	}
+
+	if got := ExtractHashtags(b.String()); len(got) != MaxHashtagsPerPost {
+		t.Errorf("got %d hashtags, want %d", len(got), MaxHashtagsPerPost)
+	}
+}
+
+func TestNormalizeHashtag(t *testing.T) {
+	if tag, ok := NormalizeHashtag("#GoLang"); !ok || tag != "golang" {
+		t.Errorf("NormalizeHashtag(#GoLang) = %q, %v", tag, ok)
+	}
+
+	if _, ok := NormalizeHashtag("not a tag"); ok {
+		t.Error("NormalizeHashtag accepted a tag with a space")
+	}
+}
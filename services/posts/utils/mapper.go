@@ -1,45 +1,387 @@
 package utils
 
 import (
+	"time"
 	"voidspace/posts/internal/domain"
 	pb "voidspace/posts/proto/generated/posts/v1"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func MapDomainPostToPb(p *domain.Post) *pb.Post {
-	images := make([]*pb.PostImage, len(p.PostImages))
-	for i, img := range p.PostImages {
+func mapDomainImagesToPb(postImages []domain.PostImage) []*pb.PostImage {
+	images := make([]*pb.PostImage, len(postImages))
+	for i, img := range postImages {
 		images[i] = &pb.PostImage{
-			Url:    img.Url,
-			Order:  int64(img.Order),
-			Width:  int64(img.Width),
-			Height: int64(img.Height),
+			Url:       img.Url,
+			Order:     int64(img.Order),
+			Width:     int64(img.Width),
+			Height:    int64(img.Height),
+			Sensitive: img.Sensitive,
 		}
 	}
+	return images
+}
+
+// mapDomainEntitiesToPb tokenizes content when entities were never stored.
+func mapDomainEntitiesToPb(entities []domain.Entity, content string, mentions []domain.Mention) []*pb.Entity {
+	if entities == nil {
+		entities = ExtractEntities(content, mentions)
+	}
 
-	return &pb.Post{
-		Id:         int64(p.ID),
-		Content:    p.Content,
-		UserId:     int64(p.UserID),
-		Images:     images,
-		LikesCount: int64(p.LikesCount),
-		CreatedAt:  timestamppb.New(p.CreatedAt),
-		UpdatedAt:  timestamppb.New(p.UpdatedAt),
-		IsLiked:    p.IsLiked,
-		IsOwner:    p.IsOwner,
+	pbEntities := make([]*pb.Entity, len(entities))
+	for i, entity := range entities {
+		pbEntities[i] = &pb.Entity{
+			Type:       entity.Type,
+			Text:       entity.Text,
+			Start:      int32(entity.Start),
+			End:        int32(entity.End),
+			Utf16Start: int32(entity.Utf16Start),
+			Utf16End:   int32(entity.Utf16End),
+		}
+		if entity.UserID != nil {
+			userID := int64(*entity.UserID)
+			pbEntities[i].UserId = &userID
+		}
+	}
+	return pbEntities
+}
+
+func mapDomainMentionsToPb(mentions []domain.Mention) []*pb.Mention {
+	pbMentions := make([]*pb.Mention, len(mentions))
+	for i, mention := range mentions {
+		pbMentions[i] = &pb.Mention{
+			UserId: int64(mention.UserID),
+			Start:  int32(mention.Start),
+			End:    int32(mention.End),
+		}
+	}
+	return pbMentions
+}
+
+func mapDomainReactionsToPb(reactions []domain.ReactionCount) []*pb.ReactionCount {
+	pbReactions := make([]*pb.ReactionCount, len(reactions))
+	for i, reaction := range reactions {
+		pbReactions[i] = &pb.ReactionCount{
+			Reaction: reaction.Reaction,
+			Count:    int64(reaction.Count),
+		}
 	}
+
+	return pbReactions
+}
+
+func MapDomainPostToPb(p *domain.Post) *pb.Post {
+	post := &pb.Post{
+		Id:             int64(p.ID),
+		Content:        p.Content,
+		UserId:         int64(p.UserID),
+		Images:         mapDomainImagesToPb(p.PostImages),
+		Entities:       mapDomainEntitiesToPb(p.Entities, p.Content, p.Mentions),
+		LikesCount:     int64(p.LikesCount),
+		RepliesCount:   int64(p.RepliesCount),
+		RepostsCount:   int64(p.RepostsCount),
+		QuotesCount:    int64(p.QuotesCount),
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+		UpdatedAt:      timestamppb.New(p.UpdatedAt),
+		IsLiked:        p.IsLiked,
+		IsOwner:        p.IsOwner,
+		IsReposted:     p.IsReposted,
+		IsBookmarked:   p.IsBookmarked,
+		IsPinned:       p.IsPinned,
+		Edited:         p.EditCount > 0,
+		EditCount:      int64(p.EditCount),
+		Visibility:     p.Visibility,
+		Reactions:      mapDomainReactionsToPb(p.Reactions),
+		ViewerReaction: p.ViewerReaction,
+		ContentWarning: p.ContentWarning,
+	}
+
+	if p.ReplyToPostID != nil {
+		replyTo := int64(*p.ReplyToPostID)
+		post.ReplyToPostId = &replyTo
+	}
+
+	if p.RootPostID != nil {
+		rootID := int64(*p.RootPostID)
+		post.RootPostId = &rootID
+	}
+
+	if p.QuotePostID != nil {
+		quoteID := int64(*p.QuotePostID)
+		post.QuotePostId = &quoteID
+	}
+
+	if p.QuotedPost != nil {
+		post.QuotedPost = MapDomainPostToPb(p.QuotedPost)
+	}
+
+	if len(p.Mentions) > 0 {
+		post.Mentions = mapDomainMentionsToPb(p.Mentions)
+	}
+
+	if p.Poll != nil {
+		post.Poll = MapDomainPollToPb(p.Poll)
+	}
+
+	if p.LinkPreview != nil {
+		post.LinkPreview = MapDomainLinkPreviewToPb(p.LinkPreview)
+	}
+
+	if p.RepostedBy != nil && p.RepostedAt != nil {
+		repostedBy := int64(*p.RepostedBy)
+		post.RepostedBy = &repostedBy
+		post.RepostedAt = timestamppb.New(*p.RepostedAt)
+	}
+
+	if p.BookmarkedAt != nil {
+		post.BookmarkedAt = timestamppb.New(*p.BookmarkedAt)
+		post.BookmarkFolder = p.BookmarkFolder
+	}
+
+	return post
+}
+
+// MapDomainPollToPb maps poll, option tallies are left unset unless
+// TalliesVisible.
+func MapDomainPollToPb(poll *domain.Poll) *pb.Poll {
+	pbPoll := &pb.Poll{
+		Options:        make([]*pb.PollOption, len(poll.Options)),
+		MultipleChoice: poll.MultipleChoice,
+		EndsAt:         timestamppb.New(poll.EndsAt),
+		Closed:         poll.Closed(time.Now()),
+		Voted:          poll.Voted,
+		TalliesVisible: poll.TalliesVisible,
+		VotersCount:    int64(poll.VotersCount),
+	}
+
+	for i, option := range poll.Options {
+		pbPoll.Options[i] = &pb.PollOption{
+			Id:      int64(option.ID),
+			Label:   option.Label,
+			IsVoted: option.IsVoted,
+		}
+
+		if poll.TalliesVisible {
+			votes := int64(option.VotesCount)
+			pbPoll.Options[i].VotesCount = &votes
+		}
+	}
+
+	return pbPoll
+}
+
+// MapPbPollInputToDomain maps the poll sent with a new post, nil when there
+// is none.
+func MapPbPollInputToDomain(input *pb.PollInput) *domain.Poll {
+	if input == nil {
+		return nil
+	}
+
+	poll := &domain.Poll{
+		Options:        make([]domain.PollOption, len(input.GetOptions())),
+		MultipleChoice: input.GetMultipleChoice(),
+		EndsAt:         input.GetEndsAt().AsTime(),
+	}
+
+	for i, label := range input.GetOptions() {
+		poll.Options[i] = domain.PollOption{Label: label}
+	}
+
+	return poll
+}
+
+// FeedCursor returns the keyset cursor after p, reposted entries are ordered
+// by the time of the repost, bookmarks by the time of the bookmark and liked
+// posts by the time of the like.
+func FeedCursor(p *domain.Post) (*timestamppb.Timestamp, int64) {
+	cursorTime := p.CreatedAt
+	if p.RepostedAt != nil {
+		cursorTime = *p.RepostedAt
+	}
+	if p.BookmarkedAt != nil {
+		cursorTime = *p.BookmarkedAt
+	}
+	if p.LikedAt != nil {
+		cursorTime = *p.LikedAt
+	}
+
+	return timestamppb.New(cursorTime), int64(p.ID)
+}
+
+func MapDomainPostsToPb(posts []domain.Post) []*pb.Post {
+	pbPosts := make([]*pb.Post, len(posts))
+	for i := range posts {
+		pbPosts[i] = MapDomainPostToPb(&posts[i])
+	}
+
+	return pbPosts
+}
+
+// MapDomainScheduledPostToPb maps a scheduled post to a Post with publish_at
+// set, its id is the scheduled post id.
+func MapDomainScheduledPostToPb(s *domain.ScheduledPost) *pb.Post {
+	post := &pb.Post{
+		Id:             int64(s.ID),
+		Content:        s.Content,
+		UserId:         int64(s.UserID),
+		Images:         mapDomainImagesToPb(s.PostImages),
+		Mentions:       mapDomainMentionsToPb(s.Mentions),
+		Entities:       mapDomainEntitiesToPb(nil, s.Content, s.Mentions),
+		CreatedAt:      timestamppb.New(s.CreatedAt),
+		UpdatedAt:      timestamppb.New(s.UpdatedAt),
+		PublishAt:      timestamppb.New(s.PublishAt),
+		IsOwner:        true,
+		Visibility:     s.Visibility,
+		ContentWarning: s.ContentWarning,
+	}
+
+	if s.ReplyToPostID != nil {
+		replyTo := int64(*s.ReplyToPostID)
+		post.ReplyToPostId = &replyTo
+	}
+
+	if s.QuotePostID != nil {
+		quoteID := int64(*s.QuotePostID)
+		post.QuotePostId = &quoteID
+	}
+
+	return post
+}
+
+func MapDomainScheduledPostsToPb(scheduled []domain.ScheduledPost) []*pb.Post {
+	pbPosts := make([]*pb.Post, len(scheduled))
+	for i := range scheduled {
+		pbPosts[i] = MapDomainScheduledPostToPb(&scheduled[i])
+	}
+
+	return pbPosts
+}
+
+func MapDomainDraftToPb(d *domain.Draft) *pb.Draft {
+	draft := &pb.Draft{
+		Id:             int64(d.ID),
+		Content:        d.Content,
+		Images:         mapDomainImagesToPb(d.PostImages),
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+		UpdatedAt:      timestamppb.New(d.UpdatedAt),
+		Visibility:     d.Visibility,
+		ContentWarning: d.ContentWarning,
+	}
+
+	if d.ReplyToPostID != nil {
+		replyTo := int64(*d.ReplyToPostID)
+		draft.ReplyToPostId = &replyTo
+	}
+
+	return draft
+}
+
+func MapDomainDraftsToPb(drafts []domain.Draft) []*pb.Draft {
+	pbDrafts := make([]*pb.Draft, len(drafts))
+	for i := range drafts {
+		pbDrafts[i] = MapDomainDraftToPb(&drafts[i])
+	}
+
+	return pbDrafts
+}
+
+// MapDomainRevisionsToPb maps previous versions of a post, a revision without
+// stored entities is tokenized without mentions.
+func MapDomainRevisionsToPb(revisions []domain.PostRevision) []*pb.PostRevision {
+	pbRevisions := make([]*pb.PostRevision, len(revisions))
+	for i, revision := range revisions {
+		pbRevisions[i] = &pb.PostRevision{
+			Version:   int32(revision.Version),
+			Content:   revision.Content,
+			Images:    mapDomainImagesToPb(revision.PostImages),
+			Entities:  mapDomainEntitiesToPb(revision.Entities, revision.Content, nil),
+			CreatedAt: timestamppb.New(revision.CreatedAt),
+		}
+	}
+
+	return pbRevisions
+}
+
+func MapDomainLinkPreviewToPb(preview *domain.LinkPreview) *pb.LinkPreview {
+	return &pb.LinkPreview{
+		Url:         preview.URL,
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+		SiteName:    preview.SiteName,
+		FetchedAt:   timestamppb.New(preview.FetchedAt),
+	}
+}
+
+// MapPbLinkPreviewToDomain maps a preview sent by the gateway, a cached
+// preview sent back keeps its fetched_at.
+func MapPbLinkPreviewToDomain(preview *pb.LinkPreview) *domain.LinkPreview {
+	linkPreview := &domain.LinkPreview{
+		URL:         preview.GetUrl(),
+		Title:       preview.GetTitle(),
+		Description: preview.GetDescription(),
+		ImageURL:    preview.GetImageUrl(),
+		SiteName:    preview.GetSiteName(),
+	}
+
+	if preview.GetFetchedAt() != nil {
+		linkPreview.FetchedAt = preview.GetFetchedAt().AsTime()
+	}
+
+	return linkPreview
+}
+
+// MapDomainLikersToPb maps a page of the users who liked a post.
+func MapDomainLikersToPb(likers []domain.Liker) []*pb.PostLiker {
+	pbLikers := make([]*pb.PostLiker, len(likers))
+	for i, liker := range likers {
+		pbLikers[i] = &pb.PostLiker{
+			UserId:     int64(liker.UserID),
+			LikedAt:    timestamppb.New(liker.LikedAt),
+			IsFollowed: liker.IsFollowed,
+		}
+	}
+
+	return pbLikers
+}
+
+func MapPbMentionsToDomain(pbMentions []*pb.Mention) []domain.Mention {
+	mentions := make([]domain.Mention, len(pbMentions))
+	for i, mention := range pbMentions {
+		mentions[i] = domain.Mention{
+			UserID: int(mention.GetUserId()),
+			Start:  int(mention.GetStart()),
+			End:    int(mention.GetEnd()),
+		}
+	}
+	return mentions
 }
 
 func MapPbPostImageToDomain(pbImages []*pb.PostImage) []domain.PostImage {
 	images := make([]domain.PostImage, len(pbImages))
 	for i, img := range pbImages {
 		images[i] = domain.PostImage{
-			Url:    img.GetUrl(),
-			Order:  int(img.GetOrder()),
-			Width:  int(img.GetWidth()),
-			Height: int(img.GetHeight()),
+			Url:       img.GetUrl(),
+			Order:     int(img.GetOrder()),
+			Width:     int(img.GetWidth()),
+			Height:    int(img.GetHeight()),
+			Sensitive: img.GetSensitive(),
 		}
 	}
 	return images
 }
+
+// MapPbContentFlagsToDomain maps a SetContentFlags request, an unset
+// content_warning stays nil so the current warning is kept.
+func MapPbContentFlagsToDomain(req *pb.SetContentFlagsRequest) *domain.ContentFlags {
+	flags := &domain.ContentFlags{
+		ContentWarning:  req.ContentWarning,
+		SensitiveImages: make(map[int]bool, len(req.GetImages())),
+	}
+
+	for _, image := range req.GetImages() {
+		flags.SensitiveImages[int(image.GetOrder())] = image.GetSensitive()
+	}
+
+	return flags
+}
@@ -8,10 +8,18 @@ import (
 	"voidspace/users/config"
 	"voidspace/users/internal/domain"
 	follow_repository "voidspace/users/internal/repository/follow"
+	invite_repository "voidspace/users/internal/repository/invite"
 	profile_repository "voidspace/users/internal/repository/profile"
+	security_event_repository "voidspace/users/internal/repository/security_event"
+	session_repository "voidspace/users/internal/repository/session"
+	settings_repository "voidspace/users/internal/repository/settings"
 	user_repository "voidspace/users/internal/repository/user"
 	follow_usecase "voidspace/users/internal/usecase/follow"
+	invite_usecase "voidspace/users/internal/usecase/invite"
 	profile_usecase "voidspace/users/internal/usecase/profile"
+	security_event_usecase "voidspace/users/internal/usecase/security_event"
+	session_usecase "voidspace/users/internal/usecase/session"
+	settings_usecase "voidspace/users/internal/usecase/settings"
 	user_usecase "voidspace/users/internal/usecase/user"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -31,9 +39,13 @@ type Application struct {
 	DB                   *pgxpool.Pool
 	// InstanceConnectionString string
 	// use cases
-	FollowUsecase  domain.FollowUsecase
-	ProfileUsecase domain.ProfileUsecase
-	UserUsecase    domain.UserUsecase
+	FollowUsecase        domain.FollowUsecase
+	ProfileUsecase       domain.ProfileUsecase
+	UserUsecase          domain.UserUsecase
+	SessionUsecase       domain.SessionUsecase
+	SecurityEventUsecase domain.SecurityEventUsecase
+	SettingsUsecase      domain.SettingsUsecase
+	InviteUsecase        domain.InviteUsecase
 }
 
 func App() (*Application, error) {
@@ -55,6 +67,12 @@ func App() (*Application, error) {
 
 	cfg := config.GetConfig()
 
+	registrationMode, err := domain.ParseRegistrationMode(cfg.RegistrationMode)
+	if err != nil {
+		logger.Error("Invalid registration mode", zap.Error(err))
+		return nil, err
+	}
+
 	privateKey, err := config.LoadPrivateKey(cfg.SecretPath)
 	if err != nil {
 		logger.Error("Failed to load private key", zap.Error(err))
@@ -73,10 +91,18 @@ func App() (*Application, error) {
 	userRepository := user_repository.NewUserRepository(db)
 	profileRepository := profile_repository.NewProfileRepository(db)
 	followRepository := follow_repository.NewFollowRepository(db)
+	sessionRepository := session_repository.NewSessionRepository(db)
+	securityEventRepository := security_event_repository.NewSecurityEventRepository(db)
+	settingsRepository := settings_repository.NewSettingsRepository(db)
+	inviteRepository := invite_repository.NewInviteRepository(db)
 
-	userUsecase := user_usecase.NewUserUsecase(userRepository, followRepository, time.Duration(cfg.ContextTimeout)*time.Second)
+	userUsecase := user_usecase.NewUserUsecase(userRepository, followRepository, profileRepository, registrationMode, time.Duration(cfg.ContextTimeout)*time.Second)
 	profileUsecase := profile_usecase.NewProfileUsecase(profileRepository, time.Duration(cfg.ContextTimeout)*time.Second)
 	followUsecase := follow_usecase.NewFollowUsecase(followRepository, time.Duration(cfg.ContextTimeout)*time.Second)
+	sessionUsecase := session_usecase.NewSessionUsecase(sessionRepository, time.Duration(cfg.ContextTimeout)*time.Second)
+	securityEventUsecase := security_event_usecase.NewSecurityEventUsecase(securityEventRepository, userRepository, time.Duration(cfg.ContextTimeout)*time.Second)
+	settingsUsecase := settings_usecase.NewSettingsUsecase(settingsRepository, time.Duration(cfg.ContextTimeout)*time.Second)
+	inviteUsecase := invite_usecase.NewInviteUsecase(inviteRepository, registrationMode, time.Duration(cfg.ContextTimeout)*time.Second)
 
 	return &Application{
 		Config:               cfg,
@@ -89,5 +115,9 @@ func App() (*Application, error) {
 		UserUsecase:          userUsecase,
 		ProfileUsecase:       profileUsecase,
 		FollowUsecase:        followUsecase,
+		SessionUsecase:       sessionUsecase,
+		SecurityEventUsecase: securityEventUsecase,
+		SettingsUsecase:      settingsUsecase,
+		InviteUsecase:        inviteUsecase,
 	}, nil
 }
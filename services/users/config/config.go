@@ -15,6 +15,7 @@ type Config struct {
 	AccessTokenDuration  int
 	RefreshTokenDuration int
 	SecretPath           string
+	RegistrationMode     string
 }
 
 var (
@@ -38,5 +39,6 @@ func initConfig() Config {
 		AccessTokenDuration:  helper.GetEnvInt("ACCESS_TOKEN_DURATION", 30),
 		RefreshTokenDuration: helper.GetEnvInt("REFRESH_TOKEN_DURATION", 7),
 		SecretPath:           helper.GetEnv("SECRET_PATH", "/etc/secrets/private-key"),
+		RegistrationMode:     helper.GetEnv("REGISTRATION_MODE", "open"),
 	}
 }
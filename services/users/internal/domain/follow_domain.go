@@ -14,10 +14,13 @@ type Follow struct {
 type FollowUsecase interface {
 	Follow(ctx context.Context, authUserID int, targetUserID int) error
 	Unfollow(ctx context.Context, authUserID int, targetUserID int) error
+	// FilterFollowing returns the ids in targetUserIDs that userID follows
+	FilterFollowing(ctx context.Context, userID int, targetUserIDs []int) ([]int, error)
 }
 
 type FollowRepository interface {
 	Follow(ctx context.Context, updates *Follow) error
 	Unfollow(ctx context.Context, updates *Follow) error
 	IsFollowing(ctx context.Context, userID, targetUserID int) (bool, error)
+	GetFollowedAmong(ctx context.Context, userID int, targetUserIDs []int) ([]int, error)
 }
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type RegistrationMode string
+
+const (
+	RegistrationOpen   RegistrationMode = "open"
+	RegistrationInvite RegistrationMode = "invite"
+	RegistrationClosed RegistrationMode = "closed"
+)
+
+func ParseRegistrationMode(mode string) (RegistrationMode, error) {
+	switch RegistrationMode(mode) {
+	case RegistrationOpen, RegistrationInvite, RegistrationClosed:
+		return RegistrationMode(mode), nil
+	default:
+		return "", fmt.Errorf("unknown registration mode %q", mode)
+	}
+}
+
+// Limits for invites issued by regular users, admins are not bound by them.
+const (
+	MaxUserInviteUses    = 5
+	MaxUserActiveInvites = 10
+	DefaultInviteTTL     = 7 * 24 * time.Hour
+	MaxUserInviteTTL     = 30 * 24 * time.Hour
+)
+
+// Invite is a registration code. IssuerID is nil for invites issued by an admin.
+type Invite struct {
+	ID        int
+	Code      string
+	IssuerID  *int
+	MaxUses   int
+	Uses      int
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+
+	Redemptions []InviteRedemption `db:"-"`
+}
+
+// InviteRedemption records who joined through which invite.
+type InviteRedemption struct {
+	InviteID   int
+	UserID     int
+	Username   string
+	RedeemedAt time.Time
+}
+
+type InviteFilter struct {
+	IssuerID *int
+	CursorID int
+	Limit    int
+}
+
+type InviteUsecase interface {
+	RegistrationMode() RegistrationMode
+	JoinWaitlist(ctx context.Context, email string) error
+	// CreateInvite with a nil issuerID creates an admin invite.
+	CreateInvite(ctx context.Context, issuerID *int, maxUses int, expiresAt *time.Time) (*Invite, error)
+	ListInvites(ctx context.Context, filter *InviteFilter) ([]Invite, bool, error)
+}
+
+type InviteRepository interface {
+	Create(ctx context.Context, invite *Invite) error
+	CountActiveByIssuer(ctx context.Context, issuerID int) (int, error)
+	List(ctx context.Context, filter *InviteFilter) ([]Invite, error)
+	GetRedemptions(ctx context.Context, inviteIDs []int) ([]InviteRedemption, error)
+	AddToWaitlist(ctx context.Context, email string) error
+}
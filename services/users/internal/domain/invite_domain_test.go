@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRegistrationMode(t *testing.T) {
+	testCases := []struct {
+		mode        string
+		expected    RegistrationMode
+		shouldError bool
+	}{
+		{mode: "open", expected: RegistrationOpen},
+		{mode: "invite", expected: RegistrationInvite},
+		{mode: "closed", expected: RegistrationClosed},
+		{mode: "", shouldError: true},
+		{mode: "Open", shouldError: true},
+		{mode: "waitlist", shouldError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.mode, func(t *testing.T) {
+			mode, err := ParseRegistrationMode(tc.mode)
+			if tc.shouldError {
+				assert.Error(t, err)
+				assert.Empty(t, mode)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, mode)
+		})
+	}
+}
@@ -3,13 +3,16 @@ package domain
 import "github.com/golang-jwt/jwt/v5"
 
 type AccessTokenClaims struct {
-	ID       string
-	Username string
+	ID        string
+	Username  string
+	SessionID string
 	jwt.RegisteredClaims
 }
 
 type RefreshTokenClaims struct {
-	ID       string
-	Username string
+	ID          string
+	Username    string
+	SessionID   string
+	TokenFamily string
 	jwt.RegisteredClaims
 }
@@ -1,6 +1,22 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+	"voidspace/users/internal/domain/views"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+const (
+	MaxProfileLinks     = 5
+	MaxProfileLinkLabel = 30
+	MaxProfileLinkURL   = 255
+	MaxPronouns         = 40
+)
 
 type Profile struct {
 	UserID      int
@@ -9,12 +25,68 @@ type Profile struct {
 	AvatarUrl   string
 	BannerUrl   string
 	Location    string
+
+	// nil leaves the stored value untouched
+	Pronouns           *string
+	Birthday           *string
+	BirthdayVisibility *string
+	Links              []views.ProfileLink
+}
+
+func (p *Profile) Validate() error {
+	if p.Pronouns != nil && utf8.RuneCountInString(*p.Pronouns) > MaxPronouns {
+		return fmt.Errorf("%w: pronouns must be at most %d characters", constants.ErrInvalidProfile, MaxPronouns)
+	}
+
+	if p.Birthday != nil && *p.Birthday != "" {
+		birthday, err := time.Parse(time.DateOnly, *p.Birthday)
+		if err != nil {
+			return fmt.Errorf("%w: birthday must be YYYY-MM-DD", constants.ErrInvalidProfile)
+		}
+		if birthday.After(time.Now()) {
+			return fmt.Errorf("%w: birthday cannot be in the future", constants.ErrInvalidProfile)
+		}
+	}
+
+	if p.BirthdayVisibility != nil {
+		switch *p.BirthdayVisibility {
+		case views.BirthdayPublic, views.BirthdayFollowers, views.BirthdayPrivate:
+		default:
+			return fmt.Errorf("%w: unknown birthday_visibility %q", constants.ErrInvalidProfile, *p.BirthdayVisibility)
+		}
+	}
+
+	if len(p.Links) > MaxProfileLinks {
+		return fmt.Errorf("%w: at most %d links are allowed", constants.ErrInvalidProfile, MaxProfileLinks)
+	}
+
+	seen := make(map[string]bool, len(p.Links))
+	for _, link := range p.Links {
+		if link.Label == "" || utf8.RuneCountInString(link.Label) > MaxProfileLinkLabel {
+			return fmt.Errorf("%w: link label must be 1-%d characters", constants.ErrInvalidProfile, MaxProfileLinkLabel)
+		}
+
+		parsed, err := url.Parse(link.URL)
+		if err != nil || len(link.URL) > MaxProfileLinkURL || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("%w: invalid link url %q", constants.ErrInvalidProfile, link.URL)
+		}
+
+		if seen[link.URL] {
+			return fmt.Errorf("%w: duplicate link url %q", constants.ErrInvalidProfile, link.URL)
+		}
+		seen[link.URL] = true
+	}
+
+	return nil
 }
 
 type ProfileUsecase interface {
 	UpdateProfile(ctx context.Context, userID int, updates *Profile) error
+	SetLinkVerified(ctx context.Context, userID int, linkURL string, verified bool) error
 }
 
 type ProfileRepository interface {
 	Update(ctx context.Context, userID int, profile *Profile) error
+	GetLinks(ctx context.Context, userID int) ([]views.ProfileLink, error)
+	SetLinkVerified(ctx context.Context, userID int, linkURL string, verified bool) error
 }
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"voidspace/users/internal/domain/views"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func TestProfileValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	links := func(urls ...string) []views.ProfileLink {
+		result := make([]views.ProfileLink, len(urls))
+		for i, u := range urls {
+			result[i] = views.ProfileLink{Label: "site", URL: u}
+		}
+		return result
+	}
+
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(time.DateOnly)
+
+	testCases := []struct {
+		name        string
+		profile     Profile
+		shouldError bool
+	}{
+		{name: "Empty profile", profile: Profile{}},
+		{name: "Pronouns at the limit", profile: Profile{Pronouns: str(strings.Repeat("é", MaxPronouns))}},
+		{name: "Pronouns too long", profile: Profile{Pronouns: str(strings.Repeat("a", MaxPronouns+1))}, shouldError: true},
+		{name: "Birthday in the past", profile: Profile{Birthday: str("1990-02-28")}},
+		{name: "Birthday cleared", profile: Profile{Birthday: str("")}},
+		{name: "Birthday in the future", profile: Profile{Birthday: str(tomorrow)}, shouldError: true},
+		{name: "Birthday not a date", profile: Profile{Birthday: str("28/02/1990")}, shouldError: true},
+		{name: "Birthday visibility followers", profile: Profile{BirthdayVisibility: str(views.BirthdayFollowers)}},
+		{name: "Unknown birthday visibility", profile: Profile{BirthdayVisibility: str("friends")}, shouldError: true},
+		{
+			name:    "Links at the limit",
+			profile: Profile{Links: links("https://a.example", "https://b.example", "https://c.example", "https://d.example", "http://e.example")},
+		},
+		{
+			name:        "Too many links",
+			profile:     Profile{Links: links("https://a.example", "https://b.example", "https://c.example", "https://d.example", "https://e.example", "https://f.example")},
+			shouldError: true,
+		},
+		{name: "Duplicate link", profile: Profile{Links: links("https://a.example", "https://a.example")}, shouldError: true},
+		{name: "Non http scheme", profile: Profile{Links: links("javascript:alert(1)")}, shouldError: true},
+		{name: "Ftp scheme", profile: Profile{Links: links("ftp://a.example")}, shouldError: true},
+		{name: "Missing host", profile: Profile{Links: links("https:///path")}, shouldError: true},
+		{name: "Relative url", profile: Profile{Links: links("/profile/alice")}, shouldError: true},
+		{name: "Url too long", profile: Profile{Links: links("https://a.example/" + strings.Repeat("a", MaxProfileLinkURL))}, shouldError: true},
+		{name: "Empty label", profile: Profile{Links: []views.ProfileLink{{URL: "https://a.example"}}}, shouldError: true},
+		{
+			name:        "Label too long",
+			profile:     Profile{Links: []views.ProfileLink{{Label: strings.Repeat("a", MaxProfileLinkLabel+1), URL: "https://a.example"}}},
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.profile.Validate()
+			if tc.shouldError {
+				assert.ErrorIs(t, err, constants.ErrInvalidProfile)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	SecurityEventLoginSuccess    = "login_success"
+	SecurityEventLoginFailed     = "login_failed"
+	SecurityEventRegister        = "register"
+	SecurityEventProfileUpdated  = "profile_updated"
+	SecurityEventAccountDeleted  = "account_deleted"
+	SecurityEventAccountRestored = "account_restored"
+)
+
+// SecurityEvent is an append-only audit record. UserID is nil when the actor
+// could not be resolved, e.g. a failed login for an unknown account.
+type SecurityEvent struct {
+	ID        int
+	UserID    *int
+	EventType string
+	IPAddress string
+	UserAgent string
+	Detail    string
+	CreatedAt time.Time
+}
+
+type SecurityEventFilter struct {
+	UserID    *int
+	EventType string
+	Since     *time.Time
+	Until     *time.Time
+	CursorID  int
+	Limit     int
+}
+
+type SecurityEventUsecase interface {
+	Record(ctx context.Context, event *SecurityEvent) error
+	RecordFailedLogin(ctx context.Context, credentials string, event *SecurityEvent) error
+	ListUserEvents(ctx context.Context, userID int, cursorID int) ([]SecurityEvent, bool, error)
+	QueryEvents(ctx context.Context, filter *SecurityEventFilter) ([]SecurityEvent, bool, error)
+}
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *SecurityEvent) error
+	Query(ctx context.Context, filter *SecurityEventFilter) ([]SecurityEvent, error)
+}
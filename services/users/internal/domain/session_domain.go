@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Session struct {
+	ID          int
+	UserID      int
+	TokenFamily string
+	UserAgent   string
+	IPAddress   string
+	CreatedAt   time.Time
+	LastSeenAt  time.Time
+	RevokedAt   *time.Time
+}
+
+type SessionUsecase interface {
+	CreateSession(ctx context.Context, userID int, userAgent, ipAddress string) (*Session, error)
+	ValidateSession(ctx context.Context, userID, sessionID int, tokenFamily string) error
+	ListSessions(ctx context.Context, userID int) ([]Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID int) error
+	RevokeAllOtherSessions(ctx context.Context, userID, currentSessionID int) (int, error)
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *Session) error
+	GetByID(ctx context.Context, sessionID int) (*Session, error)
+	Touch(ctx context.Context, sessionID int) error
+	ListActiveByUserID(ctx context.Context, userID int) ([]Session, error)
+	Revoke(ctx context.Context, userID, sessionID int) error
+	RevokeAllExcept(ctx context.Context, userID, sessionID int) (int, error)
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type PostVisibility string
+type InteractionPolicy string
+type SensitiveMediaDisplay string
+
+const (
+	VisibilityPublic    PostVisibility = "public"
+	VisibilityFollowers PostVisibility = "followers"
+	VisibilityMentioned PostVisibility = "mentioned"
+	VisibilityUnlisted  PostVisibility = "unlisted"
+
+	PolicyEveryone  InteractionPolicy = "everyone"
+	PolicyFollowing InteractionPolicy = "following"
+	PolicyMentioned InteractionPolicy = "mentioned"
+	PolicyNobody    InteractionPolicy = "nobody"
+
+	SensitiveMediaShow SensitiveMediaDisplay = "show"
+	SensitiveMediaBlur SensitiveMediaDisplay = "blur"
+	SensitiveMediaHide SensitiveMediaDisplay = "hide"
+)
+
+// settings schema, kept in line with the user_settings check constraints
+var (
+	allowedPostVisibility = map[PostVisibility]bool{
+		VisibilityPublic: true, VisibilityFollowers: true, VisibilityMentioned: true, VisibilityUnlisted: true,
+	}
+	allowedReplyPolicy = map[InteractionPolicy]bool{
+		PolicyEveryone: true, PolicyFollowing: true, PolicyMentioned: true, PolicyNobody: true,
+	}
+	allowedMentionPolicy = map[InteractionPolicy]bool{
+		PolicyEveryone: true, PolicyFollowing: true, PolicyNobody: true,
+	}
+	allowedSensitiveMedia = map[SensitiveMediaDisplay]bool{
+		SensitiveMediaShow: true, SensitiveMediaBlur: true, SensitiveMediaHide: true,
+	}
+	languageTag = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})?$`)
+)
+
+type Settings struct {
+	UserID                int
+	DefaultPostVisibility PostVisibility
+	ReplyPolicy           InteractionPolicy
+	MentionPolicy         InteractionPolicy
+	NotifyLikes           bool
+	NotifyComments        bool
+	NotifyFollows         bool
+	NotifyMentions        bool
+	Language              string
+	SensitiveMedia        SensitiveMediaDisplay
+	UpdatedAt             time.Time
+}
+
+// SettingsUpdate holds a partial update, nil fields are left untouched.
+type SettingsUpdate struct {
+	DefaultPostVisibility *PostVisibility
+	ReplyPolicy           *InteractionPolicy
+	MentionPolicy         *InteractionPolicy
+	NotifyLikes           *bool
+	NotifyComments        *bool
+	NotifyFollows         *bool
+	NotifyMentions        *bool
+	Language              *string
+	SensitiveMedia        *SensitiveMediaDisplay
+}
+
+// DefaultSettings is what a user gets until they save settings of their own.
+func DefaultSettings(userID int) Settings {
+	return Settings{
+		UserID:                userID,
+		DefaultPostVisibility: VisibilityPublic,
+		ReplyPolicy:           PolicyEveryone,
+		MentionPolicy:         PolicyEveryone,
+		NotifyLikes:           true,
+		NotifyComments:        true,
+		NotifyFollows:         true,
+		NotifyMentions:        true,
+		Language:              "en",
+		SensitiveMedia:        SensitiveMediaBlur,
+	}
+}
+
+func (s *Settings) Apply(update *SettingsUpdate) {
+	if update.DefaultPostVisibility != nil {
+		s.DefaultPostVisibility = *update.DefaultPostVisibility
+	}
+	if update.ReplyPolicy != nil {
+		s.ReplyPolicy = *update.ReplyPolicy
+	}
+	if update.MentionPolicy != nil {
+		s.MentionPolicy = *update.MentionPolicy
+	}
+	if update.NotifyLikes != nil {
+		s.NotifyLikes = *update.NotifyLikes
+	}
+	if update.NotifyComments != nil {
+		s.NotifyComments = *update.NotifyComments
+	}
+	if update.NotifyFollows != nil {
+		s.NotifyFollows = *update.NotifyFollows
+	}
+	if update.NotifyMentions != nil {
+		s.NotifyMentions = *update.NotifyMentions
+	}
+	if update.Language != nil {
+		s.Language = *update.Language
+	}
+	if update.SensitiveMedia != nil {
+		s.SensitiveMedia = *update.SensitiveMedia
+	}
+}
+
+func (s *Settings) Validate() error {
+	switch {
+	case !allowedPostVisibility[s.DefaultPostVisibility]:
+		return fmt.Errorf("%w: unknown default_post_visibility %q", constants.ErrInvalidSettings, s.DefaultPostVisibility)
+	case !allowedReplyPolicy[s.ReplyPolicy]:
+		return fmt.Errorf("%w: unknown reply_policy %q", constants.ErrInvalidSettings, s.ReplyPolicy)
+	case !allowedMentionPolicy[s.MentionPolicy]:
+		return fmt.Errorf("%w: unknown mention_policy %q", constants.ErrInvalidSettings, s.MentionPolicy)
+	case !languageTag.MatchString(s.Language):
+		return fmt.Errorf("%w: invalid language %q", constants.ErrInvalidSettings, s.Language)
+	case !allowedSensitiveMedia[s.SensitiveMedia]:
+		return fmt.Errorf("%w: unknown sensitive_media %q", constants.ErrInvalidSettings, s.SensitiveMedia)
+	}
+
+	return nil
+}
+
+type SettingsUsecase interface {
+	GetSettings(ctx context.Context, userID int) (*Settings, error)
+	UpdateSettings(ctx context.Context, userID int, update *SettingsUpdate) (*Settings, error)
+	GetSettingsByUserIDs(ctx context.Context, userIDs []int) ([]Settings, error)
+}
+
+type SettingsRepository interface {
+	GetByUserIDs(ctx context.Context, userIDs []int) ([]Settings, error)
+	Upsert(ctx context.Context, settings *Settings) error
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+const settingsMigration = "../../../../shared/utils/database/migrations/user/000010_vs_create_user_settings.up.sql"
+
+func TestSettingsValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		change      func(s *Settings)
+		shouldError bool
+	}{
+		{name: "Defaults", change: func(s *Settings) {}},
+		{name: "Followers visibility", change: func(s *Settings) { s.DefaultPostVisibility = VisibilityFollowers }},
+		{name: "Unknown visibility", change: func(s *Settings) { s.DefaultPostVisibility = "private" }, shouldError: true},
+		{name: "Reply policy mentioned", change: func(s *Settings) { s.ReplyPolicy = PolicyMentioned }},
+		{name: "Unknown reply policy", change: func(s *Settings) { s.ReplyPolicy = "friends" }, shouldError: true},
+		{name: "Mention policy nobody", change: func(s *Settings) { s.MentionPolicy = PolicyNobody }},
+		{name: "Mention policy mentioned", change: func(s *Settings) { s.MentionPolicy = PolicyMentioned }, shouldError: true},
+		{name: "Language with region", change: func(s *Settings) { s.Language = "pt-BR" }},
+		{name: "Three letter language", change: func(s *Settings) { s.Language = "fil" }},
+		{name: "Empty language", change: func(s *Settings) { s.Language = "" }, shouldError: true},
+		{name: "Upper case language", change: func(s *Settings) { s.Language = "EN" }, shouldError: true},
+		{name: "Language with underscore", change: func(s *Settings) { s.Language = "pt_BR" }, shouldError: true},
+		{name: "Hide sensitive media", change: func(s *Settings) { s.SensitiveMedia = SensitiveMediaHide }},
+		{name: "Unknown sensitive media", change: func(s *Settings) { s.SensitiveMedia = "censor" }, shouldError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			settings := DefaultSettings(1)
+			tc.change(&settings)
+
+			err := settings.Validate()
+			if tc.shouldError {
+				assert.ErrorIs(t, err, constants.ErrInvalidSettings)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSettingsApply(t *testing.T) {
+	followers := VisibilityFollowers
+	nobody := PolicyNobody
+	off := false
+	language := "de"
+	hide := SensitiveMediaHide
+
+	testCases := []struct {
+		name     string
+		update   SettingsUpdate
+		expected func(s *Settings)
+	}{
+		{name: "Empty update", update: SettingsUpdate{}, expected: func(s *Settings) {}},
+		{
+			name:     "Visibility only",
+			update:   SettingsUpdate{DefaultPostVisibility: &followers},
+			expected: func(s *Settings) { s.DefaultPostVisibility = followers },
+		},
+		{
+			name:   "Policies",
+			update: SettingsUpdate{ReplyPolicy: &nobody, MentionPolicy: &nobody},
+			expected: func(s *Settings) {
+				s.ReplyPolicy = nobody
+				s.MentionPolicy = nobody
+			},
+		},
+		{
+			name:   "Notifications",
+			update: SettingsUpdate{NotifyLikes: &off, NotifyComments: &off, NotifyFollows: &off, NotifyMentions: &off},
+			expected: func(s *Settings) {
+				s.NotifyLikes, s.NotifyComments, s.NotifyFollows, s.NotifyMentions = false, false, false, false
+			},
+		},
+		{
+			name:   "Language and media",
+			update: SettingsUpdate{Language: &language, SensitiveMedia: &hide},
+			expected: func(s *Settings) {
+				s.Language = language
+				s.SensitiveMedia = hide
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			settings := DefaultSettings(1)
+			expected := DefaultSettings(1)
+			tc.expected(&expected)
+
+			settings.Apply(&tc.update)
+
+			assert.Equal(t, expected, settings)
+		})
+	}
+}
+
+// TestSettingsMatchMigration keeps the allowed values in line with the
+// user_settings check constraints.
+func TestSettingsMatchMigration(t *testing.T) {
+	raw, err := os.ReadFile(settingsMigration)
+	require.NoError(t, err)
+	migration := string(raw)
+
+	checkValues := func(column string) []string {
+		re := regexp.MustCompile(`CHECK \(` + column + ` IN \(([^)]*)\)\)`)
+		match := re.FindStringSubmatch(migration)
+		require.NotNil(t, match, "no check constraint on %s", column)
+
+		var values []string
+		for _, value := range strings.Split(match[1], ",") {
+			values = append(values, strings.Trim(strings.TrimSpace(value), "'"))
+		}
+		slices.Sort(values)
+		return values
+	}
+
+	assert.Equal(t, checkValues("default_post_visibility"), sortedKeys(allowedPostVisibility))
+	assert.Equal(t, checkValues("reply_policy"), sortedKeys(allowedReplyPolicy))
+	assert.Equal(t, checkValues("mention_policy"), sortedKeys(allowedMentionPolicy))
+	assert.Equal(t, checkValues("sensitive_media"), sortedKeys(allowedSensitiveMedia))
+
+	defaults := DefaultSettings(1)
+	for column, value := range map[string]string{
+		"default_post_visibility": string(defaults.DefaultPostVisibility),
+		"reply_policy":            string(defaults.ReplyPolicy),
+		"mention_policy":          string(defaults.MentionPolicy),
+		"language":                defaults.Language,
+		"sensitive_media":         string(defaults.SensitiveMedia),
+	} {
+		assert.Regexp(t, column+` VARCHAR\(\d+\) NOT NULL DEFAULT '`+value+`'`, migration)
+	}
+
+	// the longest tag the pattern accepts must fit the column
+	length := regexp.MustCompile(`language VARCHAR\((\d+)\)`).FindStringSubmatch(migration)
+	require.NotNil(t, length)
+	longest := "abc-ABCDEFGH"
+	assert.True(t, languageTag.MatchString(longest))
+	assert.False(t, languageTag.MatchString(longest+"I"))
+	columnLength, err := strconv.Atoi(length[1])
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(longest), columnLength)
+}
+
+func sortedKeys[K ~string](allowed map[K]bool) []string {
+	values := make([]string, 0, len(allowed))
+	for value := range allowed {
+		values = append(values, string(value))
+	}
+	slices.Sort(values)
+	return values
+}
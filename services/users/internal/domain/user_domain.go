@@ -18,12 +18,13 @@ type User struct {
 type UserUsecase interface {
 	// Auth
 	Login(ctx context.Context, credentials, password string) (*User, error)
-	Register(ctx context.Context, username, email, password string) (*User, error)
+	Register(ctx context.Context, username, email, password, inviteCode string) (*User, error)
 
 	GetCurrentUser(ctx context.Context, userID int) (*views.UserProfile, error)
 	GetUser(ctx context.Context, username string, authUserID int) (*views.UserProfile, error)
 	GetUserByID(ctx context.Context, userID int) (*views.UserProfile, error)
 	GetUserByIDs(ctx context.Context, userIDs []int) ([]views.UserProfile, error)
+	GetUsersByUsernames(ctx context.Context, usernames []string) ([]views.UserBanner, error)
 
 	ListFollowers(ctx context.Context, userID int) ([]views.UserBanner, error)
 	ListFollowing(ctx context.Context, userID int) ([]views.UserBanner, error)
@@ -35,6 +36,7 @@ type UserUsecase interface {
 
 type UserRepository interface {
 	Create(ctx context.Context, user *User) error
+	CreateWithInvite(ctx context.Context, user *User, inviteCode string) error
 	SoftDelete(ctx context.Context, userID int) error
 
 	GetByUsername(ctx context.Context, username string) (*views.UserProfile, error)
@@ -43,6 +45,7 @@ type UserRepository interface {
 	GetByID(ctx context.Context, userID int) (*views.UserProfile, error)
 
 	GetByIDs(ctx context.Context, userIDs []int) ([]views.UserProfile, error)
+	GetByUsernames(ctx context.Context, usernames []string) ([]views.UserBanner, error)
 
 	GetProfile(ctx context.Context, userID int) (*views.UserProfile, error)
 
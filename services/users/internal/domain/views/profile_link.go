@@ -0,0 +1,9 @@
+package views
+
+import "time"
+
+type ProfileLink struct {
+	Label      string     `db:"label"`
+	URL        string     `db:"url"`
+	VerifiedAt *time.Time `db:"verified_at"`
+}
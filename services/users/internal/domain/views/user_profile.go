@@ -2,16 +2,45 @@ package views
 
 import "time"
 
+const (
+	BirthdayPublic    = "public"
+	BirthdayFollowers = "followers"
+	BirthdayPrivate   = "private"
+)
+
 type UserProfile struct {
-	ID          int       `db:"id"`
-	Username    string    `db:"username"`
-	DisplayName string    `db:"display_name"`
-	Bio         string    `db:"bio"`
-	AvatarURL   string    `db:"avatar_url"`
-	BannerURL   string    `db:"banner_url"`
-	Location    string    `db:"location"`
-	Follower    int       `db:"follower"`
-	Following   int       `db:"following"`
-	CreatedAt   time.Time `db:"created_at"`
-	IsFollowed  bool      `db:"is_followed"`
+	ID                 int           `db:"id"`
+	Username           string        `db:"username"`
+	DisplayName        string        `db:"display_name"`
+	Bio                string        `db:"bio"`
+	AvatarURL          string        `db:"avatar_url"`
+	BannerURL          string        `db:"banner_url"`
+	Location           string        `db:"location"`
+	Pronouns           string        `db:"pronouns"`
+	Birthday           string        `db:"birthday"`
+	BirthdayVisibility string        `db:"birthday_visibility"`
+	Follower           int           `db:"follower"`
+	Following          int           `db:"following"`
+	CreatedAt          time.Time     `db:"created_at"`
+	IsFollowed         bool          `db:"is_followed"`
+	Links              []ProfileLink `db:"-"`
+}
+
+// HideBirthdayFrom clears the birthday when viewerID is not allowed to see
+// it. IsFollowed must already be resolved for the viewer.
+func (p *UserProfile) HideBirthdayFrom(viewerID int) {
+	if viewerID == p.ID {
+		return
+	}
+
+	switch p.BirthdayVisibility {
+	case BirthdayPublic:
+		return
+	case BirthdayFollowers:
+		if viewerID != 0 && p.IsFollowed {
+			return
+		}
+	}
+
+	p.Birthday = ""
 }
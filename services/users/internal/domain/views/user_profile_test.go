@@ -0,0 +1,66 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHideBirthdayFrom(t *testing.T) {
+	const (
+		ownerID    = 1
+		followerID = 2
+		strangerID = 3
+		guestID    = 0
+	)
+
+	viewers := []struct {
+		name       string
+		id         int
+		isFollowed bool
+	}{
+		{"owner", ownerID, false},
+		{"follower", followerID, true},
+		{"non-follower", strangerID, false},
+		{"guest", guestID, false},
+	}
+
+	// visible is indexed like viewers
+	testCases := []struct {
+		visibility string
+		visible    [4]bool
+	}{
+		{BirthdayPublic, [4]bool{true, true, true, true}},
+		{BirthdayFollowers, [4]bool{true, true, false, false}},
+		{BirthdayPrivate, [4]bool{true, false, false, false}},
+		// an unknown visibility fails closed
+		{"", [4]bool{true, false, false, false}},
+	}
+
+	for _, tc := range testCases {
+		for i, viewer := range viewers {
+			t.Run(tc.visibility+"/"+viewer.name, func(t *testing.T) {
+				profile := &UserProfile{
+					ID:                 ownerID,
+					Birthday:           "1990-02-28",
+					BirthdayVisibility: tc.visibility,
+					IsFollowed:         viewer.isFollowed,
+				}
+
+				profile.HideBirthdayFrom(viewer.id)
+
+				assert.Equal(t, tc.visible[i], profile.Birthday != "")
+			})
+		}
+	}
+}
+
+func TestHideBirthdayFromGuestIsNeverAFollower(t *testing.T) {
+	// IsFollowed is resolved for the viewer, a guest's should never be set
+	// but must not reveal the birthday if it were
+	profile := &UserProfile{ID: 1, Birthday: "1990-02-28", BirthdayVisibility: BirthdayFollowers, IsFollowed: true}
+
+	profile.HideBirthdayFrom(0)
+
+	assert.Empty(t, profile.Birthday)
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) AdminCreateInvite(
+	ctx context.Context,
+	req *pb.CreateInviteRequest,
+) (*pb.CreateInviteResponse, error) {
+	invite, err := u.InviteUsecase.CreateInvite(ctx, nil, int(req.GetMaxUses()), inviteExpiry(req))
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Admin Create Invite")
+	}
+
+	return &pb.CreateInviteResponse{
+		Invite: mapInvite(invite),
+	}, nil
+}
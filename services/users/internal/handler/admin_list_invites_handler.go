@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) AdminListInvites(
+	ctx context.Context,
+	req *pb.ListInvitesRequest,
+) (*pb.ListInvitesResponse, error) {
+	filter := &domain.InviteFilter{
+		CursorID: int(req.GetCursorId()),
+		Limit:    int(req.GetLimit()),
+	}
+
+	if req.IssuerId != nil {
+		issuerID := int(req.GetIssuerId())
+		filter.IssuerID = &issuerID
+	}
+
+	invites, hasMore, err := u.InviteUsecase.ListInvites(ctx, filter)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Admin List Invites")
+	}
+
+	return &pb.ListInvitesResponse{
+		Invites: mapInvites(invites),
+		HasMore: hasMore,
+	}, nil
+}
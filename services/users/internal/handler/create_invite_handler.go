@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (u *UserHandler) CreateInvite(
+	ctx context.Context,
+	req *pb.CreateInviteRequest,
+) (*pb.CreateInviteResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	invite, err := u.InviteUsecase.CreateInvite(ctx, &userID, int(req.GetMaxUses()), inviteExpiry(req))
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Create Invite")
+	}
+
+	return &pb.CreateInviteResponse{
+		Invite: mapInvite(invite),
+	}, nil
+}
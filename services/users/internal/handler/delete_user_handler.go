@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"voidspace/users/internal/domain"
 	pb "voidspace/users/proto/users/v1"
 
 	"github.com/vhysxl/voidspace/shared/utils/helper"
@@ -23,5 +24,7 @@ func (u *UserHandler) DeleteUser(
 		return nil, helper.HandleError(err, u.Logger, "Delete User")
 	}
 
+	u.recordSecurityEvent(ctx, userID, domain.SecurityEventAccountDeleted)
+
 	return &pb.DeleteUserResponse{}, nil
 }
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) FilterFollowing(
+	ctx context.Context,
+	req *pb.FilterFollowingRequest,
+) (*pb.FilterFollowingResponse, error) {
+	targetUserIDs := make([]int, 0, len(req.GetTargetUserIds()))
+	for _, ID := range req.GetTargetUserIds() {
+		targetUserIDs = append(targetUserIDs, int(ID))
+	}
+
+	followed, err := u.FollowUsecase.FilterFollowing(ctx, int(req.GetUserId()), targetUserIDs)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Filter Following")
+	}
+
+	userIDs := make([]int64, 0, len(followed))
+	for _, ID := range followed {
+		userIDs = append(userIDs, int64(ID))
+	}
+
+	return &pb.FilterFollowingResponse{
+		UserIds: userIDs,
+	}, nil
+}
@@ -26,17 +26,21 @@ func (u *UserHandler) GetCurrentUser(
 
 	return &pb.GetCurrentUserResponse{
 		User: &pb.UserProfile{
-			Id:          int64(user.ID),
-			Username:    user.Username,
-			DisplayName: user.DisplayName,
-			Bio:         user.Bio,
-			AvatarUrl:   user.AvatarURL,
-			BannerUrl:   user.BannerURL,
-			Location:    user.Location,
-			Followers:   int64(user.Follower),
-			Following:   int64(user.Following),
-			CreatedAt:   timestamppb.New(user.CreatedAt),
-			IsFollowed:  user.IsFollowed,
+			Id:                 int64(user.ID),
+			Username:           user.Username,
+			DisplayName:        user.DisplayName,
+			Bio:                user.Bio,
+			AvatarUrl:          user.AvatarURL,
+			BannerUrl:          user.BannerURL,
+			Location:           user.Location,
+			Followers:          int64(user.Follower),
+			Following:          int64(user.Following),
+			CreatedAt:          timestamppb.New(user.CreatedAt),
+			IsFollowed:         user.IsFollowed,
+			Pronouns:           user.Pronouns,
+			Birthday:           user.Birthday,
+			BirthdayVisibility: user.BirthdayVisibility,
+			Links:              mapProfileLinks(user.Links),
 		},
 	}, nil
 }
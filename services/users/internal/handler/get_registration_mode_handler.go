@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (u *UserHandler) GetRegistrationMode(
+	ctx context.Context,
+	req *emptypb.Empty,
+) (*pb.RegistrationModeResponse, error) {
+	return &pb.RegistrationModeResponse{
+		Mode: string(u.InviteUsecase.RegistrationMode()),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (u *UserHandler) GetSettings(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.GetSettingsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	settings, err := u.SettingsUsecase.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Get Settings")
+	}
+
+	return &pb.GetSettingsResponse{
+		Settings: mapSettings(settings),
+	}, nil
+}
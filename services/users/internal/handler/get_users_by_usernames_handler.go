@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) GetUsersByUsernames(
+	ctx context.Context,
+	req *pb.GetUsersByUsernamesRequest,
+) (*pb.GetUsersByUsernamesResponse, error) {
+	users, err := u.UserUsecase.GetUsersByUsernames(ctx, req.GetUsernames())
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Get Users By Usernames")
+	}
+
+	userBanners := make([]*pb.UserBanner, 0, len(users))
+	for _, user := range users {
+		userBanners = append(userBanners, &pb.UserBanner{
+			Id:          int64(user.ID),
+			Username:    user.Username,
+			DisplayName: user.DisplayName,
+			AvatarUrl:   user.AvatarURL,
+		})
+	}
+
+	return &pb.GetUsersByUsernamesResponse{
+		Users: userBanners,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) GetUsersSettings(
+	ctx context.Context,
+	req *pb.GetUsersRequest,
+) (*pb.GetUsersSettingsResponse, error) {
+	userIDs := make([]int, 0, len(req.GetUserIds()))
+	for _, ID := range req.GetUserIds() {
+		userIDs = append(userIDs, int(ID))
+	}
+
+	settings, err := u.SettingsUsecase.GetSettingsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Get Users Settings")
+	}
+
+	settingsRes := make([]*pb.UserSettings, 0, len(settings))
+	for i := range settings {
+		settingsRes = append(settingsRes, mapSettings(&settings[i]))
+	}
+
+	return &pb.GetUsersSettingsResponse{
+		Settings: settingsRes,
+	}, nil
+}
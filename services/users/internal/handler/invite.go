@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"time"
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func inviteExpiry(req *pb.CreateInviteRequest) *time.Time {
+	if req.ExpiresAt == nil {
+		return nil
+	}
+
+	expiresAt := req.GetExpiresAt().AsTime()
+	return &expiresAt
+}
+
+func mapInvite(invite *domain.Invite) *pb.Invite {
+	pbInvite := &pb.Invite{
+		Id:          int64(invite.ID),
+		Code:        invite.Code,
+		MaxUses:     int32(invite.MaxUses),
+		Uses:        int32(invite.Uses),
+		CreatedAt:   timestamppb.New(invite.CreatedAt),
+		Redemptions: make([]*pb.InviteRedemption, 0, len(invite.Redemptions)),
+	}
+
+	if invite.IssuerID != nil {
+		issuerID := int64(*invite.IssuerID)
+		pbInvite.IssuerId = &issuerID
+	}
+
+	if invite.ExpiresAt != nil {
+		pbInvite.ExpiresAt = timestamppb.New(*invite.ExpiresAt)
+	}
+
+	for _, redemption := range invite.Redemptions {
+		pbInvite.Redemptions = append(pbInvite.Redemptions, &pb.InviteRedemption{
+			UserId:     int64(redemption.UserID),
+			Username:   redemption.Username,
+			RedeemedAt: timestamppb.New(redemption.RedeemedAt),
+		})
+	}
+
+	return pbInvite
+}
+
+func mapInvites(invites []domain.Invite) []*pb.Invite {
+	pbInvites := make([]*pb.Invite, 0, len(invites))
+	for i := range invites {
+		pbInvites = append(pbInvites, mapInvite(&invites[i]))
+	}
+
+	return pbInvites
+}
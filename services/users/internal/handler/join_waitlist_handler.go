@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) JoinWaitlist(
+	ctx context.Context,
+	req *pb.JoinWaitlistRequest,
+) (*pb.JoinWaitlistResponse, error) {
+	err := u.InviteUsecase.JoinWaitlist(ctx, req.GetEmail())
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Join Waitlist")
+	}
+
+	return &pb.JoinWaitlistResponse{}, nil
+}
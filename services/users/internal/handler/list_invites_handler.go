@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (u *UserHandler) ListInvites(
+	ctx context.Context,
+	req *pb.ListInvitesRequest,
+) (*pb.ListInvitesResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	// users only ever see their own invites
+	invites, hasMore, err := u.InviteUsecase.ListInvites(ctx, &domain.InviteFilter{
+		IssuerID: &userID,
+		CursorID: int(req.GetCursorId()),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "List Invites")
+	}
+
+	return &pb.ListInvitesResponse{
+		Invites: mapInvites(invites),
+		HasMore: hasMore,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (u *UserHandler) ListSecurityEvents(
+	ctx context.Context,
+	req *pb.ListSecurityEventsRequest,
+) (*pb.SecurityEventsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	events, hasMore, err := u.SecurityEventUsecase.ListUserEvents(ctx, userID, int(req.GetCursorId()))
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "List Security Events")
+	}
+
+	return &pb.SecurityEventsResponse{
+		Events:  mapSecurityEvents(events),
+		HasMore: hasMore,
+	}, nil
+}
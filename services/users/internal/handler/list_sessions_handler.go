@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (u *UserHandler) ListSessions(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.ListSessionsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	currentSessionID, _ := ctx.Value(interceptor.CtxKeySessionID).(int)
+
+	sessions, err := u.SessionUsecase.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "List Sessions")
+	}
+
+	pbSessions := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, &pb.Session{
+			Id:         int64(session.ID),
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			IsCurrent:  session.ID == currentSessionID,
+		})
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: pbSessions,
+	}, nil
+}
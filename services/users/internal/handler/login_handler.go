@@ -2,10 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"voidspace/users/internal/domain"
 	pb "voidspace/users/proto/users/v1"
 	"voidspace/users/utils/token"
 
+	"github.com/vhysxl/voidspace/shared/utils/constants"
 	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -14,9 +18,21 @@ import (
 func (u *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.AuthResponse, error) {
 	user, err := u.UserUsecase.Login(ctx, req.GetEmailOrUsername(), req.GetPassword())
 	if err != nil {
+		if errors.Is(err, constants.ErrInvalidCredentials) || errors.Is(err, constants.ErrUserNotFound) {
+			u.recordFailedLogin(ctx, req.GetEmailOrUsername())
+		}
+
 		return nil, helper.HandleError(err, u.Logger, "Login")
 	}
 
+	userAgent, _ := ctx.Value(interceptor.CtxKeyUserAgent).(string)
+	ipAddress, _ := ctx.Value(interceptor.CtxKeyClientIP).(string)
+
+	session, err := u.SessionUsecase.CreateSession(ctx, user.ID, userAgent, ipAddress)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Create Session")
+	}
+
 	g, _ := errgroup.WithContext(ctx)
 
 	var (
@@ -26,13 +42,13 @@ func (u *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Auth
 
 	g.Go(func() error {
 		var err error
-		accessToken, err = token.CreateAccessToken(user, u.PrivateKey, u.AccessTokenDuration)
+		accessToken, err = token.CreateAccessToken(user, session, u.PrivateKey, u.AccessTokenDuration)
 		return err
 	})
 
 	g.Go(func() error {
 		var err error
-		refreshToken, err = token.CreateRefreshToken(user, u.PrivateKey, u.RefreshTokenDuration)
+		refreshToken, err = token.CreateRefreshToken(user, session, u.PrivateKey, u.RefreshTokenDuration)
 		return err
 	})
 
@@ -42,6 +58,8 @@ func (u *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Auth
 		return nil, helper.HandleError(err, u.Logger, "Create Token")
 	}
 
+	u.recordSecurityEvent(ctx, user.ID, domain.SecurityEventLoginSuccess)
+
 	return &pb.AuthResponse{
 		RefreshToken: &refreshToken,
 		AccessToken:  accessToken,
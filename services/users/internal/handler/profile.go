@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"voidspace/users/internal/domain/views"
+	pb "voidspace/users/proto/users/v1"
+)
+
+func mapProfileLinks(links []views.ProfileLink) []*pb.ProfileLink {
+	pbLinks := make([]*pb.ProfileLink, 0, len(links))
+	for _, link := range links {
+		pbLinks = append(pbLinks, &pb.ProfileLink{
+			Label:    link.Label,
+			Url:      link.URL,
+			Verified: link.VerifiedAt != nil,
+		})
+	}
+
+	return pbLinks
+}
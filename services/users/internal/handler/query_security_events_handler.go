@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+func (u *UserHandler) QuerySecurityEvents(
+	ctx context.Context,
+	req *pb.QuerySecurityEventsRequest,
+) (*pb.SecurityEventsResponse, error) {
+	filter := &domain.SecurityEventFilter{
+		EventType: req.GetEventType(),
+		CursorID:  int(req.GetCursorId()),
+		Limit:     int(req.GetLimit()),
+	}
+
+	if req.UserId != nil {
+		userID := int(req.GetUserId())
+		filter.UserID = &userID
+	}
+
+	if req.Since != nil {
+		since := req.GetSince().AsTime()
+		filter.Since = &since
+	}
+
+	if req.Until != nil {
+		until := req.GetUntil().AsTime()
+		filter.Until = &until
+	}
+
+	events, hasMore, err := u.SecurityEventUsecase.QueryEvents(ctx, filter)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Query Security Events")
+	}
+
+	return &pb.SecurityEventsResponse{
+		Events:  mapSecurityEvents(events),
+		HasMore: hasMore,
+	}, nil
+}
@@ -23,9 +23,18 @@ func (u *UserHandler) RefreshToken(ctx context.Context, _ *emptypb.Empty) (*pb.A
 		return nil, helper.HandleAuthError(nil, u.Logger)
 	}
 
+	sessionID, _ := ctx.Value(interceptor.CtxKeySessionID).(int)
+	tokenFamily, _ := ctx.Value(interceptor.CtxKeyTokenFamily).(string)
+
+	err = u.SessionUsecase.ValidateSession(ctx, userID, sessionID, tokenFamily)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "RefreshToken")
+	}
+
 	user := &domain.User{ID: int(userID), Username: username}
+	session := &domain.Session{ID: sessionID, TokenFamily: tokenFamily}
 
-	accessToken, err := token.CreateAccessToken(user, u.PrivateKey, u.AccessTokenDuration)
+	accessToken, err := token.CreateAccessToken(user, session, u.PrivateKey, u.AccessTokenDuration)
 	if err != nil {
 		return nil, helper.HandleError(err, u.Logger, "RefreshToken")
 	}
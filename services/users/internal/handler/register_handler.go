@@ -2,21 +2,31 @@ package handler
 
 import (
 	"context"
+	"voidspace/users/internal/domain"
 	pb "voidspace/users/proto/users/v1"
 	"voidspace/users/utils/token"
 
 	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
 func (u *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.AuthResponse, error) {
-	user, err := u.UserUsecase.Register(ctx, req.GetUsername(), req.GetEmail(), req.GetPassword())
+	user, err := u.UserUsecase.Register(ctx, req.GetUsername(), req.GetEmail(), req.GetPassword(), req.GetInviteCode())
 	if err != nil {
 		return nil, helper.HandleError(err, u.Logger, "Register")
 	}
 
+	userAgent, _ := ctx.Value(interceptor.CtxKeyUserAgent).(string)
+	ipAddress, _ := ctx.Value(interceptor.CtxKeyClientIP).(string)
+
+	session, err := u.SessionUsecase.CreateSession(ctx, user.ID, userAgent, ipAddress)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Create Session")
+	}
+
 	var (
 		accessToken  string
 		refreshToken string
@@ -26,13 +36,13 @@ func (u *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 
 	g.Go(func() error {
 		var err error
-		accessToken, err = token.CreateAccessToken(user, u.PrivateKey, u.AccessTokenDuration)
+		accessToken, err = token.CreateAccessToken(user, session, u.PrivateKey, u.AccessTokenDuration)
 		return err
 	})
 
 	g.Go(func() error {
 		var err error
-		refreshToken, err = token.CreateRefreshToken(user, u.PrivateKey, u.RefreshTokenDuration)
+		refreshToken, err = token.CreateRefreshToken(user, session, u.PrivateKey, u.RefreshTokenDuration)
 		return err
 	})
 
@@ -42,6 +52,8 @@ func (u *UserHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		return nil, helper.HandleError(err, u.Logger, "Create Token")
 	}
 
+	u.recordSecurityEvent(ctx, user.ID, domain.SecurityEventRegister)
+
 	return &pb.AuthResponse{
 		RefreshToken: &refreshToken,
 		AccessToken:  accessToken,
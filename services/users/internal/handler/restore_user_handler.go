@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"voidspace/users/internal/domain"
 	pb "voidspace/users/proto/users/v1"
 
 	"github.com/vhysxl/voidspace/shared/utils/helper"
@@ -16,5 +17,7 @@ func (u *UserHandler) RestoreUser(
 		return nil, helper.HandleError(err, u.Logger, "Restore User")
 	}
 
+	u.recordSecurityEvent(ctx, int(req.GetUserId()), domain.SecurityEventAccountRestored)
+
 	return &pb.RestoreUserResponse{}, nil
 }
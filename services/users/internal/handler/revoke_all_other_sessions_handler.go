@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (u *UserHandler) RevokeAllOtherSessions(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.RevokeAllOtherSessionsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	currentSessionID, _ := ctx.Value(interceptor.CtxKeySessionID).(int)
+
+	revoked, err := u.SessionUsecase.RevokeAllOtherSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Revoke Other Sessions")
+	}
+
+	return &pb.RevokeAllOtherSessionsResponse{
+		RevokedCount: int64(revoked),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (u *UserHandler) RevokeSession(
+	ctx context.Context,
+	req *pb.RevokeSessionRequest,
+) (*pb.RevokeSessionResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	err = u.SessionUsecase.RevokeSession(ctx, userID, int(req.GetSessionId()))
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Revoke Session")
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
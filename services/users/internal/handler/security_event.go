@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newSecurityEvent builds an audit event carrying the client info forwarded
+// by the gateway.
+func newSecurityEvent(ctx context.Context, userID int, eventType string) *domain.SecurityEvent {
+	userAgent, _ := ctx.Value(interceptor.CtxKeyUserAgent).(string)
+	ipAddress, _ := ctx.Value(interceptor.CtxKeyClientIP).(string)
+
+	event := &domain.SecurityEvent{
+		EventType: eventType,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+
+	if userID > 0 {
+		event.UserID = &userID
+	}
+
+	return event
+}
+
+// recordSecurityEvent never fails the action it describes, a broken audit
+// write is only logged.
+func (u *UserHandler) recordSecurityEvent(ctx context.Context, userID int, eventType string) {
+	err := u.SecurityEventUsecase.Record(ctx, newSecurityEvent(ctx, userID, eventType))
+	if err != nil {
+		u.Logger.Warn("failed to record security event", zap.String("event", eventType), zap.Error(err))
+	}
+}
+
+func (u *UserHandler) recordFailedLogin(ctx context.Context, credentials string) {
+	err := u.SecurityEventUsecase.RecordFailedLogin(ctx, credentials, newSecurityEvent(ctx, 0, domain.SecurityEventLoginFailed))
+	if err != nil {
+		u.Logger.Warn("failed to record security event", zap.String("event", domain.SecurityEventLoginFailed), zap.Error(err))
+	}
+}
+
+func mapSecurityEvents(events []domain.SecurityEvent) []*pb.SecurityEvent {
+	pbEvents := make([]*pb.SecurityEvent, 0, len(events))
+	for _, event := range events {
+		var userID int64
+		if event.UserID != nil {
+			userID = int64(*event.UserID)
+		}
+
+		pbEvents = append(pbEvents, &pb.SecurityEvent{
+			Id:        int64(event.ID),
+			UserId:    userID,
+			EventType: event.EventType,
+			IpAddress: event.IPAddress,
+			UserAgent: event.UserAgent,
+			Detail:    event.Detail,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+		})
+	}
+
+	return pbEvents
+}
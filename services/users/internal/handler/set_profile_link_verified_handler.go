@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (u *UserHandler) SetProfileLinkVerified(
+	ctx context.Context,
+	req *pb.SetProfileLinkVerifiedRequest,
+) (*pb.SetProfileLinkVerifiedResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	err = u.ProfileUsecase.SetLinkVerified(ctx, userID, req.GetUrl(), req.GetVerified())
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Set Profile Link Verified")
+	}
+
+	return &pb.SetProfileLinkVerifiedResponse{}, nil
+}
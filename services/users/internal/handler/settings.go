@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+)
+
+func mapSettings(settings *domain.Settings) *pb.UserSettings {
+	return &pb.UserSettings{
+		UserId:                int64(settings.UserID),
+		DefaultPostVisibility: string(settings.DefaultPostVisibility),
+		ReplyPolicy:           string(settings.ReplyPolicy),
+		MentionPolicy:         string(settings.MentionPolicy),
+		Notifications: &pb.NotificationSettings{
+			Likes:    settings.NotifyLikes,
+			Comments: settings.NotifyComments,
+			Follows:  settings.NotifyFollows,
+			Mentions: settings.NotifyMentions,
+		},
+		Language:       settings.Language,
+		SensitiveMedia: string(settings.SensitiveMedia),
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"voidspace/users/internal/domain"
+	"voidspace/users/internal/domain/views"
 	pb "voidspace/users/proto/users/v1"
 
 	"github.com/vhysxl/voidspace/shared/utils/helper"
@@ -24,6 +25,20 @@ func (u *UserHandler) UpdateProfile(
 		AvatarUrl:   req.GetAvatarUrl(),
 		BannerUrl:   req.GetBannerUrl(),
 		Location:    req.GetLocation(),
+
+		Pronouns:           req.Pronouns,
+		Birthday:           req.Birthday,
+		BirthdayVisibility: req.BirthdayVisibility,
+	}
+
+	if req.Links != nil {
+		updatedProfile.Links = make([]views.ProfileLink, 0, len(req.GetLinks().GetLinks()))
+		for _, link := range req.GetLinks().GetLinks() {
+			updatedProfile.Links = append(updatedProfile.Links, views.ProfileLink{
+				Label: link.GetLabel(),
+				URL:   link.GetUrl(),
+			})
+		}
 	}
 
 	err = u.ProfileUsecase.UpdateProfile(ctx, userId, updatedProfile)
@@ -31,5 +46,7 @@ func (u *UserHandler) UpdateProfile(
 		return nil, helper.HandleError(err, u.Logger, "Update Profile")
 	}
 
+	u.recordSecurityEvent(ctx, userId, domain.SecurityEventProfileUpdated)
+
 	return &pb.UpdateProfileResponse{}, nil
 }
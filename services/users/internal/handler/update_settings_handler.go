@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+	pb "voidspace/users/proto/users/v1"
+
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+	"github.com/vhysxl/voidspace/shared/utils/interceptor"
+)
+
+func (u *UserHandler) UpdateSettings(
+	ctx context.Context,
+	req *pb.UpdateSettingsRequest,
+) (*pb.GetSettingsResponse, error) {
+	userID, err := helper.GetUserIDFromContext(ctx, interceptor.CtxKeyUserID)
+	if err != nil {
+		return nil, helper.HandleAuthError(nil, u.Logger)
+	}
+
+	update := &domain.SettingsUpdate{
+		NotifyLikes:    req.NotifyLikes,
+		NotifyComments: req.NotifyComments,
+		NotifyFollows:  req.NotifyFollows,
+		NotifyMentions: req.NotifyMentions,
+		Language:       req.Language,
+	}
+
+	if req.DefaultPostVisibility != nil {
+		visibility := domain.PostVisibility(req.GetDefaultPostVisibility())
+		update.DefaultPostVisibility = &visibility
+	}
+	if req.ReplyPolicy != nil {
+		policy := domain.InteractionPolicy(req.GetReplyPolicy())
+		update.ReplyPolicy = &policy
+	}
+	if req.MentionPolicy != nil {
+		policy := domain.InteractionPolicy(req.GetMentionPolicy())
+		update.MentionPolicy = &policy
+	}
+	if req.SensitiveMedia != nil {
+		display := domain.SensitiveMediaDisplay(req.GetSensitiveMedia())
+		update.SensitiveMedia = &display
+	}
+
+	settings, err := u.SettingsUsecase.UpdateSettings(ctx, userID, update)
+	if err != nil {
+		return nil, helper.HandleError(err, u.Logger, "Update Settings")
+	}
+
+	return &pb.GetSettingsResponse{
+		Settings: mapSettings(settings),
+	}, nil
+}
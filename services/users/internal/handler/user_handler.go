@@ -15,6 +15,10 @@ type UserHandler struct {
 	UserUsecase          domain.UserUsecase
 	ProfileUsecase       domain.ProfileUsecase
 	FollowUsecase        domain.FollowUsecase
+	SessionUsecase       domain.SessionUsecase
+	SecurityEventUsecase domain.SecurityEventUsecase
+	SettingsUsecase      domain.SettingsUsecase
+	InviteUsecase        domain.InviteUsecase
 	Logger               *zap.Logger
 	ContextTimeout       time.Duration
 	PrivateKey           *rsa.PrivateKey
@@ -26,6 +30,10 @@ func NewUserHandler(
 	userUsecase domain.UserUsecase,
 	profileUsecase domain.ProfileUsecase,
 	followUsecase domain.FollowUsecase,
+	sessionUsecase domain.SessionUsecase,
+	securityEventUsecase domain.SecurityEventUsecase,
+	settingsUsecase domain.SettingsUsecase,
+	inviteUsecase domain.InviteUsecase,
 	timeout time.Duration,
 	logger *zap.Logger,
 	privateKey *rsa.PrivateKey,
@@ -36,6 +44,10 @@ func NewUserHandler(
 		UserUsecase:          userUsecase,
 		ProfileUsecase:       profileUsecase,
 		FollowUsecase:        followUsecase,
+		SessionUsecase:       sessionUsecase,
+		SecurityEventUsecase: securityEventUsecase,
+		SettingsUsecase:      settingsUsecase,
+		InviteUsecase:        inviteUsecase,
 		Logger:               logger,
 		ContextTimeout:       timeout,
 		PrivateKey:           privateKey,
@@ -0,0 +1,34 @@
+package follow
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (f *FollowRepository) GetFollowedAmong(
+	ctx context.Context,
+	userID int,
+	targetUserIDs []int,
+) ([]int, error) {
+	followed := []int{}
+	if len(targetUserIDs) == 0 {
+		return followed, nil
+	}
+
+	query := `
+    SELECT f.target_user_id
+    FROM user_follows f
+    JOIN users u1 ON f.user_id = u1.id
+    JOIN users u2 ON f.target_user_id = u2.id
+    WHERE f.user_id = $1
+      AND f.target_user_id = ANY($2)
+      AND u1.deleted_at IS NULL
+      AND u2.deleted_at IS NULL
+`
+	err := pgxscan.Select(ctx, f.db, &followed, query, userID, targetUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return followed, nil
+}
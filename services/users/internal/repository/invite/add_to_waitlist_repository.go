@@ -0,0 +1,28 @@
+package invite
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (i *InviteRepository) AddToWaitlist(
+	ctx context.Context,
+	email string,
+) error {
+	query := `INSERT INTO registration_waitlist (email) VALUES ($1)`
+
+	_, err := i.db.Exec(ctx, query, email)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return constants.ErrAlreadyOnWaitlist
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package invite
+
+import (
+	"context"
+)
+
+// CountActiveByIssuer counts invites that can still be redeemed.
+func (i *InviteRepository) CountActiveByIssuer(
+	ctx context.Context,
+	issuerID int,
+) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM invites
+		WHERE issuer_id = $1
+			AND uses < max_uses
+			AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	var count int
+	err := i.db.QueryRow(ctx, query, issuerID).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
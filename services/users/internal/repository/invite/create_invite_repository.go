@@ -0,0 +1,31 @@
+package invite
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+)
+
+func (i *InviteRepository) Create(
+	ctx context.Context,
+	invite *domain.Invite,
+) error {
+	query := `
+		INSERT INTO invites (code, issuer_id, max_uses, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, uses, created_at
+	`
+
+	err := i.db.QueryRow(
+		ctx,
+		query,
+		invite.Code,
+		invite.IssuerID,
+		invite.MaxUses,
+		invite.ExpiresAt,
+	).Scan(&invite.ID, &invite.Uses, &invite.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package invite
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (i *InviteRepository) GetRedemptions(
+	ctx context.Context,
+	inviteIDs []int,
+) ([]domain.InviteRedemption, error) {
+	query := `
+		SELECT ir.invite_id, ir.user_id, u.username, ir.redeemed_at
+		FROM invite_redemptions ir
+		JOIN users u ON u.id = ir.user_id
+		WHERE ir.invite_id = ANY($1)
+		ORDER BY ir.redeemed_at ASC
+	`
+
+	redemptions := []domain.InviteRedemption{}
+
+	err := pgxscan.Select(ctx, i.db, &redemptions, query, inviteIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return redemptions, nil
+}
@@ -0,0 +1,17 @@
+package invite
+
+import (
+	"voidspace/users/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type InviteRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewInviteRepository(db *pgxpool.Pool) domain.InviteRepository {
+	return &InviteRepository{
+		db: db,
+	}
+}
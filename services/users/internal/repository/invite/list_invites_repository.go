@@ -0,0 +1,50 @@
+package invite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"voidspace/users/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// List returns up to filter.Limit invites newest first, keyed by id.
+func (i *InviteRepository) List(
+	ctx context.Context,
+	filter *domain.InviteFilter,
+) ([]domain.Invite, error) {
+	conditions := []string{"TRUE"}
+	args := []any{}
+
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.IssuerID != nil {
+		addCondition("issuer_id = $%d", *filter.IssuerID)
+	}
+	if filter.CursorID > 0 {
+		addCondition("id < $%d", filter.CursorID)
+	}
+
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT id, code, issuer_id, max_uses, uses, expires_at, created_at
+		FROM invites
+		WHERE %s
+		ORDER BY id DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	invites := []domain.Invite{}
+
+	err := pgxscan.Select(ctx, i.db, &invites, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
@@ -0,0 +1,29 @@
+package profile
+
+import (
+	"context"
+	"voidspace/users/internal/domain/views"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (p *ProfileRepository) GetLinks(
+	ctx context.Context,
+	userID int,
+) ([]views.ProfileLink, error) {
+	links := []views.ProfileLink{}
+
+	query := `
+		SELECT label, url, verified_at
+		FROM user_profile_links
+		WHERE user_id = $1
+		ORDER BY position ASC
+	`
+
+	err := pgxscan.Select(ctx, p.db, &links, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
@@ -0,0 +1,32 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (p *ProfileRepository) SetLinkVerified(
+	ctx context.Context,
+	userID int,
+	linkURL string,
+	verified bool,
+) error {
+	query := `
+		UPDATE user_profile_links
+		SET verified_at = CASE WHEN $3 THEN CURRENT_TIMESTAMP ELSE NULL END
+		WHERE user_id = $1
+		AND url = $2
+	`
+
+	cmdTag, err := p.db.Exec(ctx, query, userID, linkURL, verified)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return constants.ErrProfileLinkNotFound
+	}
+
+	return nil
+}
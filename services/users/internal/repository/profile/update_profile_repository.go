@@ -4,6 +4,7 @@ import (
 	"context"
 	"voidspace/users/internal/domain"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/vhysxl/voidspace/shared/utils/constants"
 	"github.com/vhysxl/voidspace/shared/utils/helper"
 )
@@ -13,13 +14,23 @@ func (p *ProfileRepository) Update(
 	userID int,
 	profile *domain.Profile,
 ) error {
+	err := profile.Validate()
+	if err != nil {
+		return err
+	}
+
+	// pronouns, birthday and its visibility keep their value when not sent,
+	// an empty string clears pronouns and birthday
 	query := `
         UPDATE user_profile 
         SET display_name = $1, 
             bio = $2, 
             avatar_url = $3, 
             banner_url = $4, 
-            location = $5
+            location = $5,
+            pronouns = CASE WHEN $7::text IS NULL THEN pronouns ELSE NULLIF($7::text, '') END,
+            birthday = CASE WHEN $8::text IS NULL THEN birthday ELSE NULLIF($8::text, '')::date END,
+            birthday_visibility = COALESCE($9::text, birthday_visibility)
         WHERE user_id = $6
           AND EXISTS (
               SELECT 1 FROM users 
@@ -34,16 +45,57 @@ func (p *ProfileRepository) Update(
 		helper.NullIfEmpty(profile.BannerUrl),
 		helper.NullIfEmpty(profile.Location),
 		userID,
+		profile.Pronouns,
+		profile.Birthday,
+		profile.BirthdayVisibility,
 	}
 
-	commandTag, err := p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return err
-	}
+	deleteLinks := `
+		DELETE FROM user_profile_links
+		WHERE user_id = $1
+		AND NOT (url = ANY($2))
+	`
 
-	if commandTag.RowsAffected() == 0 {
-		return constants.ErrUserNotFound
-	}
+	// verification survives as long as the url stays the same
+	upsertLink := `
+		INSERT INTO user_profile_links (user_id, label, url, position)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, url) DO UPDATE SET
+			label = EXCLUDED.label,
+			position = EXCLUDED.position
+	`
+
+	return pgx.BeginFunc(ctx, p.db, func(tx pgx.Tx) error {
+		commandTag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		if commandTag.RowsAffected() == 0 {
+			return constants.ErrUserNotFound
+		}
+
+		if profile.Links == nil {
+			return nil
+		}
+
+		urls := make([]string, 0, len(profile.Links))
+		for _, link := range profile.Links {
+			urls = append(urls, link.URL)
+		}
+
+		_, err = tx.Exec(ctx, deleteLinks, userID, urls)
+		if err != nil {
+			return err
+		}
+
+		for i, link := range profile.Links {
+			_, err = tx.Exec(ctx, upsertLink, userID, link.Label, link.URL, i)
+			if err != nil {
+				return err
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -0,0 +1,32 @@
+package security_event
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+)
+
+func (s *SecurityEventRepository) Create(
+	ctx context.Context,
+	event *domain.SecurityEvent,
+) error {
+	query := `
+		INSERT INTO security_events (user_id, event_type, ip_address, user_agent, detail)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := s.db.QueryRow(
+		ctx,
+		query,
+		event.UserID,
+		event.EventType,
+		event.IPAddress,
+		event.UserAgent,
+		event.Detail,
+	).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
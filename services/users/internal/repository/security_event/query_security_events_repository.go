@@ -0,0 +1,60 @@
+package security_event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"voidspace/users/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// Query returns up to filter.Limit events newest first. Events are keyed by
+// id, which only grows, so CursorID is the last id of the previous page.
+func (s *SecurityEventRepository) Query(
+	ctx context.Context,
+	filter *domain.SecurityEventFilter,
+) ([]domain.SecurityEvent, error) {
+	conditions := []string{"TRUE"}
+	args := []any{}
+
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.UserID != nil {
+		addCondition("user_id = $%d", *filter.UserID)
+	}
+	if filter.EventType != "" {
+		addCondition("event_type = $%d", filter.EventType)
+	}
+	if filter.Since != nil {
+		addCondition("created_at >= $%d", *filter.Since)
+	}
+	if filter.Until != nil {
+		addCondition("created_at < $%d", *filter.Until)
+	}
+	if filter.CursorID > 0 {
+		addCondition("id < $%d", filter.CursorID)
+	}
+
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT id, user_id, event_type, ip_address, user_agent, detail, created_at
+		FROM security_events
+		WHERE %s
+		ORDER BY id DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	events := []domain.SecurityEvent{}
+
+	err := pgxscan.Select(ctx, s.db, &events, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
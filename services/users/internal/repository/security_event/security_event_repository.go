@@ -0,0 +1,17 @@
+package security_event
+
+import (
+	"voidspace/users/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SecurityEventRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSecurityEventRepository(db *pgxpool.Pool) domain.SecurityEventRepository {
+	return &SecurityEventRepository{
+		db: db,
+	}
+}
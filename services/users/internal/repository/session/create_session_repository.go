@@ -0,0 +1,31 @@
+package session
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+)
+
+func (s *SessionRepository) Create(
+	ctx context.Context,
+	session *domain.Session,
+) error {
+	query := `
+		INSERT INTO user_sessions (user_id, token_family, user_agent, ip_address)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, last_seen_at
+	`
+
+	err := s.db.QueryRow(
+		ctx,
+		query,
+		session.UserID,
+		session.TokenFamily,
+		session.UserAgent,
+		session.IPAddress,
+	).Scan(&session.ID, &session.CreatedAt, &session.LastSeenAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"voidspace/users/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SessionRepository) GetByID(
+	ctx context.Context,
+	sessionID int,
+) (*domain.Session, error) {
+	var session domain.Session
+
+	query := `
+		SELECT id, user_id, token_family, user_agent, ip_address, created_at, last_seen_at, revoked_at
+		FROM user_sessions
+		WHERE id = $1
+	`
+
+	err := pgxscan.Get(ctx, s.db, &session, query, sessionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, constants.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	return &session, nil
+}
@@ -0,0 +1,30 @@
+package session
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (s *SessionRepository) ListActiveByUserID(
+	ctx context.Context,
+	userID int,
+) ([]domain.Session, error) {
+	sessions := []domain.Session{}
+
+	query := `
+		SELECT id, user_id, token_family, user_agent, ip_address, created_at, last_seen_at, revoked_at
+		FROM user_sessions
+		WHERE user_id = $1
+		AND revoked_at IS NULL
+		ORDER BY last_seen_at DESC, id DESC
+	`
+
+	err := pgxscan.Select(ctx, s.db, &sessions, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
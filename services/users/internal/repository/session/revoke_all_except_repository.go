@@ -0,0 +1,25 @@
+package session
+
+import (
+	"context"
+)
+
+func (s *SessionRepository) RevokeAllExcept(
+	ctx context.Context,
+	userID, sessionID int,
+) (int, error) {
+	query := `
+		UPDATE user_sessions
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1
+		AND id <> $2
+		AND revoked_at IS NULL
+	`
+
+	cmdTag, err := s.db.Exec(ctx, query, userID, sessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
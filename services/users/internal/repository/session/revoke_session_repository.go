@@ -0,0 +1,31 @@
+package session
+
+import (
+	"context"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SessionRepository) Revoke(
+	ctx context.Context,
+	userID, sessionID int,
+) error {
+	query := `
+		UPDATE user_sessions
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		AND user_id = $2
+		AND revoked_at IS NULL
+	`
+
+	cmdTag, err := s.db.Exec(ctx, query, sessionID, userID)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return constants.ErrSessionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package session
+
+import (
+	"voidspace/users/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SessionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepository(db *pgxpool.Pool) domain.SessionRepository {
+	return &SessionRepository{
+		db: db,
+	}
+}
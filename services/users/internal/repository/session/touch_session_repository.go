@@ -0,0 +1,24 @@
+package session
+
+import (
+	"context"
+)
+
+func (s *SessionRepository) Touch(
+	ctx context.Context,
+	sessionID int,
+) error {
+	query := `
+		UPDATE user_sessions
+		SET last_seen_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		AND revoked_at IS NULL
+	`
+
+	_, err := s.db.Exec(ctx, query, sessionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
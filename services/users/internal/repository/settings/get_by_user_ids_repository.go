@@ -0,0 +1,49 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"voidspace/users/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/vhysxl/voidspace/shared/utils/helper"
+)
+
+// GetByUserIDs only returns stored rows, users who never saved settings are
+// missing from the result.
+func (s *SettingsRepository) GetByUserIDs(
+	ctx context.Context,
+	userIDs []int,
+) ([]domain.Settings, error) {
+	if len(userIDs) == 0 {
+		return []domain.Settings{}, nil
+	}
+
+	queryBatch, args := helper.GenerateDBPlaceholders(userIDs)
+
+	settings := []domain.Settings{}
+
+	query := fmt.Sprintf(`
+		SELECT
+			user_id,
+			default_post_visibility,
+			reply_policy,
+			mention_policy,
+			notify_likes,
+			notify_comments,
+			notify_follows,
+			notify_mentions,
+			language,
+			sensitive_media,
+			updated_at
+		FROM user_settings
+		WHERE user_id IN (%s)
+	`, queryBatch)
+
+	err := pgxscan.Select(ctx, s.db, &settings, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
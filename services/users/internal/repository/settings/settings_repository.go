@@ -0,0 +1,17 @@
+package settings
+
+import (
+	"voidspace/users/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SettingsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSettingsRepository(db *pgxpool.Pool) domain.SettingsRepository {
+	return &SettingsRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,73 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"voidspace/users/internal/domain"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SettingsRepository) Upsert(
+	ctx context.Context,
+	settings *domain.Settings,
+) error {
+	query := `
+		INSERT INTO user_settings (
+			user_id,
+			default_post_visibility,
+			reply_policy,
+			mention_policy,
+			notify_likes,
+			notify_comments,
+			notify_follows,
+			notify_mentions,
+			language,
+			sensitive_media
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (user_id) DO UPDATE SET
+			default_post_visibility = EXCLUDED.default_post_visibility,
+			reply_policy = EXCLUDED.reply_policy,
+			mention_policy = EXCLUDED.mention_policy,
+			notify_likes = EXCLUDED.notify_likes,
+			notify_comments = EXCLUDED.notify_comments,
+			notify_follows = EXCLUDED.notify_follows,
+			notify_mentions = EXCLUDED.notify_mentions,
+			language = EXCLUDED.language,
+			sensitive_media = EXCLUDED.sensitive_media,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at
+	`
+
+	err := s.db.QueryRow(
+		ctx,
+		query,
+		settings.UserID,
+		settings.DefaultPostVisibility,
+		settings.ReplyPolicy,
+		settings.MentionPolicy,
+		settings.NotifyLikes,
+		settings.NotifyComments,
+		settings.NotifyFollows,
+		settings.NotifyMentions,
+		settings.Language,
+		settings.SensitiveMedia,
+	).Scan(&settings.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.ForeignKeyViolation:
+				return constants.ErrUserNotFound
+			case pgerrcode.CheckViolation:
+				return constants.ErrInvalidSettings
+			}
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"voidspace/users/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// CreateWithInvite redeems the invite and creates the user in one
+// transaction, so a failed signup never burns an invite use.
+func (u *UserRepository) CreateWithInvite(
+	ctx context.Context,
+	user *domain.User,
+	inviteCode string,
+) error {
+	// invites from deleted accounts stop working
+	sqlRedeem := `
+		UPDATE invites i
+		SET uses = i.uses + 1
+		WHERE i.code = $1
+			AND i.uses < i.max_uses
+			AND (i.expires_at IS NULL OR i.expires_at > NOW())
+			AND (i.issuer_id IS NULL OR EXISTS (
+				SELECT 1 FROM users u WHERE u.id = i.issuer_id AND u.deleted_at IS NULL
+			))
+		RETURNING i.id
+	`
+
+	sqlRedemption := `INSERT INTO invite_redemptions (invite_id, user_id) VALUES ($1, $2)`
+
+	err := pgx.BeginFunc(ctx, u.db, func(tx pgx.Tx) error {
+		var inviteID int
+
+		err := tx.QueryRow(ctx, sqlRedeem, inviteCode).Scan(&inviteID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return constants.ErrInvalidInvite
+			}
+			return err
+		}
+
+		err = insertUser(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sqlRedemption, inviteID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return mapCreateUserError(err)
+	}
+
+	return nil
+}
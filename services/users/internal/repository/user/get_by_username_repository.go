@@ -27,6 +27,9 @@ func (u *UserRepository) GetByUsername(
       		COALESCE(up.avatar_url, '') AS avatar_url,
       		COALESCE(up.banner_url, '') AS banner_url,
       		COALESCE(up.location, '') AS location,
+      		COALESCE(up.pronouns, '') AS pronouns,
+      		COALESCE(to_char(up.birthday, 'YYYY-MM-DD'), '') AS birthday,
+      		up.birthday_visibility,
     	(SELECT COUNT(*)
      		FROM user_follows f
      		JOIN users u_follower ON f.user_id = u_follower.id
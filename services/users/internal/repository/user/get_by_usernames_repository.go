@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+	"voidspace/users/internal/domain/views"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetByUsernames implements [domain.UserRepository].
+func (u *UserRepository) GetByUsernames(
+	ctx context.Context,
+	usernames []string,
+) ([]views.UserBanner, error) {
+	if len(usernames) == 0 {
+		return []views.UserBanner{}, nil
+	}
+
+	users := []views.UserBanner{}
+
+	query := `
+		SELECT 
+			u.id as user_id, 
+			u.username, 
+			COALESCE(up.display_name, '') as display_name, 
+			COALESCE(up.avatar_url, '') as avatar_url
+		FROM users u
+		JOIN user_profile up ON u.id = up.user_id
+		WHERE u.username = ANY($1)
+		AND u.deleted_at IS NULL
+	`
+
+	err := pgxscan.Select(ctx, u.db, &users, query, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -19,6 +19,10 @@ func SetupGRPCServer(app *bootstrap.Application) *grpc.Server {
 		app.UserUsecase,
 		app.ProfileUsecase,
 		app.FollowUsecase,
+		app.SessionUsecase,
+		app.SecurityEventUsecase,
+		app.SettingsUsecase,
+		app.InviteUsecase,
 		app.ContextTimeout,
 		app.Logger,
 		app.PrivateKey,
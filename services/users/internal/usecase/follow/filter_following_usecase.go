@@ -0,0 +1,13 @@
+package follow
+
+import (
+	"context"
+)
+
+func (f *FollowUsecase) FilterFollowing(
+	ctx context.Context,
+	userID int,
+	targetUserIDs []int,
+) ([]int, error) {
+	return f.followRepository.GetFollowedAmong(ctx, userID, targetUserIDs)
+}
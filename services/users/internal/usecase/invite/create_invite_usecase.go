@@ -0,0 +1,67 @@
+package invite
+
+import (
+	"context"
+	"time"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (i *InviteUsecase) CreateInvite(
+	ctx context.Context,
+	issuerID *int,
+	maxUses int,
+	expiresAt *time.Time,
+) (*domain.Invite, error) {
+	now := time.Now()
+
+	if maxUses <= 0 {
+		maxUses = 1
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, constants.ErrInvalidData
+	}
+
+	// regular users get bounded invites, admins can issue anything
+	if issuerID != nil {
+		if maxUses > domain.MaxUserInviteUses {
+			return nil, constants.ErrInvalidData
+		}
+
+		if expiresAt == nil {
+			defaultExpiry := now.Add(domain.DefaultInviteTTL)
+			expiresAt = &defaultExpiry
+		}
+		if expiresAt.After(now.Add(domain.MaxUserInviteTTL)) {
+			return nil, constants.ErrInvalidData
+		}
+
+		active, err := i.inviteRepository.CountActiveByIssuer(ctx, *issuerID)
+		if err != nil {
+			return nil, constants.ErrInternalServer
+		}
+		if active >= domain.MaxUserActiveInvites {
+			return nil, constants.ErrInviteLimitReached
+		}
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	invite := &domain.Invite{
+		Code:      code,
+		IssuerID:  issuerID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+	}
+
+	err = i.inviteRepository.Create(ctx, invite)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	return invite, nil
+}
@@ -0,0 +1,78 @@
+package invite
+
+import (
+	"context"
+	"testing"
+	"time"
+	"voidspace/users/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type fakeInviteRepository struct {
+	domain.InviteRepository
+	active  int
+	created *domain.Invite
+}
+
+func (f *fakeInviteRepository) CountActiveByIssuer(ctx context.Context, issuerID int) (int, error) {
+	return f.active, nil
+}
+
+func (f *fakeInviteRepository) Create(ctx context.Context, invite *domain.Invite) error {
+	f.created = invite
+	return nil
+}
+
+func TestCreateInvite(t *testing.T) {
+	userID := 1
+	in := func(d time.Duration) *time.Time {
+		at := time.Now().Add(d)
+		return &at
+	}
+
+	testCases := []struct {
+		name        string
+		issuerID    *int
+		maxUses     int
+		expiresAt   *time.Time
+		active      int
+		wantUses    int
+		wantExpiry  bool
+		expectedErr error
+	}{
+		{name: "User invite defaults to one use and a week", issuerID: &userID, wantUses: 1, wantExpiry: true},
+		{name: "User invite at the use limit", issuerID: &userID, maxUses: domain.MaxUserInviteUses, wantUses: domain.MaxUserInviteUses, wantExpiry: true},
+		{name: "User invite over the use limit", issuerID: &userID, maxUses: domain.MaxUserInviteUses + 1, expectedErr: constants.ErrInvalidData},
+		{name: "User invite at the TTL cap", issuerID: &userID, expiresAt: in(domain.MaxUserInviteTTL - time.Minute), wantUses: 1, wantExpiry: true},
+		{name: "User invite over the TTL cap", issuerID: &userID, expiresAt: in(domain.MaxUserInviteTTL + time.Hour), expectedErr: constants.ErrInvalidData},
+		{name: "User invite already expired", issuerID: &userID, expiresAt: in(-time.Minute), expectedErr: constants.ErrInvalidData},
+		{name: "User one below the active limit", issuerID: &userID, active: domain.MaxUserActiveInvites - 1, wantUses: 1, wantExpiry: true},
+		{name: "User at the active limit", issuerID: &userID, active: domain.MaxUserActiveInvites, expectedErr: constants.ErrInviteLimitReached},
+		{name: "Admin invite is unbounded", maxUses: 1000, active: 1000, wantUses: 1000},
+		{name: "Admin invite already expired", expiresAt: in(-time.Minute), expectedErr: constants.ErrInvalidData},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeInviteRepository{active: tc.active}
+			usecase := NewInviteUsecase(repo, domain.RegistrationInvite, time.Second)
+
+			invite, err := usecase.CreateInvite(context.Background(), tc.issuerID, tc.maxUses, tc.expiresAt)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, repo.created)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, invite.Code, 16)
+			assert.Equal(t, tc.wantUses, invite.MaxUses)
+			assert.Equal(t, tc.wantExpiry, invite.ExpiresAt != nil)
+			if tc.expiresAt == nil && tc.wantExpiry {
+				assert.WithinDuration(t, time.Now().Add(domain.DefaultInviteTTL), *invite.ExpiresAt, time.Minute)
+			}
+		})
+	}
+}
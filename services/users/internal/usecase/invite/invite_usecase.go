@@ -0,0 +1,46 @@
+package invite
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"time"
+	"voidspace/users/internal/domain"
+)
+
+const (
+	defaultListSize = 20
+	maxListSize     = 100
+	inviteCodeBytes = 10
+)
+
+type InviteUsecase struct {
+	inviteRepository domain.InviteRepository
+	registrationMode domain.RegistrationMode
+	contextTimeout   time.Duration
+}
+
+func NewInviteUsecase(
+	inviteRepository domain.InviteRepository,
+	registrationMode domain.RegistrationMode,
+	contextTimeout time.Duration,
+) domain.InviteUsecase {
+	return &InviteUsecase{
+		inviteRepository: inviteRepository,
+		registrationMode: registrationMode,
+		contextTimeout:   contextTimeout,
+	}
+}
+
+func (i *InviteUsecase) RegistrationMode() domain.RegistrationMode {
+	return i.registrationMode
+}
+
+// generateInviteCode returns a 16 character code that is easy to type back in.
+func generateInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
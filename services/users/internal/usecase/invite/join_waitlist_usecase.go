@@ -0,0 +1,35 @@
+package invite
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (i *InviteUsecase) JoinWaitlist(
+	ctx context.Context,
+	email string,
+) error {
+	// the waitlist only makes sense while the doors are closed
+	if i.registrationMode == domain.RegistrationOpen {
+		return constants.ErrInvalidData
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return constants.ErrInvalidData
+	}
+
+	err := i.inviteRepository.AddToWaitlist(ctx, email)
+	if err != nil {
+		if errors.Is(err, constants.ErrAlreadyOnWaitlist) {
+			return err
+		}
+
+		return constants.ErrInternalServer
+	}
+
+	return nil
+}
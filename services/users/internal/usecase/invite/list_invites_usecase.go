@@ -0,0 +1,59 @@
+package invite
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (i *InviteUsecase) ListInvites(
+	ctx context.Context,
+	filter *domain.InviteFilter,
+) ([]domain.Invite, bool, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultListSize
+	}
+	if limit > maxListSize {
+		limit = maxListSize
+	}
+
+	query := *filter
+	query.Limit = limit + 1
+
+	invites, err := i.inviteRepository.List(ctx, &query)
+	if err != nil {
+		return nil, false, constants.ErrInternalServer
+	}
+
+	hasMore := len(invites) > limit
+	if hasMore {
+		invites = invites[:limit]
+	}
+
+	if len(invites) == 0 {
+		return invites, hasMore, nil
+	}
+
+	inviteIDs := make([]int, 0, len(invites))
+	for _, invite := range invites {
+		inviteIDs = append(inviteIDs, invite.ID)
+	}
+
+	redemptions, err := i.inviteRepository.GetRedemptions(ctx, inviteIDs)
+	if err != nil {
+		return nil, false, constants.ErrInternalServer
+	}
+
+	byInvite := make(map[int][]domain.InviteRedemption, len(invites))
+	for _, redemption := range redemptions {
+		byInvite[redemption.InviteID] = append(byInvite[redemption.InviteID], redemption)
+	}
+
+	for idx := range invites {
+		invites[idx].Redemptions = byInvite[invites[idx].ID]
+	}
+
+	return invites, hasMore, nil
+}
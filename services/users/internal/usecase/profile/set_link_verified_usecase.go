@@ -0,0 +1,26 @@
+package profile
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (p *ProfileUsecase) SetLinkVerified(
+	ctx context.Context,
+	userID int,
+	linkURL string,
+	verified bool,
+) error {
+	err := p.profileRepository.SetLinkVerified(ctx, userID, linkURL, verified)
+	if err != nil {
+		if errors.Is(err, constants.ErrProfileLinkNotFound) {
+			return err
+		}
+
+		return constants.ErrInternalServer
+	}
+
+	return nil
+}
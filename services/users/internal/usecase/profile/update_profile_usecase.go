@@ -15,7 +15,7 @@ func (p *ProfileUsecase) UpdateProfile(
 ) error {
 	err := p.profileRepository.Update(ctx, userID, updates)
 	if err != nil {
-		if errors.Is(err, constants.ErrUserNotFound) {
+		if errors.Is(err, constants.ErrUserNotFound) || errors.Is(err, constants.ErrInvalidProfile) {
 			return err
 		}
 
@@ -0,0 +1,32 @@
+package security_event
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SecurityEventUsecase) ListUserEvents(
+	ctx context.Context,
+	userID int,
+	cursorID int,
+) ([]domain.SecurityEvent, bool, error) {
+	filter := &domain.SecurityEventFilter{
+		UserID:   &userID,
+		CursorID: cursorID,
+		Limit:    userPageSize + 1,
+	}
+
+	events, err := s.securityEventRepository.Query(ctx, filter)
+	if err != nil {
+		return nil, false, constants.ErrInternalServer
+	}
+
+	hasMore := len(events) > userPageSize
+	if hasMore {
+		events = events[:userPageSize]
+	}
+
+	return events, hasMore, nil
+}
@@ -0,0 +1,40 @@
+package security_event
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SecurityEventUsecase) QueryEvents(
+	ctx context.Context,
+	filter *domain.SecurityEventFilter,
+) ([]domain.SecurityEvent, bool, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultQuerySize
+	}
+	if limit > maxQuerySize {
+		limit = maxQuerySize
+	}
+
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return nil, false, constants.ErrInvalidData
+	}
+
+	query := *filter
+	query.Limit = limit + 1
+
+	events, err := s.securityEventRepository.Query(ctx, &query)
+	if err != nil {
+		return nil, false, constants.ErrInternalServer
+	}
+
+	hasMore := len(events) > limit
+	if hasMore {
+		events = events[:limit]
+	}
+
+	return events, hasMore, nil
+}
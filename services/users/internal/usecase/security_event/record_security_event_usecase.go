@@ -0,0 +1,58 @@
+package security_event
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SecurityEventUsecase) Record(
+	ctx context.Context,
+	event *domain.SecurityEvent,
+) error {
+	err := s.securityEventRepository.Create(ctx, event)
+	if err != nil {
+		return constants.ErrInternalServer
+	}
+
+	return nil
+}
+
+// RecordFailedLogin attaches the attempt to the targeted account when the
+// credentials resolve to one, so owners can see attempts against them. What
+// was typed is never stored, it may be a mistyped password.
+func (s *SecurityEventUsecase) RecordFailedLogin(
+	ctx context.Context,
+	credentials string,
+	event *domain.SecurityEvent,
+) error {
+	user, err := s.userRepository.GetByCredentials(ctx, credentials)
+	if err != nil && !errors.Is(err, constants.ErrUserNotFound) {
+		return constants.ErrInternalServer
+	}
+
+	if user != nil {
+		event.UserID = &user.ID
+	}
+
+	event.EventType = domain.SecurityEventLoginFailed
+	if user == nil {
+		event.Detail = maskCredentials(credentials)
+	}
+
+	return s.Record(ctx, event)
+}
+
+// maskCredentials keeps only the domain of an email, enough to spot attempts
+// against one provider, and drops usernames.
+func maskCredentials(credentials string) string {
+	at := strings.LastIndex(credentials, "@")
+	if at < 0 {
+		return ""
+	}
+
+	return "*" + credentials[at:]
+}
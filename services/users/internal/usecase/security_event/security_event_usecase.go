@@ -0,0 +1,30 @@
+package security_event
+
+import (
+	"time"
+	"voidspace/users/internal/domain"
+)
+
+const (
+	userPageSize     = 20
+	defaultQuerySize = 50
+	maxQuerySize     = 100
+)
+
+type SecurityEventUsecase struct {
+	securityEventRepository domain.SecurityEventRepository
+	userRepository          domain.UserRepository
+	contextTimeout          time.Duration
+}
+
+func NewSecurityEventUsecase(
+	securityEventRepository domain.SecurityEventRepository,
+	userRepository domain.UserRepository,
+	contextTimeout time.Duration,
+) domain.SecurityEventUsecase {
+	return &SecurityEventUsecase{
+		securityEventRepository: securityEventRepository,
+		userRepository:          userRepository,
+		contextTimeout:          contextTimeout,
+	}
+}
@@ -0,0 +1,35 @@
+package session
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+	"voidspace/users/utils/token"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SessionUsecase) CreateSession(
+	ctx context.Context,
+	userID int,
+	userAgent string,
+	ipAddress string,
+) (*domain.Session, error) {
+	family, err := token.GenerateTokenFamily()
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	session := &domain.Session{
+		UserID:      userID,
+		TokenFamily: family,
+		UserAgent:   userAgent,
+		IPAddress:   ipAddress,
+	}
+
+	err = s.sessionRepository.Create(ctx, session)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	return session, nil
+}
@@ -0,0 +1,20 @@
+package session
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SessionUsecase) ListSessions(
+	ctx context.Context,
+	userID int,
+) ([]domain.Session, error) {
+	sessions, err := s.sessionRepository.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	return sessions, nil
+}
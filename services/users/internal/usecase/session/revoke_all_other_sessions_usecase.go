@@ -0,0 +1,24 @@
+package session
+
+import (
+	"context"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SessionUsecase) RevokeAllOtherSessions(
+	ctx context.Context,
+	userID int,
+	currentSessionID int,
+) (int, error) {
+	if currentSessionID == 0 {
+		return 0, constants.ErrSessionNotFound
+	}
+
+	revoked, err := s.sessionRepository.RevokeAllExcept(ctx, userID, currentSessionID)
+	if err != nil {
+		return 0, constants.ErrInternalServer
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,25 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SessionUsecase) RevokeSession(
+	ctx context.Context,
+	userID int,
+	sessionID int,
+) error {
+	err := s.sessionRepository.Revoke(ctx, userID, sessionID)
+	if err != nil {
+		if errors.Is(err, constants.ErrSessionNotFound) {
+			return err
+		}
+
+		return constants.ErrInternalServer
+	}
+
+	return nil
+}
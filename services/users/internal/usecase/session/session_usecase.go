@@ -0,0 +1,21 @@
+package session
+
+import (
+	"time"
+	"voidspace/users/internal/domain"
+)
+
+type SessionUsecase struct {
+	sessionRepository domain.SessionRepository
+	contextTimeout    time.Duration
+}
+
+func NewSessionUsecase(
+	sessionRepository domain.SessionRepository,
+	contextTimeout time.Duration,
+) domain.SessionUsecase {
+	return &SessionUsecase{
+		sessionRepository: sessionRepository,
+		contextTimeout:    contextTimeout,
+	}
+}
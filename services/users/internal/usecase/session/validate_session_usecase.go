@@ -0,0 +1,38 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// ValidateSession makes sure the refresh token still belongs to a live
+// session. Unknown, foreign and revoked sessions are all reported as revoked
+// so the caller is forced to log in again.
+func (s *SessionUsecase) ValidateSession(
+	ctx context.Context,
+	userID int,
+	sessionID int,
+	tokenFamily string,
+) error {
+	session, err := s.sessionRepository.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, constants.ErrSessionNotFound) {
+			return constants.ErrSessionRevoked
+		}
+
+		return constants.ErrInternalServer
+	}
+
+	if session.UserID != userID || session.TokenFamily != tokenFamily || session.RevokedAt != nil {
+		return constants.ErrSessionRevoked
+	}
+
+	err = s.sessionRepository.Touch(ctx, sessionID)
+	if err != nil {
+		return constants.ErrInternalServer
+	}
+
+	return nil
+}
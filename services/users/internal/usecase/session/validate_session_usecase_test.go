@@ -0,0 +1,85 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"voidspace/users/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type fakeSessionRepository struct {
+	domain.SessionRepository
+	session *domain.Session
+	err     error
+	touched bool
+}
+
+func (f *fakeSessionRepository) GetByID(ctx context.Context, sessionID int) (*domain.Session, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.session, nil
+}
+
+func (f *fakeSessionRepository) Touch(ctx context.Context, sessionID int) error {
+	f.touched = true
+	return nil
+}
+
+func TestValidateSession(t *testing.T) {
+	revokedAt := time.Now().Add(-time.Minute)
+
+	live := func() *domain.Session {
+		return &domain.Session{ID: 7, UserID: 1, TokenFamily: "family"}
+	}
+
+	testCases := []struct {
+		name        string
+		session     func() *domain.Session
+		repoErr     error
+		userID      int
+		tokenFamily string
+		expectedErr error
+	}{
+		{name: "Live session", session: live, userID: 1, tokenFamily: "family"},
+		{
+			name: "Revoked session",
+			session: func() *domain.Session {
+				s := live()
+				s.RevokedAt = &revokedAt
+				return s
+			},
+			userID:      1,
+			tokenFamily: "family",
+			expectedErr: constants.ErrSessionRevoked,
+		},
+		{name: "Token family mismatch", session: live, userID: 1, tokenFamily: "rotated", expectedErr: constants.ErrSessionRevoked},
+		{name: "Another user's session", session: live, userID: 2, tokenFamily: "family", expectedErr: constants.ErrSessionRevoked},
+		{name: "Unknown session", repoErr: constants.ErrSessionNotFound, userID: 1, tokenFamily: "family", expectedErr: constants.ErrSessionRevoked},
+		{name: "Repository failure", repoErr: errors.New("connection reset"), userID: 1, tokenFamily: "family", expectedErr: constants.ErrInternalServer},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeSessionRepository{err: tc.repoErr}
+			if tc.session != nil {
+				repo.session = tc.session()
+			}
+			usecase := NewSessionUsecase(repo, time.Second)
+
+			err := usecase.ValidateSession(context.Background(), tc.userID, 7, tc.tokenFamily)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.False(t, repo.touched, "a rejected session must not be touched")
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, repo.touched)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package settings
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// GetSettingsByUserIDs returns one entry per requested id in request order,
+// falling back to the defaults for users without stored settings.
+func (s *SettingsUsecase) GetSettingsByUserIDs(
+	ctx context.Context,
+	userIDs []int,
+) ([]domain.Settings, error) {
+	stored, err := s.settingsRepository.GetByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	settingsMap := make(map[int]domain.Settings, len(stored))
+	for _, settings := range stored {
+		settingsMap[settings.UserID] = settings
+	}
+
+	result := make([]domain.Settings, 0, len(userIDs))
+	for _, userID := range userIDs {
+		settings, ok := settingsMap[userID]
+		if !ok {
+			settings = domain.DefaultSettings(userID)
+		}
+		result = append(result, settings)
+	}
+
+	return result, nil
+}
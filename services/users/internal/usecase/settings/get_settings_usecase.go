@@ -0,0 +1,18 @@
+package settings
+
+import (
+	"context"
+	"voidspace/users/internal/domain"
+)
+
+func (s *SettingsUsecase) GetSettings(
+	ctx context.Context,
+	userID int,
+) (*domain.Settings, error) {
+	settings, err := s.GetSettingsByUserIDs(ctx, []int{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings[0], nil
+}
@@ -0,0 +1,21 @@
+package settings
+
+import (
+	"time"
+	"voidspace/users/internal/domain"
+)
+
+type SettingsUsecase struct {
+	settingsRepository domain.SettingsRepository
+	contextTimeout     time.Duration
+}
+
+func NewSettingsUsecase(
+	settingsRepository domain.SettingsRepository,
+	contextTimeout time.Duration,
+) domain.SettingsUsecase {
+	return &SettingsUsecase{
+		settingsRepository: settingsRepository,
+		contextTimeout:     contextTimeout,
+	}
+}
@@ -0,0 +1,38 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"voidspace/users/internal/domain"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+func (s *SettingsUsecase) UpdateSettings(
+	ctx context.Context,
+	userID int,
+	update *domain.SettingsUpdate,
+) (*domain.Settings, error) {
+	settings, err := s.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings.Apply(update)
+
+	err = settings.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.settingsRepository.Upsert(ctx, settings)
+	if err != nil {
+		if errors.Is(err, constants.ErrUserNotFound) || errors.Is(err, constants.ErrInvalidSettings) {
+			return nil, err
+		}
+
+		return nil, constants.ErrInternalServer
+	}
+
+	return settings, nil
+}
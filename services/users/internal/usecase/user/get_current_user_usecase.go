@@ -20,6 +20,11 @@ func (u *UserUsecase) GetCurrentUser(
 		return nil, constants.ErrInternalServer
 	}
 
+	user.Links, err = u.profileRepository.GetLinks(ctx, user.ID)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
 	return user, nil
 
 }
@@ -20,5 +20,13 @@ func (u *UserUsecase) GetUserByID(
 		return nil, constants.ErrInternalServer
 	}
 
+	user.Links, err = u.profileRepository.GetLinks(ctx, user.ID)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	// the caller is not known here, only public birthdays are shared
+	user.HideBirthdayFrom(0)
+
 	return user, nil
 }
@@ -22,7 +22,13 @@ func (u *UserUsecase) GetUser(
 		return nil, constants.ErrInternalServer
 	}
 
+	user.Links, err = u.profileRepository.GetLinks(ctx, user.ID)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
 	if authUserID == 0 {
+		user.HideBirthdayFrom(authUserID)
 		return user, nil
 	}
 
@@ -32,6 +38,7 @@ func (u *UserUsecase) GetUser(
 	}
 
 	user.IsFollowed = exist
+	user.HideBirthdayFrom(authUserID)
 
 	return user, nil
 }
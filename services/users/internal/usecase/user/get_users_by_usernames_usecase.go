@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+	"voidspace/users/internal/domain/views"
+
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+// maxUsernamesLookup bounds one batch, a post can not mention more users
+// than fit in its content anyway.
+const maxUsernamesLookup = 50
+
+func (u *UserUsecase) GetUsersByUsernames(
+	ctx context.Context,
+	usernames []string,
+) ([]views.UserBanner, error) {
+	if len(usernames) > maxUsernamesLookup {
+		return nil, constants.ErrInvalidData
+	}
+
+	users, err := u.userRepository.GetByUsernames(ctx, usernames)
+	if err != nil {
+		return nil, constants.ErrInternalServer
+	}
+
+	return users, nil
+}
@@ -15,7 +15,17 @@ func (u *UserUsecase) Register(
 	username string,
 	email string,
 	password string,
+	inviteCode string,
 ) (*domain.User, error) {
+	switch u.registrationMode {
+	case domain.RegistrationClosed:
+		return nil, constants.ErrRegistrationClosed
+	case domain.RegistrationInvite:
+		if inviteCode == "" {
+			return nil, constants.ErrInviteRequired
+		}
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword(
 		[]byte(password),
 		bcrypt.DefaultCost,
@@ -34,9 +44,14 @@ func (u *UserUsecase) Register(
 		UpdatedAt:    now,
 	}
 
-	err = u.userRepository.Create(ctx, user)
+	// a code is still honoured in open mode so we know who invited whom
+	if inviteCode != "" {
+		err = u.userRepository.CreateWithInvite(ctx, user, inviteCode)
+	} else {
+		err = u.userRepository.Create(ctx, user)
+	}
 	if err != nil {
-		if errors.Is(err, constants.ErrUserExists) {
+		if errors.Is(err, constants.ErrUserExists) || errors.Is(err, constants.ErrInvalidInvite) {
 			return nil, err
 		}
 
@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"voidspace/users/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vhysxl/voidspace/shared/utils/constants"
+)
+
+type fakeUserRepository struct {
+	domain.UserRepository
+	inviteErr  error
+	created    bool
+	inviteCode string
+}
+
+func (f *fakeUserRepository) Create(ctx context.Context, user *domain.User) error {
+	f.created = true
+	return nil
+}
+
+func (f *fakeUserRepository) CreateWithInvite(ctx context.Context, user *domain.User, inviteCode string) error {
+	if f.inviteErr != nil {
+		return f.inviteErr
+	}
+	f.created = true
+	f.inviteCode = inviteCode
+	return nil
+}
+
+func TestRegisterModes(t *testing.T) {
+	testCases := []struct {
+		name        string
+		mode        domain.RegistrationMode
+		inviteCode  string
+		inviteErr   error
+		wantInvite  bool
+		expectedErr error
+	}{
+		{name: "Open without a code", mode: domain.RegistrationOpen},
+		{name: "Open with a code records the invite", mode: domain.RegistrationOpen, inviteCode: "CODE", wantInvite: true},
+		{name: "Open with an invalid code", mode: domain.RegistrationOpen, inviteCode: "CODE", inviteErr: constants.ErrInvalidInvite, expectedErr: constants.ErrInvalidInvite},
+		{name: "Invite without a code", mode: domain.RegistrationInvite, expectedErr: constants.ErrInviteRequired},
+		{name: "Invite with a code", mode: domain.RegistrationInvite, inviteCode: "CODE", wantInvite: true},
+		{name: "Invite with a used up code", mode: domain.RegistrationInvite, inviteCode: "CODE", inviteErr: constants.ErrInvalidInvite, expectedErr: constants.ErrInvalidInvite},
+		{name: "Closed without a code", mode: domain.RegistrationClosed, expectedErr: constants.ErrRegistrationClosed},
+		{name: "Closed with a code", mode: domain.RegistrationClosed, inviteCode: "CODE", expectedErr: constants.ErrRegistrationClosed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeUserRepository{inviteErr: tc.inviteErr}
+			usecase := NewUserUsecase(repo, nil, nil, tc.mode, 0)
+
+			user, err := usecase.Register(context.Background(), "alice", "alice@example.com", "password", tc.inviteCode)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, user)
+				assert.False(t, repo.created)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, repo.created)
+			if tc.wantInvite {
+				assert.Equal(t, tc.inviteCode, repo.inviteCode)
+			} else {
+				assert.Empty(t, repo.inviteCode)
+			}
+		})
+	}
+}
@@ -6,19 +6,25 @@ import (
 )
 
 type UserUsecase struct {
-	userRepository   domain.UserRepository
-	followRepository domain.FollowRepository
-	contextTimeout   time.Duration
+	userRepository    domain.UserRepository
+	followRepository  domain.FollowRepository
+	profileRepository domain.ProfileRepository
+	registrationMode  domain.RegistrationMode
+	contextTimeout    time.Duration
 }
 
 func NewUserUsecase(
 	userRepository domain.UserRepository,
 	followRepository domain.FollowRepository,
+	profileRepository domain.ProfileRepository,
+	registrationMode domain.RegistrationMode,
 	contextTimeout time.Duration,
 ) domain.UserUsecase {
 	return &UserUsecase{
-		userRepository:   userRepository,
-		followRepository: followRepository,
-		contextTimeout:   contextTimeout,
+		userRepository:    userRepository,
+		followRepository:  followRepository,
+		profileRepository: profileRepository,
+		registrationMode:  registrationMode,
+		contextTimeout:    contextTimeout,
 	}
 }
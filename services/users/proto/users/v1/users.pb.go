@@ -24,10 +24,12 @@ const (
 )
 
 type RegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// required when registration is invite-only
+	InviteCode    string `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,6 +85,13 @@ func (x *RegisterRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 type LoginRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	EmailOrUsername string                 `protobuf:"bytes,1,opt,name=email_or_username,json=emailOrUsername,proto3" json:"email_or_username,omitempty"`
@@ -267,20 +276,70 @@ func (x *GetUsersRequest) GetUserIds() []int64 {
 	return nil
 }
 
-type UpdateProfileRequest struct {
+type GetUsersByUsernamesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName   *string                `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
-	Bio           *string                `protobuf:"bytes,2,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
-	AvatarUrl     *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
-	BannerUrl     *string                `protobuf:"bytes,4,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
-	Location      *string                `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Usernames     []string               `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByUsernamesRequest) Reset() {
+	*x = GetUsersByUsernamesRequest{}
+	mi := &file_users_v1_users_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByUsernamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByUsernamesRequest) ProtoMessage() {}
+
+func (x *GetUsersByUsernamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByUsernamesRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByUsernamesRequest) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUsersByUsernamesRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName *string                `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	Bio         *string                `protobuf:"bytes,2,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	AvatarUrl   *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	BannerUrl   *string                `protobuf:"bytes,4,opt,name=banner_url,json=bannerUrl,proto3,oneof" json:"banner_url,omitempty"`
+	Location    *string                `protobuf:"bytes,5,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Pronouns    *string                `protobuf:"bytes,6,opt,name=pronouns,proto3,oneof" json:"pronouns,omitempty"`
+	// YYYY-MM-DD, empty string clears it
+	Birthday           *string `protobuf:"bytes,7,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
+	BirthdayVisibility *string `protobuf:"bytes,8,opt,name=birthday_visibility,json=birthdayVisibility,proto3,oneof" json:"birthday_visibility,omitempty"`
+	// replaces every link when set
+	Links         *ProfileLinks `protobuf:"bytes,9,opt,name=links,proto3,oneof" json:"links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_users_v1_users_proto_msgTypes[5]
+	mi := &file_users_v1_users_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +351,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[5]
+	mi := &file_users_v1_users_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +364,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{5}
+	return file_users_v1_users_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateProfileRequest) GetDisplayName() string {
@@ -343,71 +402,56 @@ func (x *UpdateProfileRequest) GetLocation() string {
 	return ""
 }
 
-type FollowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FollowRequest) Reset() {
-	*x = FollowRequest{}
-	mi := &file_users_v1_users_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FollowRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateProfileRequest) GetPronouns() string {
+	if x != nil && x.Pronouns != nil {
+		return *x.Pronouns
+	}
+	return ""
 }
 
-func (*FollowRequest) ProtoMessage() {}
-
-func (x *FollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateProfileRequest) GetBirthday() string {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
-func (*FollowRequest) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{6}
+func (x *UpdateProfileRequest) GetBirthdayVisibility() string {
+	if x != nil && x.BirthdayVisibility != nil {
+		return *x.BirthdayVisibility
+	}
+	return ""
 }
 
-func (x *FollowRequest) GetUserId() int64 {
+func (x *UpdateProfileRequest) GetLinks() *ProfileLinks {
 	if x != nil {
-		return x.UserId
+		return x.Links
 	}
-	return 0
+	return nil
 }
 
-type UnfollowRequest struct {
+type SetProfileLinkVerifiedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowRequest) Reset() {
-	*x = UnfollowRequest{}
+func (x *SetProfileLinkVerifiedRequest) Reset() {
+	*x = SetProfileLinkVerifiedRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowRequest) String() string {
+func (x *SetProfileLinkVerifiedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowRequest) ProtoMessage() {}
+func (*SetProfileLinkVerifiedRequest) ProtoMessage() {}
 
-func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+func (x *SetProfileLinkVerifiedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,39 +463,46 @@ func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProfileLinkVerifiedRequest.ProtoReflect.Descriptor instead.
+func (*SetProfileLinkVerifiedRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UnfollowRequest) GetUserId() int64 {
+func (x *SetProfileLinkVerifiedRequest) GetUrl() string {
 	if x != nil {
-		return x.UserId
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-type RestoreUserRequest struct {
+func (x *SetProfileLinkVerifiedRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type FollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestoreUserRequest) Reset() {
-	*x = RestoreUserRequest{}
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestoreUserRequest) String() string {
+func (x *FollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreUserRequest) ProtoMessage() {}
+func (*FollowRequest) ProtoMessage() {}
 
-func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,39 +514,41 @@ func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
-func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RestoreUserRequest) GetUserId() int64 {
+func (x *FollowRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type SearchUsersRequest struct {
+// FilterFollowingRequest asks which of target_user_ids user_id follows
+type FilterFollowingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserIds []int64                `protobuf:"varint,2,rep,packed,name=target_user_ids,json=targetUserIds,proto3" json:"target_user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersRequest) Reset() {
-	*x = SearchUsersRequest{}
+func (x *FilterFollowingRequest) Reset() {
+	*x = FilterFollowingRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersRequest) String() string {
+func (x *FilterFollowingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersRequest) ProtoMessage() {}
+func (*FilterFollowingRequest) ProtoMessage() {}
 
-func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *FilterFollowingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,42 +560,46 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
-func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilterFollowingRequest.ProtoReflect.Descriptor instead.
+func (*FilterFollowingRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SearchUsersRequest) GetQuery() string {
+func (x *FilterFollowingRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Query
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type AuthResponse struct {
+func (x *FilterFollowingRequest) GetTargetUserIds() []int64 {
+	if x != nil {
+		return x.TargetUserIds
+	}
+	return nil
+}
+
+type UnfollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  *string                `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3,oneof" json:"refresh_token,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthResponse) Reset() {
-	*x = AuthResponse{}
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthResponse) String() string {
+func (x *UnfollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResponse) ProtoMessage() {}
+func (*UnfollowRequest) ProtoMessage() {}
 
-func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,60 +611,39 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
-func (*AuthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AuthResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *AuthResponse) GetRefreshToken() string {
-	if x != nil && x.RefreshToken != nil {
-		return *x.RefreshToken
-	}
-	return ""
-}
-
-func (x *AuthResponse) GetExpiresIn() int64 {
+func (x *UnfollowRequest) GetUserId() int64 {
 	if x != nil {
-		return x.ExpiresIn
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *AuthResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type GetCurrentUserResponse struct {
+type RestoreUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentUserResponse) Reset() {
-	*x = GetCurrentUserResponse{}
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentUserResponse) String() string {
+func (x *RestoreUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserResponse) ProtoMessage() {}
+func (*RestoreUserRequest) ProtoMessage() {}
 
-func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,39 +655,39 @@ func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetCurrentUserResponse) GetUser() *UserProfile {
+func (x *RestoreUserRequest) GetUserId() int64 {
 	if x != nil {
-		return x.User
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-type GetUserResponse struct {
+type SearchUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *SearchUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*SearchUsersRequest) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,39 +699,39 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetUserResponse) GetUser() *UserProfile {
+func (x *SearchUsersRequest) GetQuery() string {
 	if x != nil {
-		return x.User
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-type GetUsersResponse struct {
+type RevokeSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	SessionId     int64                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersResponse) Reset() {
-	*x = GetUsersResponse{}
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersResponse) String() string {
+func (x *RevokeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersResponse) ProtoMessage() {}
+func (*RevokeSessionRequest) ProtoMessage() {}
 
-func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,39 +743,39 @@ func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetUsersResponse) GetUsers() []*UserProfile {
+func (x *RevokeSessionRequest) GetSessionId() int64 {
 	if x != nil {
-		return x.Users
+		return x.SessionId
 	}
-	return nil
+	return 0
 }
 
-type ListFollowersResponse struct {
+type ListSecurityEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,1,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFollowersResponse) Reset() {
-	*x = ListFollowersResponse{}
+func (x *ListSecurityEventsRequest) Reset() {
+	*x = ListSecurityEventsRequest{}
 	mi := &file_users_v1_users_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFollowersResponse) String() string {
+func (x *ListSecurityEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFollowersResponse) ProtoMessage() {}
+func (*ListSecurityEventsRequest) ProtoMessage() {}
 
-func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSecurityEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_users_v1_users_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,40 +787,232 @@ func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
-func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSecurityEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecurityEventsRequest) Descriptor() ([]byte, []int) {
 	return file_users_v1_users_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListFollowersResponse) GetUsers() []*UserBanner {
+func (x *ListSecurityEventsRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+type UpdateSettingsRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DefaultPostVisibility *string                `protobuf:"bytes,1,opt,name=default_post_visibility,json=defaultPostVisibility,proto3,oneof" json:"default_post_visibility,omitempty"`
+	ReplyPolicy           *string                `protobuf:"bytes,2,opt,name=reply_policy,json=replyPolicy,proto3,oneof" json:"reply_policy,omitempty"`
+	MentionPolicy         *string                `protobuf:"bytes,3,opt,name=mention_policy,json=mentionPolicy,proto3,oneof" json:"mention_policy,omitempty"`
+	NotifyLikes           *bool                  `protobuf:"varint,4,opt,name=notify_likes,json=notifyLikes,proto3,oneof" json:"notify_likes,omitempty"`
+	NotifyComments        *bool                  `protobuf:"varint,5,opt,name=notify_comments,json=notifyComments,proto3,oneof" json:"notify_comments,omitempty"`
+	NotifyFollows         *bool                  `protobuf:"varint,6,opt,name=notify_follows,json=notifyFollows,proto3,oneof" json:"notify_follows,omitempty"`
+	NotifyMentions        *bool                  `protobuf:"varint,7,opt,name=notify_mentions,json=notifyMentions,proto3,oneof" json:"notify_mentions,omitempty"`
+	Language              *string                `protobuf:"bytes,8,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	SensitiveMedia        *string                `protobuf:"bytes,9,opt,name=sensitive_media,json=sensitiveMedia,proto3,oneof" json:"sensitive_media,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_users_v1_users_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[15]
 	if x != nil {
-		return x.Users
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateSettingsRequest) GetDefaultPostVisibility() string {
+	if x != nil && x.DefaultPostVisibility != nil {
+		return *x.DefaultPostVisibility
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetReplyPolicy() string {
+	if x != nil && x.ReplyPolicy != nil {
+		return *x.ReplyPolicy
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetMentionPolicy() string {
+	if x != nil && x.MentionPolicy != nil {
+		return *x.MentionPolicy
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetNotifyLikes() bool {
+	if x != nil && x.NotifyLikes != nil {
+		return *x.NotifyLikes
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetNotifyComments() bool {
+	if x != nil && x.NotifyComments != nil {
+		return *x.NotifyComments
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetNotifyFollows() bool {
+	if x != nil && x.NotifyFollows != nil {
+		return *x.NotifyFollows
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetNotifyMentions() bool {
+	if x != nil && x.NotifyMentions != nil {
+		return *x.NotifyMentions
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetSensitiveMedia() string {
+	if x != nil && x.SensitiveMedia != nil {
+		return *x.SensitiveMedia
+	}
+	return ""
+}
+
+type QuerySecurityEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	EventType     *string                `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	CursorId      *int64                 `protobuf:"varint,5,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySecurityEventsRequest) Reset() {
+	*x = QuerySecurityEventsRequest{}
+	mi := &file_users_v1_users_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySecurityEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySecurityEventsRequest) ProtoMessage() {}
+
+func (x *QuerySecurityEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySecurityEventsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySecurityEventsRequest) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuerySecurityEventsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *QuerySecurityEventsRequest) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *QuerySecurityEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
 	}
 	return nil
 }
 
-type ListFollowingResponse struct {
+func (x *QuerySecurityEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *QuerySecurityEventsRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+func (x *QuerySecurityEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type JoinWaitlistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFollowingResponse) Reset() {
-	*x = ListFollowingResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[15]
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_users_v1_users_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFollowingResponse) String() string {
+func (x *JoinWaitlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFollowingResponse) ProtoMessage() {}
+func (*JoinWaitlistRequest) ProtoMessage() {}
 
-func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[15]
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,75 +1023,1652 @@ func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
-func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListFollowingResponse) GetUsers() []*UserBanner {
+func (x *JoinWaitlistRequest) GetEmail() string {
 	if x != nil {
-		return x.Users
+		return x.Email
+	}
+	return ""
+}
+
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxUses       int32                  `protobuf:"varint,1,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_users_v1_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type UpdateProfileResponse struct {
+type ListInvitesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// admin only, ignored for regular users
+	IssuerId      *int64 `protobuf:"varint,1,opt,name=issuer_id,json=issuerId,proto3,oneof" json:"issuer_id,omitempty"`
+	CursorId      *int64 `protobuf:"varint,2,opt,name=cursor_id,json=cursorId,proto3,oneof" json:"cursor_id,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_users_v1_users_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListInvitesRequest) GetIssuerId() int64 {
+	if x != nil && x.IssuerId != nil {
+		return *x.IssuerId
+	}
+	return 0
+}
+
+func (x *ListInvitesRequest) GetCursorId() int64 {
+	if x != nil && x.CursorId != nil {
+		return *x.CursorId
+	}
+	return 0
+}
+
+func (x *ListInvitesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  *string                `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3,oneof" json:"refresh_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[16]
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuthResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *AuthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCurrentUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserResponse) Reset() {
+	*x = GetCurrentUserResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserResponse) ProtoMessage() {}
+
+func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCurrentUserResponse) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetUserResponse) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersResponse) Reset() {
+	*x = GetUsersResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersResponse) ProtoMessage() {}
+
+func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUsersResponse) GetUsers() []*UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUsersByUsernamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByUsernamesResponse) Reset() {
+	*x = GetUsersByUsernamesResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByUsernamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByUsernamesResponse) ProtoMessage() {}
+
+func (x *GetUsersByUsernamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByUsernamesResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByUsernamesResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUsersByUsernamesResponse) GetUsers() []*UserBanner {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersResponse) Reset() {
+	*x = ListFollowersResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersResponse) ProtoMessage() {}
+
+func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListFollowersResponse) GetUsers() []*UserBanner {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingResponse) Reset() {
+	*x = ListFollowingResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingResponse) ProtoMessage() {}
+
+func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListFollowingResponse) GetUsers() []*UserBanner {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{27}
+}
+
+type SetProfileLinkVerifiedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProfileLinkVerifiedResponse) Reset() {
+	*x = SetProfileLinkVerifiedResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProfileLinkVerifiedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProfileLinkVerifiedResponse) ProtoMessage() {}
+
+func (x *SetProfileLinkVerifiedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProfileLinkVerifiedResponse.ProtoReflect.Descriptor instead.
+func (*SetProfileLinkVerifiedResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{28}
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{29}
+}
+
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{30}
+}
+
+type FollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowResponse) Reset() {
+	*x = FollowResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResponse) ProtoMessage() {}
+
+func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{31}
+}
+
+type UnfollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowResponse) Reset() {
+	*x = UnfollowResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowResponse) ProtoMessage() {}
+
+func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{32}
+}
+
+type FilterFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterFollowingResponse) Reset() {
+	*x = FilterFollowingResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterFollowingResponse) ProtoMessage() {}
+
+func (x *FilterFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterFollowingResponse.ProtoReflect.Descriptor instead.
+func (*FilterFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FilterFollowingResponse) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*UserBanner {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{36}
+}
+
+type RevokeAllOtherSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevokedCount  int64                  `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsResponse) Reset() {
+	*x = RevokeAllOtherSessionsResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevokeAllOtherSessionsResponse) GetRevokedCount() int64 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsResponse) Reset() {
+	*x = GetSettingsResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsResponse) ProtoMessage() {}
+
+func (x *GetSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetUsersSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*UserSettings        `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersSettingsResponse) Reset() {
+	*x = GetUsersSettingsResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersSettingsResponse) ProtoMessage() {}
+
+func (x *GetUsersSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetUsersSettingsResponse) GetSettings() []*UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SecurityEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*SecurityEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityEventsResponse) Reset() {
+	*x = SecurityEventsResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEventsResponse) ProtoMessage() {}
+
+func (x *SecurityEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEventsResponse.ProtoReflect.Descriptor instead.
+func (*SecurityEventsResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SecurityEventsResponse) GetEvents() []*SecurityEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SecurityEventsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UserProfile struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName        string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio                string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl          string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	BannerUrl          string                 `protobuf:"bytes,6,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	Location           string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Followers          int64                  `protobuf:"varint,8,opt,name=followers,proto3" json:"followers,omitempty"`
+	Following          int64                  `protobuf:"varint,9,opt,name=following,proto3" json:"following,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsFollowed         bool                   `protobuf:"varint,11,opt,name=is_followed,json=isFollowed,proto3" json:"is_followed,omitempty"`
+	Pronouns           string                 `protobuf:"bytes,12,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	Birthday           string                 `protobuf:"bytes,13,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	BirthdayVisibility string                 `protobuf:"bytes,14,opt,name=birthday_visibility,json=birthdayVisibility,proto3" json:"birthday_visibility,omitempty"`
+	Links              []*ProfileLink         `protobuf:"bytes,15,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_users_v1_users_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UserProfile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+func (x *UserProfile) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UserProfile) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *UserProfile) GetFollowing() int64 {
+	if x != nil {
+		return x.Following
+	}
+	return 0
+}
+
+func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserProfile) GetIsFollowed() bool {
+	if x != nil {
+		return x.IsFollowed
+	}
+	return false
+}
+
+func (x *UserProfile) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBirthdayVisibility() string {
+	if x != nil {
+		return x.BirthdayVisibility
+	}
+	return ""
+}
+
+func (x *UserProfile) GetLinks() []*ProfileLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ProfileLinks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*ProfileLink         `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileLinks) Reset() {
+	*x = ProfileLinks{}
+	mi := &file_users_v1_users_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLinks) ProtoMessage() {}
+
+func (x *ProfileLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLinks.ProtoReflect.Descriptor instead.
+func (*ProfileLinks) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ProfileLinks) GetLinks() []*ProfileLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ProfileLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Verified      bool                   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileLink) Reset() {
+	*x = ProfileLink{}
+	mi := &file_users_v1_users_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLink) ProtoMessage() {}
+
+func (x *ProfileLink) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLink.ProtoReflect.Descriptor instead.
+func (*ProfileLink) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ProfileLink) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ProfileLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProfileLink) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type UserBanner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserBanner) Reset() {
+	*x = UserBanner{}
+	mi := &file_users_v1_users_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserBanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBanner) ProtoMessage() {}
+
+func (x *UserBanner) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBanner.ProtoReflect.Descriptor instead.
+func (*UserBanner) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UserBanner) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserBanner) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserBanner) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserBanner) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	IsCurrent     bool                   `protobuf:"varint,6,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_users_v1_users_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Session) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+type SecurityEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Detail        string                 `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityEvent) Reset() {
+	*x = SecurityEvent{}
+	mi := &file_users_v1_users_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEvent) ProtoMessage() {}
+
+func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEvent.ProtoReflect.Descriptor instead.
+func (*SecurityEvent) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SecurityEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SecurityEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SecurityEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type UserSettings struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DefaultPostVisibility string                 `protobuf:"bytes,2,opt,name=default_post_visibility,json=defaultPostVisibility,proto3" json:"default_post_visibility,omitempty"`
+	ReplyPolicy           string                 `protobuf:"bytes,3,opt,name=reply_policy,json=replyPolicy,proto3" json:"reply_policy,omitempty"`
+	MentionPolicy         string                 `protobuf:"bytes,4,opt,name=mention_policy,json=mentionPolicy,proto3" json:"mention_policy,omitempty"`
+	Notifications         *NotificationSettings  `protobuf:"bytes,5,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	Language              string                 `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	SensitiveMedia        string                 `protobuf:"bytes,7,opt,name=sensitive_media,json=sensitiveMedia,proto3" json:"sensitive_media,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_users_v1_users_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UserSettings) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserSettings) GetDefaultPostVisibility() string {
+	if x != nil {
+		return x.DefaultPostVisibility
+	}
+	return ""
+}
+
+func (x *UserSettings) GetReplyPolicy() string {
+	if x != nil {
+		return x.ReplyPolicy
+	}
+	return ""
+}
+
+func (x *UserSettings) GetMentionPolicy() string {
+	if x != nil {
+		return x.MentionPolicy
+	}
+	return ""
+}
+
+func (x *UserSettings) GetNotifications() *NotificationSettings {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
-
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[16]
+func (x *UserSettings) GetLanguage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Language
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{16}
+func (x *UserSettings) GetSensitiveMedia() string {
+	if x != nil {
+		return x.SensitiveMedia
+	}
+	return ""
 }
 
-type DeleteUserResponse struct {
+type NotificationSettings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         bool                   `protobuf:"varint,1,opt,name=likes,proto3" json:"likes,omitempty"`
+	Comments      bool                   `protobuf:"varint,2,opt,name=comments,proto3" json:"comments,omitempty"`
+	Follows       bool                   `protobuf:"varint,3,opt,name=follows,proto3" json:"follows,omitempty"`
+	Mentions      bool                   `protobuf:"varint,4,opt,name=mentions,proto3" json:"mentions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[17]
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	mi := &file_users_v1_users_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *NotificationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*NotificationSettings) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[17]
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,68 +2679,62 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{17}
-}
-
-type RestoreUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RestoreUserResponse) Reset() {
-	*x = RestoreUserResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NotificationSettings) GetLikes() bool {
+	if x != nil {
+		return x.Likes
+	}
+	return false
 }
 
-func (x *RestoreUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NotificationSettings) GetComments() bool {
+	if x != nil {
+		return x.Comments
+	}
+	return false
 }
 
-func (*RestoreUserResponse) ProtoMessage() {}
-
-func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[18]
+func (x *NotificationSettings) GetFollows() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Follows
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
-func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{18}
+func (x *NotificationSettings) GetMentions() bool {
+	if x != nil {
+		return x.Mentions
+	}
+	return false
 }
 
-type FollowResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type RegistrationModeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// open, invite or closed
+	Mode          string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowResponse) Reset() {
-	*x = FollowResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[19]
+func (x *RegistrationModeResponse) Reset() {
+	*x = RegistrationModeResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowResponse) String() string {
+func (x *RegistrationModeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowResponse) ProtoMessage() {}
+func (*RegistrationModeResponse) ProtoMessage() {}
 
-func (x *FollowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[19]
+func (x *RegistrationModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,32 +2745,39 @@ func (x *FollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
-func (*FollowResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RegistrationModeResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationModeResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{49}
 }
 
-type UnfollowResponse struct {
+func (x *RegistrationModeResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type JoinWaitlistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowResponse) Reset() {
-	*x = UnfollowResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[20]
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowResponse) String() string {
+func (x *JoinWaitlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowResponse) ProtoMessage() {}
+func (*JoinWaitlistResponse) ProtoMessage() {}
 
-func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[20]
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,33 +2788,35 @@ func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{50}
 }
 
-type SearchUsersResponse struct {
+type InviteRedemption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserBanner          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RedeemedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchUsersResponse) Reset() {
-	*x = SearchUsersResponse{}
-	mi := &file_users_v1_users_proto_msgTypes[21]
+func (x *InviteRedemption) Reset() {
+	*x = InviteRedemption{}
+	mi := &file_users_v1_users_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchUsersResponse) String() string {
+func (x *InviteRedemption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersResponse) ProtoMessage() {}
+func (*InviteRedemption) ProtoMessage() {}
 
-func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[21]
+func (x *InviteRedemption) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,50 +2827,62 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
-func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use InviteRedemption.ProtoReflect.Descriptor instead.
+func (*InviteRedemption) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SearchUsersResponse) GetUsers() []*UserBanner {
+func (x *InviteRedemption) GetUserId() int64 {
 	if x != nil {
-		return x.Users
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteRedemption) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteRedemption) GetRedeemedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RedeemedAt
 	}
 	return nil
 }
 
-type UserProfile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	BannerUrl     string                 `protobuf:"bytes,6,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
-	Location      string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Followers     int64                  `protobuf:"varint,8,opt,name=followers,proto3" json:"followers,omitempty"`
-	Following     int64                  `protobuf:"varint,9,opt,name=following,proto3" json:"following,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsFollowed    bool                   `protobuf:"varint,11,opt,name=is_followed,json=isFollowed,proto3" json:"is_followed,omitempty"`
+type Invite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// empty for invites issued by an admin
+	IssuerId      *int64                 `protobuf:"varint,3,opt,name=issuer_id,json=issuerId,proto3,oneof" json:"issuer_id,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Redemptions   []*InviteRedemption    `protobuf:"bytes,8,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserProfile) Reset() {
-	*x = UserProfile{}
-	mi := &file_users_v1_users_proto_msgTypes[22]
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_users_v1_users_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfile) String() string {
+func (x *Invite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfile) ProtoMessage() {}
+func (*Invite) ProtoMessage() {}
 
-func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[22]
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,113 +2893,89 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
-func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UserProfile) GetId() int64 {
+func (x *Invite) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UserProfile) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UserProfile) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *UserProfile) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
-}
-
-func (x *UserProfile) GetAvatarUrl() string {
+func (x *Invite) GetCode() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UserProfile) GetBannerUrl() string {
-	if x != nil {
-		return x.BannerUrl
+func (x *Invite) GetIssuerId() int64 {
+	if x != nil && x.IssuerId != nil {
+		return *x.IssuerId
 	}
-	return ""
+	return 0
 }
 
-func (x *UserProfile) GetLocation() string {
+func (x *Invite) GetMaxUses() int32 {
 	if x != nil {
-		return x.Location
+		return x.MaxUses
 	}
-	return ""
+	return 0
 }
 
-func (x *UserProfile) GetFollowers() int64 {
+func (x *Invite) GetUses() int32 {
 	if x != nil {
-		return x.Followers
+		return x.Uses
 	}
 	return 0
 }
 
-func (x *UserProfile) GetFollowing() int64 {
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Following
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *UserProfile) GetIsFollowed() bool {
+func (x *Invite) GetRedemptions() []*InviteRedemption {
 	if x != nil {
-		return x.IsFollowed
+		return x.Redemptions
 	}
-	return false
+	return nil
 }
 
-type UserBanner struct {
+type CreateInviteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserBanner) Reset() {
-	*x = UserBanner{}
-	mi := &file_users_v1_users_proto_msgTypes[23]
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserBanner) String() string {
+func (x *CreateInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserBanner) ProtoMessage() {}
+func (*CreateInviteResponse) ProtoMessage() {}
 
-func (x *UserBanner) ProtoReflect() protoreflect.Message {
-	mi := &file_users_v1_users_proto_msgTypes[23]
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,48 +2986,81 @@ func (x *UserBanner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserBanner.ProtoReflect.Descriptor instead.
-func (*UserBanner) Descriptor() ([]byte, []int) {
-	return file_users_v1_users_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UserBanner) GetId() int64 {
+func (x *CreateInviteResponse) GetInvite() *Invite {
 	if x != nil {
-		return x.Id
+		return x.Invite
 	}
-	return 0
+	return nil
 }
 
-func (x *UserBanner) GetUsername() string {
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_users_v1_users_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_users_proto_msgTypes[54]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserBanner) GetDisplayName() string {
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_users_v1_users_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
 	if x != nil {
-		return x.DisplayName
+		return x.Invites
 	}
-	return ""
+	return nil
 }
 
-func (x *UserBanner) GetAvatarUrl() string {
+func (x *ListInvitesResponse) GetHasMore() bool {
 	if x != nil {
-		return x.AvatarUrl
+		return x.HasMore
 	}
-	return ""
+	return false
 }
 
 var File_users_v1_users_proto protoreflect.FileDescriptor
 
 const file_users_v1_users_proto_rawDesc = "" +
 	"\n" +
-	"\x14users/v1/users.proto\x12\busers.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"_\n" +
+	"\x14users/v1/users.proto\x12\busers.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x01\n" +
 	"\x0fRegisterRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"V\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vinvite_code\x18\x04 \x01(\tR\n" +
+	"inviteCode\"V\n" +
 	"\fLoginRequest\x12*\n" +
 	"\x11email_or_username\x18\x01 \x01(\tR\x0femailOrUsername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\",\n" +
@@ -1240,7 +3069,9 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"\x12GetUserByIdRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\",\n" +
 	"\x0fGetUsersRequest\x12\x19\n" +
-	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"\x82\x02\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\":\n" +
+	"\x1aGetUsersByUsernamesRequest\x12\x1c\n" +
+	"\tusernames\x18\x01 \x03(\tR\tusernames\"\xe9\x03\n" +
 	"\x14UpdateProfileRequest\x12&\n" +
 	"\fdisplay_name\x18\x01 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12\x15\n" +
 	"\x03bio\x18\x02 \x01(\tH\x01R\x03bio\x88\x01\x01\x12\"\n" +
@@ -1248,20 +3079,90 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"avatar_url\x18\x03 \x01(\tH\x02R\tavatarUrl\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"banner_url\x18\x04 \x01(\tH\x03R\tbannerUrl\x88\x01\x01\x12\x1f\n" +
-	"\blocation\x18\x05 \x01(\tH\x04R\blocation\x88\x01\x01B\x0f\n" +
+	"\blocation\x18\x05 \x01(\tH\x04R\blocation\x88\x01\x01\x12\x1f\n" +
+	"\bpronouns\x18\x06 \x01(\tH\x05R\bpronouns\x88\x01\x01\x12\x1f\n" +
+	"\bbirthday\x18\a \x01(\tH\x06R\bbirthday\x88\x01\x01\x124\n" +
+	"\x13birthday_visibility\x18\b \x01(\tH\aR\x12birthdayVisibility\x88\x01\x01\x121\n" +
+	"\x05links\x18\t \x01(\v2\x16.users.v1.ProfileLinksH\bR\x05links\x88\x01\x01B\x0f\n" +
 	"\r_display_nameB\x06\n" +
 	"\x04_bioB\r\n" +
 	"\v_avatar_urlB\r\n" +
 	"\v_banner_urlB\v\n" +
-	"\t_location\"(\n" +
+	"\t_locationB\v\n" +
+	"\t_pronounsB\v\n" +
+	"\t_birthdayB\x16\n" +
+	"\x14_birthday_visibilityB\b\n" +
+	"\x06_links\"M\n" +
+	"\x1dSetProfileLinkVerifiedRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\"(\n" +
 	"\rFollowRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"*\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"Y\n" +
+	"\x16FilterFollowingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x0ftarget_user_ids\x18\x02 \x03(\x03R\rtargetUserIds\"*\n" +
 	"\x0fUnfollowRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"-\n" +
 	"\x12RestoreUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"*\n" +
 	"\x12SearchUsersRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\"\xa6\x01\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03R\tsessionId\"K\n" +
+	"\x19ListSecurityEventsRequest\x12 \n" +
+	"\tcursor_id\x18\x01 \x01(\x03H\x00R\bcursorId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_cursor_id\"\xd4\x04\n" +
+	"\x15UpdateSettingsRequest\x12;\n" +
+	"\x17default_post_visibility\x18\x01 \x01(\tH\x00R\x15defaultPostVisibility\x88\x01\x01\x12&\n" +
+	"\freply_policy\x18\x02 \x01(\tH\x01R\vreplyPolicy\x88\x01\x01\x12*\n" +
+	"\x0emention_policy\x18\x03 \x01(\tH\x02R\rmentionPolicy\x88\x01\x01\x12&\n" +
+	"\fnotify_likes\x18\x04 \x01(\bH\x03R\vnotifyLikes\x88\x01\x01\x12,\n" +
+	"\x0fnotify_comments\x18\x05 \x01(\bH\x04R\x0enotifyComments\x88\x01\x01\x12*\n" +
+	"\x0enotify_follows\x18\x06 \x01(\bH\x05R\rnotifyFollows\x88\x01\x01\x12,\n" +
+	"\x0fnotify_mentions\x18\a \x01(\bH\x06R\x0enotifyMentions\x88\x01\x01\x12\x1f\n" +
+	"\blanguage\x18\b \x01(\tH\aR\blanguage\x88\x01\x01\x12,\n" +
+	"\x0fsensitive_media\x18\t \x01(\tH\bR\x0esensitiveMedia\x88\x01\x01B\x1a\n" +
+	"\x18_default_post_visibilityB\x0f\n" +
+	"\r_reply_policyB\x11\n" +
+	"\x0f_mention_policyB\x0f\n" +
+	"\r_notify_likesB\x12\n" +
+	"\x10_notify_commentsB\x11\n" +
+	"\x0f_notify_followsB\x12\n" +
+	"\x10_notify_mentionsB\v\n" +
+	"\t_languageB\x12\n" +
+	"\x10_sensitive_media\"\xc1\x02\n" +
+	"\x1aQuerySecurityEventsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x03H\x00R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tH\x01R\teventType\x88\x01\x01\x125\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x05since\x88\x01\x01\x125\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x05until\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x05 \x01(\x03H\x04R\bcursorId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limitB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_event_typeB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_untilB\f\n" +
+	"\n" +
+	"_cursor_id\"+\n" +
+	"\x13JoinWaitlistRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x7f\n" +
+	"\x13CreateInviteRequest\x12\x19\n" +
+	"\bmax_uses\x18\x01 \x01(\x05R\amaxUses\x12>\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"\x8a\x01\n" +
+	"\x12ListInvitesRequest\x12 \n" +
+	"\tissuer_id\x18\x01 \x01(\x03H\x00R\bissuerId\x88\x01\x01\x12 \n" +
+	"\tcursor_id\x18\x02 \x01(\x03H\x01R\bcursorId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limitB\f\n" +
+	"\n" +
+	"_issuer_idB\f\n" +
+	"\n" +
+	"_cursor_id\"\xa6\x01\n" +
 	"\fAuthResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12(\n" +
 	"\rrefresh_token\x18\x02 \x01(\tH\x00R\frefreshToken\x88\x01\x01\x12\x1d\n" +
@@ -1274,18 +3175,35 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"\x0fGetUserResponse\x12)\n" +
 	"\x04user\x18\x01 \x01(\v2\x15.users.v1.UserProfileR\x04user\"?\n" +
 	"\x10GetUsersResponse\x12+\n" +
-	"\x05users\x18\x01 \x03(\v2\x15.users.v1.UserProfileR\x05users\"C\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.users.v1.UserProfileR\x05users\"I\n" +
+	"\x1bGetUsersByUsernamesResponse\x12*\n" +
+	"\x05users\x18\x01 \x03(\v2\x14.users.v1.UserBannerR\x05users\"C\n" +
 	"\x15ListFollowersResponse\x12*\n" +
 	"\x05users\x18\x01 \x03(\v2\x14.users.v1.UserBannerR\x05users\"C\n" +
 	"\x15ListFollowingResponse\x12*\n" +
 	"\x05users\x18\x01 \x03(\v2\x14.users.v1.UserBannerR\x05users\"\x17\n" +
-	"\x15UpdateProfileResponse\"\x14\n" +
+	"\x15UpdateProfileResponse\" \n" +
+	"\x1eSetProfileLinkVerifiedResponse\"\x14\n" +
 	"\x12DeleteUserResponse\"\x15\n" +
 	"\x13RestoreUserResponse\"\x10\n" +
 	"\x0eFollowResponse\"\x12\n" +
-	"\x10UnfollowResponse\"A\n" +
+	"\x10UnfollowResponse\"4\n" +
+	"\x17FilterFollowingResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"A\n" +
 	"\x13SearchUsersResponse\x12*\n" +
-	"\x05users\x18\x01 \x03(\v2\x14.users.v1.UserBannerR\x05users\"\xe0\x02\n" +
+	"\x05users\x18\x01 \x03(\v2\x14.users.v1.UserBannerR\x05users\"E\n" +
+	"\x14ListSessionsResponse\x12-\n" +
+	"\bsessions\x18\x01 \x03(\v2\x11.users.v1.SessionR\bsessions\"\x17\n" +
+	"\x15RevokeSessionResponse\"E\n" +
+	"\x1eRevokeAllOtherSessionsResponse\x12#\n" +
+	"\rrevoked_count\x18\x01 \x01(\x03R\frevokedCount\"I\n" +
+	"\x13GetSettingsResponse\x122\n" +
+	"\bsettings\x18\x01 \x01(\v2\x16.users.v1.UserSettingsR\bsettings\"N\n" +
+	"\x18GetUsersSettingsResponse\x122\n" +
+	"\bsettings\x18\x01 \x03(\v2\x16.users.v1.UserSettingsR\bsettings\"d\n" +
+	"\x16SecurityEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.users.v1.SecurityEventR\x06events\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xf6\x03\n" +
 	"\vUserProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
@@ -1302,14 +3220,88 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
 	"\vis_followed\x18\v \x01(\bR\n" +
-	"isFollowed\"z\n" +
+	"isFollowed\x12\x1a\n" +
+	"\bpronouns\x18\f \x01(\tR\bpronouns\x12\x1a\n" +
+	"\bbirthday\x18\r \x01(\tR\bbirthday\x12/\n" +
+	"\x13birthday_visibility\x18\x0e \x01(\tR\x12birthdayVisibility\x12+\n" +
+	"\x05links\x18\x0f \x03(\v2\x15.users.v1.ProfileLinkR\x05links\";\n" +
+	"\fProfileLinks\x12+\n" +
+	"\x05links\x18\x01 \x03(\v2\x15.users.v1.ProfileLinkR\x05links\"Q\n" +
+	"\vProfileLink\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bverified\x18\x03 \x01(\bR\bverified\"z\n" +
 	"\n" +
 	"UserBanner\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
 	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1d\n" +
 	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl2\xaa\b\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"\xef\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\x06 \x01(\bR\tisCurrent\"\xe8\x01\n" +
+	"\rSecurityEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb4\x02\n" +
+	"\fUserSettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x126\n" +
+	"\x17default_post_visibility\x18\x02 \x01(\tR\x15defaultPostVisibility\x12!\n" +
+	"\freply_policy\x18\x03 \x01(\tR\vreplyPolicy\x12%\n" +
+	"\x0emention_policy\x18\x04 \x01(\tR\rmentionPolicy\x12D\n" +
+	"\rnotifications\x18\x05 \x01(\v2\x1e.users.v1.NotificationSettingsR\rnotifications\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12'\n" +
+	"\x0fsensitive_media\x18\a \x01(\tR\x0esensitiveMedia\"~\n" +
+	"\x14NotificationSettings\x12\x14\n" +
+	"\x05likes\x18\x01 \x01(\bR\x05likes\x12\x1a\n" +
+	"\bcomments\x18\x02 \x01(\bR\bcomments\x12\x18\n" +
+	"\afollows\x18\x03 \x01(\bR\afollows\x12\x1a\n" +
+	"\bmentions\x18\x04 \x01(\bR\bmentions\".\n" +
+	"\x18RegistrationModeResponse\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"\x16\n" +
+	"\x14JoinWaitlistResponse\"\x84\x01\n" +
+	"\x10InviteRedemption\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12;\n" +
+	"\vredeemed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"redeemedAt\"\xd3\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\tissuer_id\x18\x03 \x01(\x03H\x00R\bissuerId\x88\x01\x01\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\vredemptions\x18\b \x03(\v2\x1a.users.v1.InviteRedemptionR\vredemptionsB\f\n" +
+	"\n" +
+	"_issuer_idB\r\n" +
+	"\v_expires_at\"@\n" +
+	"\x14CreateInviteResponse\x12(\n" +
+	"\x06invite\x18\x01 \x01(\v2\x10.users.v1.InviteR\x06invite\"\\\n" +
+	"\x13ListInvitesResponse\x12*\n" +
+	"\ainvites\x18\x01 \x03(\v2\x10.users.v1.InviteR\ainvites\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore2\xd2\x13\n" +
 	"\vUserService\x12=\n" +
 	"\bRegister\x12\x19.users.v1.RegisterRequest\x1a\x16.users.v1.AuthResponse\x127\n" +
 	"\x05Login\x12\x16.users.v1.LoginRequest\x1a\x16.users.v1.AuthResponse\x12>\n" +
@@ -1317,16 +3309,33 @@ const file_users_v1_users_proto_rawDesc = "" +
 	"\x0eGetCurrentUser\x12\x16.google.protobuf.Empty\x1a .users.v1.GetCurrentUserResponse\x12>\n" +
 	"\aGetUser\x12\x18.users.v1.GetUserRequest\x1a\x19.users.v1.GetUserResponse\x12F\n" +
 	"\vGetUserById\x12\x1c.users.v1.GetUserByIdRequest\x1a\x19.users.v1.GetUserResponse\x12A\n" +
-	"\bGetUsers\x12\x19.users.v1.GetUsersRequest\x1a\x1a.users.v1.GetUsersResponse\x12P\n" +
-	"\rUpdateProfile\x12\x1e.users.v1.UpdateProfileRequest\x1a\x1f.users.v1.UpdateProfileResponse\x12N\n" +
+	"\bGetUsers\x12\x19.users.v1.GetUsersRequest\x1a\x1a.users.v1.GetUsersResponse\x12b\n" +
+	"\x13GetUsersByUsernames\x12$.users.v1.GetUsersByUsernamesRequest\x1a%.users.v1.GetUsersByUsernamesResponse\x12P\n" +
+	"\rUpdateProfile\x12\x1e.users.v1.UpdateProfileRequest\x1a\x1f.users.v1.UpdateProfileResponse\x12k\n" +
+	"\x16SetProfileLinkVerified\x12'.users.v1.SetProfileLinkVerifiedRequest\x1a(.users.v1.SetProfileLinkVerifiedResponse\x12N\n" +
 	"\rListFollowers\x12\x1c.users.v1.GetUserByIdRequest\x1a\x1f.users.v1.ListFollowersResponse\x12N\n" +
 	"\rListFollowing\x12\x1c.users.v1.GetUserByIdRequest\x1a\x1f.users.v1.ListFollowingResponse\x12;\n" +
 	"\x06Follow\x12\x17.users.v1.FollowRequest\x1a\x18.users.v1.FollowResponse\x12A\n" +
-	"\bUnfollow\x12\x19.users.v1.UnfollowRequest\x1a\x1a.users.v1.UnfollowResponse\x12B\n" +
+	"\bUnfollow\x12\x19.users.v1.UnfollowRequest\x1a\x1a.users.v1.UnfollowResponse\x12V\n" +
+	"\x0fFilterFollowing\x12 .users.v1.FilterFollowingRequest\x1a!.users.v1.FilterFollowingResponse\x12B\n" +
 	"\n" +
 	"DeleteUser\x12\x16.google.protobuf.Empty\x1a\x1c.users.v1.DeleteUserResponse\x12J\n" +
 	"\vRestoreUser\x12\x1c.users.v1.RestoreUserRequest\x1a\x1d.users.v1.RestoreUserResponse\x12J\n" +
-	"\vSearchUsers\x12\x1c.users.v1.SearchUsersRequest\x1a\x1d.users.v1.SearchUsersResponseB\x14Z\x12./users/v1;usersv1b\x06proto3"
+	"\vSearchUsers\x12\x1c.users.v1.SearchUsersRequest\x1a\x1d.users.v1.SearchUsersResponse\x12F\n" +
+	"\fListSessions\x12\x16.google.protobuf.Empty\x1a\x1e.users.v1.ListSessionsResponse\x12P\n" +
+	"\rRevokeSession\x12\x1e.users.v1.RevokeSessionRequest\x1a\x1f.users.v1.RevokeSessionResponse\x12Z\n" +
+	"\x16RevokeAllOtherSessions\x12\x16.google.protobuf.Empty\x1a(.users.v1.RevokeAllOtherSessionsResponse\x12[\n" +
+	"\x12ListSecurityEvents\x12#.users.v1.ListSecurityEventsRequest\x1a .users.v1.SecurityEventsResponse\x12]\n" +
+	"\x13QuerySecurityEvents\x12$.users.v1.QuerySecurityEventsRequest\x1a .users.v1.SecurityEventsResponse\x12D\n" +
+	"\vGetSettings\x12\x16.google.protobuf.Empty\x1a\x1d.users.v1.GetSettingsResponse\x12P\n" +
+	"\x0eUpdateSettings\x12\x1f.users.v1.UpdateSettingsRequest\x1a\x1d.users.v1.GetSettingsResponse\x12Q\n" +
+	"\x10GetUsersSettings\x12\x19.users.v1.GetUsersRequest\x1a\".users.v1.GetUsersSettingsResponse\x12Q\n" +
+	"\x13GetRegistrationMode\x12\x16.google.protobuf.Empty\x1a\".users.v1.RegistrationModeResponse\x12M\n" +
+	"\fJoinWaitlist\x12\x1d.users.v1.JoinWaitlistRequest\x1a\x1e.users.v1.JoinWaitlistResponse\x12M\n" +
+	"\fCreateInvite\x12\x1d.users.v1.CreateInviteRequest\x1a\x1e.users.v1.CreateInviteResponse\x12J\n" +
+	"\vListInvites\x12\x1c.users.v1.ListInvitesRequest\x1a\x1d.users.v1.ListInvitesResponse\x12R\n" +
+	"\x11AdminCreateInvite\x12\x1d.users.v1.CreateInviteRequest\x1a\x1e.users.v1.CreateInviteResponse\x12O\n" +
+	"\x10AdminListInvites\x12\x1c.users.v1.ListInvitesRequest\x1a\x1d.users.v1.ListInvitesResponseB\x14Z\x12./users/v1;usersv1b\x06proto3"
 
 var (
 	file_users_v1_users_proto_rawDescOnce sync.Once
@@ -1340,78 +3349,164 @@ func file_users_v1_users_proto_rawDescGZIP() []byte {
 	return file_users_v1_users_proto_rawDescData
 }
 
-var file_users_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_users_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_users_v1_users_proto_goTypes = []any{
-	(*RegisterRequest)(nil),        // 0: users.v1.RegisterRequest
-	(*LoginRequest)(nil),           // 1: users.v1.LoginRequest
-	(*GetUserRequest)(nil),         // 2: users.v1.GetUserRequest
-	(*GetUserByIdRequest)(nil),     // 3: users.v1.GetUserByIdRequest
-	(*GetUsersRequest)(nil),        // 4: users.v1.GetUsersRequest
-	(*UpdateProfileRequest)(nil),   // 5: users.v1.UpdateProfileRequest
-	(*FollowRequest)(nil),          // 6: users.v1.FollowRequest
-	(*UnfollowRequest)(nil),        // 7: users.v1.UnfollowRequest
-	(*RestoreUserRequest)(nil),     // 8: users.v1.RestoreUserRequest
-	(*SearchUsersRequest)(nil),     // 9: users.v1.SearchUsersRequest
-	(*AuthResponse)(nil),           // 10: users.v1.AuthResponse
-	(*GetCurrentUserResponse)(nil), // 11: users.v1.GetCurrentUserResponse
-	(*GetUserResponse)(nil),        // 12: users.v1.GetUserResponse
-	(*GetUsersResponse)(nil),       // 13: users.v1.GetUsersResponse
-	(*ListFollowersResponse)(nil),  // 14: users.v1.ListFollowersResponse
-	(*ListFollowingResponse)(nil),  // 15: users.v1.ListFollowingResponse
-	(*UpdateProfileResponse)(nil),  // 16: users.v1.UpdateProfileResponse
-	(*DeleteUserResponse)(nil),     // 17: users.v1.DeleteUserResponse
-	(*RestoreUserResponse)(nil),    // 18: users.v1.RestoreUserResponse
-	(*FollowResponse)(nil),         // 19: users.v1.FollowResponse
-	(*UnfollowResponse)(nil),       // 20: users.v1.UnfollowResponse
-	(*SearchUsersResponse)(nil),    // 21: users.v1.SearchUsersResponse
-	(*UserProfile)(nil),            // 22: users.v1.UserProfile
-	(*UserBanner)(nil),             // 23: users.v1.UserBanner
-	(*timestamppb.Timestamp)(nil),  // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),          // 25: google.protobuf.Empty
+	(*RegisterRequest)(nil),                // 0: users.v1.RegisterRequest
+	(*LoginRequest)(nil),                   // 1: users.v1.LoginRequest
+	(*GetUserRequest)(nil),                 // 2: users.v1.GetUserRequest
+	(*GetUserByIdRequest)(nil),             // 3: users.v1.GetUserByIdRequest
+	(*GetUsersRequest)(nil),                // 4: users.v1.GetUsersRequest
+	(*GetUsersByUsernamesRequest)(nil),     // 5: users.v1.GetUsersByUsernamesRequest
+	(*UpdateProfileRequest)(nil),           // 6: users.v1.UpdateProfileRequest
+	(*SetProfileLinkVerifiedRequest)(nil),  // 7: users.v1.SetProfileLinkVerifiedRequest
+	(*FollowRequest)(nil),                  // 8: users.v1.FollowRequest
+	(*FilterFollowingRequest)(nil),         // 9: users.v1.FilterFollowingRequest
+	(*UnfollowRequest)(nil),                // 10: users.v1.UnfollowRequest
+	(*RestoreUserRequest)(nil),             // 11: users.v1.RestoreUserRequest
+	(*SearchUsersRequest)(nil),             // 12: users.v1.SearchUsersRequest
+	(*RevokeSessionRequest)(nil),           // 13: users.v1.RevokeSessionRequest
+	(*ListSecurityEventsRequest)(nil),      // 14: users.v1.ListSecurityEventsRequest
+	(*UpdateSettingsRequest)(nil),          // 15: users.v1.UpdateSettingsRequest
+	(*QuerySecurityEventsRequest)(nil),     // 16: users.v1.QuerySecurityEventsRequest
+	(*JoinWaitlistRequest)(nil),            // 17: users.v1.JoinWaitlistRequest
+	(*CreateInviteRequest)(nil),            // 18: users.v1.CreateInviteRequest
+	(*ListInvitesRequest)(nil),             // 19: users.v1.ListInvitesRequest
+	(*AuthResponse)(nil),                   // 20: users.v1.AuthResponse
+	(*GetCurrentUserResponse)(nil),         // 21: users.v1.GetCurrentUserResponse
+	(*GetUserResponse)(nil),                // 22: users.v1.GetUserResponse
+	(*GetUsersResponse)(nil),               // 23: users.v1.GetUsersResponse
+	(*GetUsersByUsernamesResponse)(nil),    // 24: users.v1.GetUsersByUsernamesResponse
+	(*ListFollowersResponse)(nil),          // 25: users.v1.ListFollowersResponse
+	(*ListFollowingResponse)(nil),          // 26: users.v1.ListFollowingResponse
+	(*UpdateProfileResponse)(nil),          // 27: users.v1.UpdateProfileResponse
+	(*SetProfileLinkVerifiedResponse)(nil), // 28: users.v1.SetProfileLinkVerifiedResponse
+	(*DeleteUserResponse)(nil),             // 29: users.v1.DeleteUserResponse
+	(*RestoreUserResponse)(nil),            // 30: users.v1.RestoreUserResponse
+	(*FollowResponse)(nil),                 // 31: users.v1.FollowResponse
+	(*UnfollowResponse)(nil),               // 32: users.v1.UnfollowResponse
+	(*FilterFollowingResponse)(nil),        // 33: users.v1.FilterFollowingResponse
+	(*SearchUsersResponse)(nil),            // 34: users.v1.SearchUsersResponse
+	(*ListSessionsResponse)(nil),           // 35: users.v1.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),          // 36: users.v1.RevokeSessionResponse
+	(*RevokeAllOtherSessionsResponse)(nil), // 37: users.v1.RevokeAllOtherSessionsResponse
+	(*GetSettingsResponse)(nil),            // 38: users.v1.GetSettingsResponse
+	(*GetUsersSettingsResponse)(nil),       // 39: users.v1.GetUsersSettingsResponse
+	(*SecurityEventsResponse)(nil),         // 40: users.v1.SecurityEventsResponse
+	(*UserProfile)(nil),                    // 41: users.v1.UserProfile
+	(*ProfileLinks)(nil),                   // 42: users.v1.ProfileLinks
+	(*ProfileLink)(nil),                    // 43: users.v1.ProfileLink
+	(*UserBanner)(nil),                     // 44: users.v1.UserBanner
+	(*Session)(nil),                        // 45: users.v1.Session
+	(*SecurityEvent)(nil),                  // 46: users.v1.SecurityEvent
+	(*UserSettings)(nil),                   // 47: users.v1.UserSettings
+	(*NotificationSettings)(nil),           // 48: users.v1.NotificationSettings
+	(*RegistrationModeResponse)(nil),       // 49: users.v1.RegistrationModeResponse
+	(*JoinWaitlistResponse)(nil),           // 50: users.v1.JoinWaitlistResponse
+	(*InviteRedemption)(nil),               // 51: users.v1.InviteRedemption
+	(*Invite)(nil),                         // 52: users.v1.Invite
+	(*CreateInviteResponse)(nil),           // 53: users.v1.CreateInviteResponse
+	(*ListInvitesResponse)(nil),            // 54: users.v1.ListInvitesResponse
+	(*timestamppb.Timestamp)(nil),          // 55: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 56: google.protobuf.Empty
 }
 var file_users_v1_users_proto_depIdxs = []int32{
-	22, // 0: users.v1.GetCurrentUserResponse.user:type_name -> users.v1.UserProfile
-	22, // 1: users.v1.GetUserResponse.user:type_name -> users.v1.UserProfile
-	22, // 2: users.v1.GetUsersResponse.users:type_name -> users.v1.UserProfile
-	23, // 3: users.v1.ListFollowersResponse.users:type_name -> users.v1.UserBanner
-	23, // 4: users.v1.ListFollowingResponse.users:type_name -> users.v1.UserBanner
-	23, // 5: users.v1.SearchUsersResponse.users:type_name -> users.v1.UserBanner
-	24, // 6: users.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: users.v1.UserService.Register:input_type -> users.v1.RegisterRequest
-	1,  // 8: users.v1.UserService.Login:input_type -> users.v1.LoginRequest
-	25, // 9: users.v1.UserService.RefreshToken:input_type -> google.protobuf.Empty
-	25, // 10: users.v1.UserService.GetCurrentUser:input_type -> google.protobuf.Empty
-	2,  // 11: users.v1.UserService.GetUser:input_type -> users.v1.GetUserRequest
-	3,  // 12: users.v1.UserService.GetUserById:input_type -> users.v1.GetUserByIdRequest
-	4,  // 13: users.v1.UserService.GetUsers:input_type -> users.v1.GetUsersRequest
-	5,  // 14: users.v1.UserService.UpdateProfile:input_type -> users.v1.UpdateProfileRequest
-	3,  // 15: users.v1.UserService.ListFollowers:input_type -> users.v1.GetUserByIdRequest
-	3,  // 16: users.v1.UserService.ListFollowing:input_type -> users.v1.GetUserByIdRequest
-	6,  // 17: users.v1.UserService.Follow:input_type -> users.v1.FollowRequest
-	7,  // 18: users.v1.UserService.Unfollow:input_type -> users.v1.UnfollowRequest
-	25, // 19: users.v1.UserService.DeleteUser:input_type -> google.protobuf.Empty
-	8,  // 20: users.v1.UserService.RestoreUser:input_type -> users.v1.RestoreUserRequest
-	9,  // 21: users.v1.UserService.SearchUsers:input_type -> users.v1.SearchUsersRequest
-	10, // 22: users.v1.UserService.Register:output_type -> users.v1.AuthResponse
-	10, // 23: users.v1.UserService.Login:output_type -> users.v1.AuthResponse
-	10, // 24: users.v1.UserService.RefreshToken:output_type -> users.v1.AuthResponse
-	11, // 25: users.v1.UserService.GetCurrentUser:output_type -> users.v1.GetCurrentUserResponse
-	12, // 26: users.v1.UserService.GetUser:output_type -> users.v1.GetUserResponse
-	12, // 27: users.v1.UserService.GetUserById:output_type -> users.v1.GetUserResponse
-	13, // 28: users.v1.UserService.GetUsers:output_type -> users.v1.GetUsersResponse
-	16, // 29: users.v1.UserService.UpdateProfile:output_type -> users.v1.UpdateProfileResponse
-	14, // 30: users.v1.UserService.ListFollowers:output_type -> users.v1.ListFollowersResponse
-	15, // 31: users.v1.UserService.ListFollowing:output_type -> users.v1.ListFollowingResponse
-	19, // 32: users.v1.UserService.Follow:output_type -> users.v1.FollowResponse
-	20, // 33: users.v1.UserService.Unfollow:output_type -> users.v1.UnfollowResponse
-	17, // 34: users.v1.UserService.DeleteUser:output_type -> users.v1.DeleteUserResponse
-	18, // 35: users.v1.UserService.RestoreUser:output_type -> users.v1.RestoreUserResponse
-	21, // 36: users.v1.UserService.SearchUsers:output_type -> users.v1.SearchUsersResponse
-	22, // [22:37] is the sub-list for method output_type
-	7,  // [7:22] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	42, // 0: users.v1.UpdateProfileRequest.links:type_name -> users.v1.ProfileLinks
+	55, // 1: users.v1.QuerySecurityEventsRequest.since:type_name -> google.protobuf.Timestamp
+	55, // 2: users.v1.QuerySecurityEventsRequest.until:type_name -> google.protobuf.Timestamp
+	55, // 3: users.v1.CreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	41, // 4: users.v1.GetCurrentUserResponse.user:type_name -> users.v1.UserProfile
+	41, // 5: users.v1.GetUserResponse.user:type_name -> users.v1.UserProfile
+	41, // 6: users.v1.GetUsersResponse.users:type_name -> users.v1.UserProfile
+	44, // 7: users.v1.GetUsersByUsernamesResponse.users:type_name -> users.v1.UserBanner
+	44, // 8: users.v1.ListFollowersResponse.users:type_name -> users.v1.UserBanner
+	44, // 9: users.v1.ListFollowingResponse.users:type_name -> users.v1.UserBanner
+	44, // 10: users.v1.SearchUsersResponse.users:type_name -> users.v1.UserBanner
+	45, // 11: users.v1.ListSessionsResponse.sessions:type_name -> users.v1.Session
+	47, // 12: users.v1.GetSettingsResponse.settings:type_name -> users.v1.UserSettings
+	47, // 13: users.v1.GetUsersSettingsResponse.settings:type_name -> users.v1.UserSettings
+	46, // 14: users.v1.SecurityEventsResponse.events:type_name -> users.v1.SecurityEvent
+	55, // 15: users.v1.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	43, // 16: users.v1.UserProfile.links:type_name -> users.v1.ProfileLink
+	43, // 17: users.v1.ProfileLinks.links:type_name -> users.v1.ProfileLink
+	55, // 18: users.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	55, // 19: users.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	55, // 20: users.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
+	48, // 21: users.v1.UserSettings.notifications:type_name -> users.v1.NotificationSettings
+	55, // 22: users.v1.InviteRedemption.redeemed_at:type_name -> google.protobuf.Timestamp
+	55, // 23: users.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	55, // 24: users.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	51, // 25: users.v1.Invite.redemptions:type_name -> users.v1.InviteRedemption
+	52, // 26: users.v1.CreateInviteResponse.invite:type_name -> users.v1.Invite
+	52, // 27: users.v1.ListInvitesResponse.invites:type_name -> users.v1.Invite
+	0,  // 28: users.v1.UserService.Register:input_type -> users.v1.RegisterRequest
+	1,  // 29: users.v1.UserService.Login:input_type -> users.v1.LoginRequest
+	56, // 30: users.v1.UserService.RefreshToken:input_type -> google.protobuf.Empty
+	56, // 31: users.v1.UserService.GetCurrentUser:input_type -> google.protobuf.Empty
+	2,  // 32: users.v1.UserService.GetUser:input_type -> users.v1.GetUserRequest
+	3,  // 33: users.v1.UserService.GetUserById:input_type -> users.v1.GetUserByIdRequest
+	4,  // 34: users.v1.UserService.GetUsers:input_type -> users.v1.GetUsersRequest
+	5,  // 35: users.v1.UserService.GetUsersByUsernames:input_type -> users.v1.GetUsersByUsernamesRequest
+	6,  // 36: users.v1.UserService.UpdateProfile:input_type -> users.v1.UpdateProfileRequest
+	7,  // 37: users.v1.UserService.SetProfileLinkVerified:input_type -> users.v1.SetProfileLinkVerifiedRequest
+	3,  // 38: users.v1.UserService.ListFollowers:input_type -> users.v1.GetUserByIdRequest
+	3,  // 39: users.v1.UserService.ListFollowing:input_type -> users.v1.GetUserByIdRequest
+	8,  // 40: users.v1.UserService.Follow:input_type -> users.v1.FollowRequest
+	10, // 41: users.v1.UserService.Unfollow:input_type -> users.v1.UnfollowRequest
+	9,  // 42: users.v1.UserService.FilterFollowing:input_type -> users.v1.FilterFollowingRequest
+	56, // 43: users.v1.UserService.DeleteUser:input_type -> google.protobuf.Empty
+	11, // 44: users.v1.UserService.RestoreUser:input_type -> users.v1.RestoreUserRequest
+	12, // 45: users.v1.UserService.SearchUsers:input_type -> users.v1.SearchUsersRequest
+	56, // 46: users.v1.UserService.ListSessions:input_type -> google.protobuf.Empty
+	13, // 47: users.v1.UserService.RevokeSession:input_type -> users.v1.RevokeSessionRequest
+	56, // 48: users.v1.UserService.RevokeAllOtherSessions:input_type -> google.protobuf.Empty
+	14, // 49: users.v1.UserService.ListSecurityEvents:input_type -> users.v1.ListSecurityEventsRequest
+	16, // 50: users.v1.UserService.QuerySecurityEvents:input_type -> users.v1.QuerySecurityEventsRequest
+	56, // 51: users.v1.UserService.GetSettings:input_type -> google.protobuf.Empty
+	15, // 52: users.v1.UserService.UpdateSettings:input_type -> users.v1.UpdateSettingsRequest
+	4,  // 53: users.v1.UserService.GetUsersSettings:input_type -> users.v1.GetUsersRequest
+	56, // 54: users.v1.UserService.GetRegistrationMode:input_type -> google.protobuf.Empty
+	17, // 55: users.v1.UserService.JoinWaitlist:input_type -> users.v1.JoinWaitlistRequest
+	18, // 56: users.v1.UserService.CreateInvite:input_type -> users.v1.CreateInviteRequest
+	19, // 57: users.v1.UserService.ListInvites:input_type -> users.v1.ListInvitesRequest
+	18, // 58: users.v1.UserService.AdminCreateInvite:input_type -> users.v1.CreateInviteRequest
+	19, // 59: users.v1.UserService.AdminListInvites:input_type -> users.v1.ListInvitesRequest
+	20, // 60: users.v1.UserService.Register:output_type -> users.v1.AuthResponse
+	20, // 61: users.v1.UserService.Login:output_type -> users.v1.AuthResponse
+	20, // 62: users.v1.UserService.RefreshToken:output_type -> users.v1.AuthResponse
+	21, // 63: users.v1.UserService.GetCurrentUser:output_type -> users.v1.GetCurrentUserResponse
+	22, // 64: users.v1.UserService.GetUser:output_type -> users.v1.GetUserResponse
+	22, // 65: users.v1.UserService.GetUserById:output_type -> users.v1.GetUserResponse
+	23, // 66: users.v1.UserService.GetUsers:output_type -> users.v1.GetUsersResponse
+	24, // 67: users.v1.UserService.GetUsersByUsernames:output_type -> users.v1.GetUsersByUsernamesResponse
+	27, // 68: users.v1.UserService.UpdateProfile:output_type -> users.v1.UpdateProfileResponse
+	28, // 69: users.v1.UserService.SetProfileLinkVerified:output_type -> users.v1.SetProfileLinkVerifiedResponse
+	25, // 70: users.v1.UserService.ListFollowers:output_type -> users.v1.ListFollowersResponse
+	26, // 71: users.v1.UserService.ListFollowing:output_type -> users.v1.ListFollowingResponse
+	31, // 72: users.v1.UserService.Follow:output_type -> users.v1.FollowResponse
+	32, // 73: users.v1.UserService.Unfollow:output_type -> users.v1.UnfollowResponse
+	33, // 74: users.v1.UserService.FilterFollowing:output_type -> users.v1.FilterFollowingResponse
+	29, // 75: users.v1.UserService.DeleteUser:output_type -> users.v1.DeleteUserResponse
+	30, // 76: users.v1.UserService.RestoreUser:output_type -> users.v1.RestoreUserResponse
+	34, // 77: users.v1.UserService.SearchUsers:output_type -> users.v1.SearchUsersResponse
+	35, // 78: users.v1.UserService.ListSessions:output_type -> users.v1.ListSessionsResponse
+	36, // 79: users.v1.UserService.RevokeSession:output_type -> users.v1.RevokeSessionResponse
+	37, // 80: users.v1.UserService.RevokeAllOtherSessions:output_type -> users.v1.RevokeAllOtherSessionsResponse
+	40, // 81: users.v1.UserService.ListSecurityEvents:output_type -> users.v1.SecurityEventsResponse
+	40, // 82: users.v1.UserService.QuerySecurityEvents:output_type -> users.v1.SecurityEventsResponse
+	38, // 83: users.v1.UserService.GetSettings:output_type -> users.v1.GetSettingsResponse
+	38, // 84: users.v1.UserService.UpdateSettings:output_type -> users.v1.GetSettingsResponse
+	39, // 85: users.v1.UserService.GetUsersSettings:output_type -> users.v1.GetUsersSettingsResponse
+	49, // 86: users.v1.UserService.GetRegistrationMode:output_type -> users.v1.RegistrationModeResponse
+	50, // 87: users.v1.UserService.JoinWaitlist:output_type -> users.v1.JoinWaitlistResponse
+	53, // 88: users.v1.UserService.CreateInvite:output_type -> users.v1.CreateInviteResponse
+	54, // 89: users.v1.UserService.ListInvites:output_type -> users.v1.ListInvitesResponse
+	53, // 90: users.v1.UserService.AdminCreateInvite:output_type -> users.v1.CreateInviteResponse
+	54, // 91: users.v1.UserService.AdminListInvites:output_type -> users.v1.ListInvitesResponse
+	60, // [60:92] is the sub-list for method output_type
+	28, // [28:60] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_users_v1_users_proto_init() }
@@ -1419,15 +3514,21 @@ func file_users_v1_users_proto_init() {
 	if File_users_v1_users_proto != nil {
 		return
 	}
-	file_users_v1_users_proto_msgTypes[5].OneofWrappers = []any{}
-	file_users_v1_users_proto_msgTypes[10].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[6].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[14].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[15].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[16].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[18].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[19].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[20].OneofWrappers = []any{}
+	file_users_v1_users_proto_msgTypes[52].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_v1_users_proto_rawDesc), len(file_users_v1_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1,7 +1,9 @@
 package token
 
 import (
+	"crypto/rand"
 	"crypto/rsa"
+	"encoding/hex"
 	"strconv"
 	"time"
 	"voidspace/users/internal/domain"
@@ -9,11 +11,12 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func CreateAccessToken(user *domain.User, privateKey *rsa.PrivateKey, expiry time.Duration) (string, error) {
+func CreateAccessToken(user *domain.User, session *domain.Session, privateKey *rsa.PrivateKey, expiry time.Duration) (string, error) {
 	exp := time.Now().Add(expiry).Unix()
 	claims := &domain.AccessTokenClaims{
-		ID:       strconv.Itoa(int(user.ID)),
-		Username: user.Username,
+		ID:        strconv.Itoa(int(user.ID)),
+		Username:  user.Username,
+		SessionID: strconv.Itoa(session.ID),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Unix(exp, 0)),
 		},
@@ -27,11 +30,13 @@ func CreateAccessToken(user *domain.User, privateKey *rsa.PrivateKey, expiry tim
 	return accessToken, nil
 }
 
-func CreateRefreshToken(user *domain.User, privateKey *rsa.PrivateKey, expiry time.Duration) (string, error) {
+func CreateRefreshToken(user *domain.User, session *domain.Session, privateKey *rsa.PrivateKey, expiry time.Duration) (string, error) {
 	exp := time.Now().Add(expiry).Unix()
 	claims := &domain.RefreshTokenClaims{
-		ID:       strconv.Itoa(int(user.ID)),
-		Username: user.Username,
+		ID:          strconv.Itoa(int(user.ID)),
+		Username:    user.Username,
+		SessionID:   strconv.Itoa(session.ID),
+		TokenFamily: session.TokenFamily,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Unix(exp, 0)),
 		},
@@ -44,3 +49,14 @@ func CreateRefreshToken(user *domain.User, privateKey *rsa.PrivateKey, expiry ti
 	}
 	return refreshToken, nil
 }
+
+// GenerateTokenFamily returns a random identifier shared by every refresh
+// token issued for the same session.
+func GenerateTokenFamily() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
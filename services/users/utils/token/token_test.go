@@ -34,7 +34,7 @@ func TestCreateAccessToken(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			token, err := CreateAccessToken(tc.user, privateKey, tc.expiry)
+			token, err := CreateAccessToken(tc.user, &domain.Session{ID: 1}, privateKey, tc.expiry)
 			if tc.shouldError {
 				assert.Error(t, err)
 				assert.Empty(t, token)
@@ -70,7 +70,7 @@ func TestCreateRefreshToken(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			token, err := CreateRefreshToken(tc.user, privateKey, tc.expiry)
+			token, err := CreateRefreshToken(tc.user, &domain.Session{ID: 1, TokenFamily: "family"}, privateKey, tc.expiry)
 			if tc.shouldError {
 				assert.Error(t, err)
 				assert.Empty(t, token)
@@ -4,6 +4,7 @@ go 1.24.5
 
 require (
 	github.com/jackc/pgx/v5 v5.8.0
+	github.com/rivo/uniseg v0.4.7
 	go.uber.org/zap v1.27.1
 )
 
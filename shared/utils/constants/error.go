@@ -29,6 +29,32 @@ var (
 	ErrInvalidCredentials = errors.New("Invalid credentials")
 )
 
+// Registration-related errors
+var (
+	ErrRegistrationClosed = errors.New("Registration is closed")
+	ErrInviteRequired     = errors.New("An invite code is required to register")
+	ErrInvalidInvite      = errors.New("Invite code is invalid or expired")
+	ErrInviteLimitReached = errors.New("Invite limit reached")
+	ErrAlreadyOnWaitlist  = errors.New("Already on the waitlist")
+)
+
+// Settings-related errors
+var (
+	ErrInvalidSettings = errors.New("Invalid settings")
+)
+
+// Session-related errors
+var (
+	ErrSessionNotFound = errors.New("Session not found")
+	ErrSessionRevoked  = errors.New("Session expired or revoked")
+)
+
+// Profile-related errors
+var (
+	ErrInvalidProfile      = errors.New("Invalid profile")
+	ErrProfileLinkNotFound = errors.New("Profile link not found")
+)
+
 // Follow-related errors
 var (
 	ErrAlreadyFollowing = errors.New("Already following this user")
@@ -41,6 +67,77 @@ var (
 	ErrAlreadyLiked       = errors.New("already liked this post")
 	ErrUserOrPostNotFound = errors.New("user or post not found")
 	ErrPostNotFound       = errors.New("post not found")
+	ErrPostTooLong        = errors.New("post content is too long")
+	ErrEditWindowClosed   = errors.New("post can no longer be edited")
+	ErrEditLimitReached   = errors.New("post edit limit reached")
+)
+
+// Scheduled post related errors
+var (
+	ErrScheduledPostNotFound = errors.New("scheduled post not found")
+	ErrInvalidPublishAt      = errors.New("publish time must be in the future")
+)
+
+// Draft related errors
+var (
+	ErrDraftNotFound = errors.New("draft not found")
+)
+
+// Poll related errors
+var (
+	ErrPollNotFound = errors.New("poll not found")
+	ErrInvalidPoll  = errors.New("invalid poll")
+	ErrInvalidVote  = errors.New("invalid poll vote")
+	ErrAlreadyVoted = errors.New("already voted in this poll")
+	ErrPollClosed   = errors.New("poll is closed")
+)
+
+// Link preview related errors
+var (
+	ErrLinkPreviewNotFound = errors.New("link preview not found")
+	ErrInvalidLinkPreview  = errors.New("invalid link preview")
+)
+
+// Content warning related errors
+var (
+	ErrInvalidContentWarning = errors.New("content warning is too long")
+)
+
+// Pin related errors
+var (
+	ErrPinLimitReached = errors.New("pinned post limit reached")
+)
+
+// Visibility related errors
+var (
+	ErrInvalidVisibility = errors.New("invalid post visibility")
+	ErrPostNotShareable  = errors.New("only public and unlisted posts can be reposted or quoted")
+)
+
+// Feed related errors
+var (
+	ErrFeedSnapshotExpired = errors.New("feed snapshot expired, reload the feed")
+	ErrTimelineNotBuilt    = errors.New("home timeline is not built yet")
+)
+
+// Reaction related errors
+var (
+	ErrInvalidReaction = errors.New("invalid reaction")
+)
+
+// Bookmark related errors
+var (
+	ErrInvalidBookmarkFolder = errors.New("invalid bookmark folder")
+)
+
+// Hashtag related errors
+var (
+	ErrInvalidHashtag = errors.New("invalid hashtag")
+)
+
+// Mention related errors
+var (
+	ErrInvalidMention = errors.New("invalid mention")
 )
 
 // Comment related errors
@@ -0,0 +1,12 @@
+-- byte_start and byte_end are byte offsets into comments.content
+CREATE TABLE IF NOT EXISTS comment_mentions (
+    comment_id BIGINT NOT NULL,
+    mentioned_user_id INT NOT NULL,
+    byte_start INT NOT NULL,
+    byte_end INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (comment_id, byte_start),
+    FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_mentions_user_created ON comment_mentions(mentioned_user_id, created_at DESC, comment_id DESC);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_posts_root_post_id;
+DROP INDEX IF EXISTS idx_posts_reply_to;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS root_post_id,
+    DROP COLUMN IF EXISTS reply_to_post_id;
@@ -0,0 +1,10 @@
+-- no foreign keys: a reply outlives a deleted parent and the thread simply
+-- stops where the chain breaks
+ALTER TABLE posts
+    ADD COLUMN reply_to_post_id INT DEFAULT NULL,
+    ADD COLUMN root_post_id INT DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_posts_reply_to ON posts(reply_to_post_id, created_at, id)
+    WHERE reply_to_post_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_posts_root_post_id ON posts(root_post_id)
+    WHERE root_post_id IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS post_reposts;
+
+DROP INDEX IF EXISTS idx_posts_quote_post_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS quote_post_id;
@@ -0,0 +1,16 @@
+-- like reply_to_post_id, a quote keeps pointing at a deleted post
+ALTER TABLE posts ADD COLUMN quote_post_id INT DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_posts_quote_post_id ON posts(quote_post_id)
+    WHERE quote_post_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS post_reposts (
+    post_id INT NOT NULL,
+    user_id INT NOT NULL,
+    created_at TIMESTAMP DEFAULT DATE_TRUNC('millisecond', NOW()),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_reposts_user_created ON post_reposts(user_id, created_at DESC);
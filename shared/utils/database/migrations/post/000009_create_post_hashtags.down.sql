@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS trending_hashtags;
+DROP TABLE IF EXISTS post_hashtags;
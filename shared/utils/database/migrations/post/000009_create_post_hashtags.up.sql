@@ -0,0 +1,20 @@
+-- tags are stored lowercased without the leading #, created_at is the post's
+-- created_at so hashtag feeds page like the global feed
+CREATE TABLE IF NOT EXISTS post_hashtags (
+    post_id INT NOT NULL,
+    tag VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (post_id, tag),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_hashtags_tag_created ON post_hashtags(tag, created_at DESC, post_id DESC);
+CREATE INDEX IF NOT EXISTS idx_post_hashtags_created ON post_hashtags(created_at);
+
+-- snapshot rebuilt by RefreshTrendingHashtags
+CREATE TABLE IF NOT EXISTS trending_hashtags (
+    tag VARCHAR(100) PRIMARY KEY,
+    uses INT NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT DATE_TRUNC('millisecond', NOW())
+);
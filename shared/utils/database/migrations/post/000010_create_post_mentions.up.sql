@@ -0,0 +1,13 @@
+-- byte_start and byte_end are byte offsets into posts.content, created_at is
+-- the post's created_at so the mentions feed pages like the other feeds
+CREATE TABLE IF NOT EXISTS post_mentions (
+    post_id INT NOT NULL,
+    mentioned_user_id INT NOT NULL,
+    byte_start INT NOT NULL,
+    byte_end INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (post_id, byte_start),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_mentions_user_created ON post_mentions(mentioned_user_id, created_at DESC, post_id DESC);
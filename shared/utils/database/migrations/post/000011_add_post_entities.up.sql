@@ -0,0 +1,3 @@
+-- entities is the tokenized content, posts written before this column existed
+-- stay NULL and are tokenized when read
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS entities JSONB;
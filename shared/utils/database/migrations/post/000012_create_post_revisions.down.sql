@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS post_revisions;
+ALTER TABLE posts DROP COLUMN IF EXISTS edit_count;
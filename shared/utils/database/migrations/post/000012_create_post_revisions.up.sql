@@ -0,0 +1,15 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS edit_count INT NOT NULL DEFAULT 0;
+
+-- each row is a version of the post before an edit, created_at is when that
+-- version was written
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id SERIAL PRIMARY KEY,
+    post_id INT NOT NULL,
+    content TEXT NOT NULL,
+    post_images JSONB,
+    entities JSONB,
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_revisions_post ON post_revisions(post_id, id);
@@ -0,0 +1,17 @@
+-- scheduled posts are kept out of posts until they are published, mentions
+-- holds the resolved mentions as sent with the request
+CREATE TABLE IF NOT EXISTS scheduled_posts (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    content TEXT NOT NULL,
+    post_images JSONB,
+    reply_to_post_id INT,
+    quote_post_id INT,
+    mentions JSONB,
+    publish_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_posts_user_publish ON scheduled_posts(user_id, publish_at);
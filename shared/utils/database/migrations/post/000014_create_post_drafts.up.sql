@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS post_drafts (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    content TEXT NOT NULL DEFAULT '',
+    post_images JSONB,
+    reply_to_post_id INT,
+    mentions JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_drafts_user_updated ON post_drafts(user_id, updated_at DESC);
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS polls (
+    post_id INT PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    ends_at TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id SERIAL PRIMARY KEY,
+    post_id INT NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    label TEXT NOT NULL,
+    UNIQUE (post_id, position)
+);
+
+-- one row per user per poll, this is what enforces a single vote even on
+-- multiple choice polls
+CREATE TABLE IF NOT EXISTS poll_voters (
+    post_id INT NOT NULL REFERENCES polls(post_id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    option_id INT NOT NULL REFERENCES poll_options(id) ON DELETE CASCADE,
+    post_id INT NOT NULL,
+    user_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (option_id, user_id),
+    FOREIGN KEY (post_id, user_id) REFERENCES poll_voters(post_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_options_post_id ON poll_options(post_id);
+CREATE INDEX IF NOT EXISTS idx_poll_votes_post_user ON poll_votes(post_id, user_id);
@@ -0,0 +1,13 @@
+-- folder is a free form name, NULL means unfiled
+CREATE TABLE IF NOT EXISTS post_bookmarks (
+    post_id INT NOT NULL,
+    user_id INT NOT NULL,
+    folder TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT DATE_TRUNC('millisecond', NOW()),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_bookmarks_user_created ON post_bookmarks(user_id, created_at DESC, post_id DESC);
+CREATE INDEX IF NOT EXISTS idx_post_bookmarks_user_folder ON post_bookmarks(user_id, folder, created_at DESC, post_id DESC);
@@ -0,0 +1,8 @@
+-- a post is pinned by its author only, so post_id alone is the key
+CREATE TABLE IF NOT EXISTS pinned_posts (
+    post_id INT PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT DATE_TRUNC('millisecond', NOW())
+);
+
+CREATE INDEX IF NOT EXISTS idx_pinned_posts_user_created ON pinned_posts(user_id, created_at DESC);
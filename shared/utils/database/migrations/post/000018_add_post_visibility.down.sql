@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_posts_public_created;
+
+ALTER TABLE post_drafts DROP COLUMN IF EXISTS visibility;
+
+ALTER TABLE scheduled_posts DROP COLUMN IF EXISTS visibility;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,13 @@
+-- who a post is shown to, checked by the posts service on every read
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'mentioned', 'unlisted'));
+
+ALTER TABLE scheduled_posts ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'mentioned', 'unlisted'));
+
+ALTER TABLE post_drafts ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'public'
+    CHECK (visibility IN ('public', 'followers', 'mentioned', 'unlisted'));
+
+-- discovery reads only public posts
+CREATE INDEX IF NOT EXISTS idx_posts_public_created ON posts(created_at DESC, id DESC)
+    WHERE visibility = 'public' AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+-- liked posts are paged by like time
+CREATE INDEX IF NOT EXISTS idx_post_likes_user_created ON post_likes(user_id, created_at DESC, post_id DESC)
+    WHERE deleted_at IS NULL;
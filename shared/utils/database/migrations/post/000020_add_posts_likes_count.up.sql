@@ -0,0 +1,13 @@
+-- kept in step with post_likes by the like, unlike and account lifecycle
+-- writes, a nightly job reconciles any drift
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS likes_count INT NOT NULL DEFAULT 0;
+
+UPDATE posts p
+SET likes_count = c.likes_count
+FROM (
+    SELECT post_id, COUNT(*) AS likes_count
+    FROM post_likes
+    WHERE deleted_at IS NULL
+    GROUP BY post_id
+) c
+WHERE p.id = c.post_id;
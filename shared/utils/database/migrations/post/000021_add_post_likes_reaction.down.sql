@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_post_likes_post_reaction;
+ALTER TABLE post_likes DROP COLUMN IF EXISTS reaction;
@@ -0,0 +1,5 @@
+-- a post_likes row is now a reaction, rows from before reactions were likes
+ALTER TABLE post_likes ADD COLUMN IF NOT EXISTS reaction VARCHAR(32) NOT NULL DEFAULT 'like';
+
+-- per reaction counts of a page of posts
+CREATE INDEX IF NOT EXISTS idx_post_likes_post_reaction ON post_likes(post_id, reaction) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE posts DROP COLUMN IF EXISTS link_preview_url;
+DROP TABLE IF EXISTS link_previews;
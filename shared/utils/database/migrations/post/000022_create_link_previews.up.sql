@@ -0,0 +1,12 @@
+-- unfurled by the gateway after a post is created, keyed by the URL as it
+-- appears in the post so every post linking to it shares one fetch
+CREATE TABLE IF NOT EXISTS link_previews (
+    url TEXT PRIMARY KEY,
+    title TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL DEFAULT '',
+    site_name TEXT NOT NULL DEFAULT '',
+    fetched_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS link_preview_url TEXT REFERENCES link_previews(url) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE scheduled_posts DROP COLUMN IF EXISTS content_warning;
+ALTER TABLE posts DROP COLUMN IF EXISTS content_warning;
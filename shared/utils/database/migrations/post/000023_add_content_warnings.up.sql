@@ -0,0 +1,5 @@
+-- set by the author when writing the post or by a moderator afterwards,
+-- sensitive images are flagged inside post_images
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS content_warning TEXT;
+
+ALTER TABLE scheduled_posts ADD COLUMN IF NOT EXISTS content_warning TEXT;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_posts_top_level_created;
+DROP TABLE IF EXISTS for_you_snapshots;
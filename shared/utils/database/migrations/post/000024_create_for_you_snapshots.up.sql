@@ -0,0 +1,15 @@
+-- a viewer's ranked For You feed, frozen when the first page is read so
+-- paging stays stable while scores move. Expired snapshots are dropped
+-- whenever a new one is written.
+CREATE TABLE IF NOT EXISTS for_you_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    post_ids INT[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_for_you_snapshots_created ON for_you_snapshots(created_at);
+
+-- candidates are the recent top level posts
+CREATE INDEX IF NOT EXISTS idx_posts_top_level_created ON posts(created_at DESC)
+    WHERE reply_to_post_id IS NULL AND deleted_at IS NULL;
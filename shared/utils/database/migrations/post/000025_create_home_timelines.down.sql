@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS timeline_celebrities;
+DROP TABLE IF EXISTS home_timeline_states;
+DROP TABLE IF EXISTS home_timelines;
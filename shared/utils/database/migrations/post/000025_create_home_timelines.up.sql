@@ -0,0 +1,32 @@
+-- materialized following feeds, filled by the gateway fanning new posts and
+-- reposts out to followers. source_id is the followed user that brought the
+-- post in: its author, or the reposter when repost is set. A post reaching
+-- the timeline from several sources has a row per source so unfollowing one
+-- keeps the others, reads show its latest entry.
+CREATE TABLE IF NOT EXISTS home_timelines (
+    user_id INT NOT NULL,
+    post_id INT NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    source_id INT NOT NULL,
+    repost BOOLEAN NOT NULL DEFAULT FALSE,
+    feed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, post_id, source_id, repost)
+);
+
+CREATE INDEX IF NOT EXISTS idx_home_timelines_user_feed ON home_timelines(user_id, feed_at DESC, post_id DESC);
+CREATE INDEX IF NOT EXISTS idx_home_timelines_user_source ON home_timelines(user_id, source_id);
+-- undoing a repost drops it from every timeline
+CREATE INDEX IF NOT EXISTS idx_home_timelines_post_source ON home_timelines(post_id, source_id) WHERE repost;
+
+-- a timeline is read only once it has been rebuilt from the user's follows,
+-- until then the gateway falls back to the pull feed
+CREATE TABLE IF NOT EXISTS home_timeline_states (
+    user_id INT PRIMARY KEY,
+    built_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- authors with too many followers to fan out to, their posts are pulled into
+-- timelines at read time
+CREATE TABLE IF NOT EXISTS timeline_celebrities (
+    user_id INT PRIMARY KEY,
+    marked_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
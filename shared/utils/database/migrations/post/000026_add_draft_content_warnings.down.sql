@@ -0,0 +1 @@
+ALTER TABLE post_drafts DROP COLUMN IF EXISTS content_warning;
@@ -0,0 +1,2 @@
+-- kept with the draft so publishing it applies the warning
+ALTER TABLE post_drafts ADD COLUMN IF NOT EXISTS content_warning TEXT;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS security_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    detail TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_events_user ON security_events(user_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_security_events_type ON security_events(event_type, id DESC);
+
+-- audit rows are append-only, no user_id foreign key so they outlive the account
+CREATE OR REPLACE FUNCTION reject_security_event_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'security_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER security_events_append_only
+    BEFORE UPDATE OR DELETE ON security_events
+    FOR EACH ROW EXECUTE FUNCTION reject_security_event_change();
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id INT PRIMARY KEY,
+    default_post_visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    reply_policy VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    mention_policy VARCHAR(20) NOT NULL DEFAULT 'everyone',
+    notify_likes BOOLEAN NOT NULL DEFAULT TRUE,
+    notify_comments BOOLEAN NOT NULL DEFAULT TRUE,
+    notify_follows BOOLEAN NOT NULL DEFAULT TRUE,
+    notify_mentions BOOLEAN NOT NULL DEFAULT TRUE,
+    language VARCHAR(16) NOT NULL DEFAULT 'en',
+    sensitive_media VARCHAR(10) NOT NULL DEFAULT 'blur',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_settings_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_default_post_visibility
+        CHECK (default_post_visibility IN ('public', 'followers', 'mentioned', 'unlisted')),
+    CONSTRAINT chk_reply_policy
+        CHECK (reply_policy IN ('everyone', 'following', 'mentioned', 'nobody')),
+    CONSTRAINT chk_mention_policy
+        CHECK (mention_policy IN ('everyone', 'following', 'nobody')),
+    CONSTRAINT chk_sensitive_media
+        CHECK (sensitive_media IN ('show', 'blur', 'hide'))
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS user_profile_links;
+
+ALTER TABLE user_profile
+    DROP CONSTRAINT IF EXISTS chk_birthday_visibility,
+    DROP COLUMN IF EXISTS birthday_visibility,
+    DROP COLUMN IF EXISTS birthday,
+    DROP COLUMN IF EXISTS pronouns;
@@ -0,0 +1,25 @@
+ALTER TABLE user_profile
+    ADD COLUMN IF NOT EXISTS pronouns VARCHAR(40) NULL,
+    ADD COLUMN IF NOT EXISTS birthday DATE NULL,
+    ADD COLUMN IF NOT EXISTS birthday_visibility VARCHAR(20) NOT NULL DEFAULT 'private';
+
+ALTER TABLE user_profile
+    ADD CONSTRAINT chk_birthday_visibility
+        CHECK (birthday_visibility IN ('public', 'followers', 'private'));
+
+CREATE TABLE IF NOT EXISTS user_profile_links (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    label VARCHAR(30) NOT NULL,
+    url VARCHAR(255) NOT NULL,
+    position SMALLINT NOT NULL DEFAULT 0,
+    verified_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_profile_link_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT unique_profile_link UNIQUE (user_id, url)
+);
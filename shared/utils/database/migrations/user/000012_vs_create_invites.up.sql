@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS invites (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    -- NULL when issued by an admin
+    issuer_id INT,
+    max_uses INT NOT NULL DEFAULT 1,
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_invites_issuer
+        FOREIGN KEY (issuer_id)
+        REFERENCES users(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT chk_invites_max_uses CHECK (max_uses > 0),
+    CONSTRAINT chk_invites_uses CHECK (uses >= 0 AND uses <= max_uses)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invites_issuer_id ON invites (issuer_id, id DESC);
+
+-- who invited whom, kept for abuse tracing
+CREATE TABLE IF NOT EXISTS invite_redemptions (
+    invite_id BIGINT NOT NULL,
+    user_id INT NOT NULL UNIQUE,
+    redeemed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (invite_id, user_id),
+
+    CONSTRAINT fk_redemptions_invite
+        FOREIGN KEY (invite_id)
+        REFERENCES invites(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_redemptions_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS registration_waitlist (
+    email CITEXT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
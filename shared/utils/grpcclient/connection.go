@@ -1,4 +1,4 @@
-package bootstrap
+package grpcclient
 
 import (
 	"crypto/tls"
@@ -20,12 +20,34 @@ func HandleError(err error, logger *zap.Logger, operation string) error {
 	switch {
 	case errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled):
 		return status.Error(codes.DeadlineExceeded, constants.RequestTimeout)
+	case errors.Is(err, constants.ErrInvalidData):
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, constants.ErrUserNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, constants.ErrUserExists):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, constants.ErrInvalidCredentials):
 		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, constants.ErrRegistrationClosed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrInviteRequired):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrInvalidInvite):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInviteLimitReached):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrAlreadyOnWaitlist):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, constants.ErrInvalidSettings):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInvalidProfile):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrProfileLinkNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrSessionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrSessionRevoked):
+		return status.Error(codes.Unauthenticated, err.Error())
 	case errors.Is(err, constants.ErrAlreadyFollowing):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, constants.ErrAlreadyLiked):
@@ -39,6 +61,52 @@ func HandleError(err error, logger *zap.Logger, operation string) error {
 		// Post Not found
 	case errors.Is(err, constants.ErrPostNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrPostTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrEditWindowClosed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrEditLimitReached):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrScheduledPostNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrInvalidPublishAt):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrDraftNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrPollNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrInvalidPoll):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInvalidVote):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrAlreadyVoted):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, constants.ErrPollClosed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrLinkPreviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrInvalidLinkPreview):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInvalidContentWarning):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrPinLimitReached):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrInvalidVisibility):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrPostNotShareable):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, constants.ErrFeedSnapshotExpired):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, constants.ErrTimelineNotBuilt):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, constants.ErrInvalidReaction):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInvalidBookmarkFolder):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInvalidHashtag):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, constants.ErrInvalidMention):
+		return status.Error(codes.InvalidArgument, err.Error())
 		// Comment Not Found
 	case errors.Is(err, constants.ErrCommentNotFound):
 		return status.Error(codes.NotFound, err.Error())
@@ -27,3 +27,15 @@ func GetEnvInt(key string, fallback int) int {
 	log.Println("Using fallback for value", key)
 	return fallback
 }
+
+func GetEnvFloat(key string, fallback float64) float64 {
+	if value, ok := os.LookupEnv(key); ok {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return parsed
+		}
+	}
+
+	log.Println("Using fallback for value", key)
+	return fallback
+}
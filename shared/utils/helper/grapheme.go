@@ -0,0 +1,13 @@
+package helper
+
+import "github.com/rivo/uniseg"
+
+// MaxPostLength is the post content limit in grapheme clusters, so an emoji
+// with skin tone or a flag counts as one character like users see it.
+const MaxPostLength = 240
+
+// GraphemeCount counts user-perceived characters in s, the extended grapheme
+// clusters of Unicode text segmentation.
+func GraphemeCount(s string) int {
+	return uniseg.GraphemeClusterCount(s)
+}
@@ -0,0 +1,31 @@
+package helper
+
+import "testing"
+
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    int
+	}{
+		{"empty", "", 0},
+		{"ascii", "hello", 5},
+		{"crlf", "a\r\nb", 3},
+		{"combining accent", "e\u0301", 1},
+		{"skin tone", "👍🏽", 1},
+		{"zwj family", "👨\u200d👩\u200d👧\u200d👦", 1},
+		{"flags", "🇮🇩🇯🇵", 2},
+		{"odd regional indicator", "🇮🇩🇯", 2},
+		{"keycap", "1\ufe0f\u20e3", 1},
+		{"hangul jamo", "\u1100\u1161\u11a8", 1},
+		{"hangul syllables", "한국어", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GraphemeCount(tt.content); got != tt.want {
+				t.Errorf("GraphemeCount(%q) = %d, want %d", tt.content, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package helper
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxMentions        = 10
+	minMentionUsername = 3
+	maxMentionUsername = 30
+)
+
+// MentionSpan is an @username found in content, Start and End are byte
+// offsets and include the @.
+type MentionSpan struct {
+	Username string
+	Start    int
+	End      int
+}
+
+func isUsernameByte(b byte) bool {
+	return b < utf8.RuneSelf && (b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9')
+}
+
+// mentionCanStartAfter stops emails like bob@example.com from being read as
+// mentions.
+func mentionCanStartAfter(content string, at int) bool {
+	if at == 0 {
+		return true
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(content[:at])
+	return !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '@' || r == '.')
+}
+
+// ExtractMentions returns the @username spans in content, at most
+// MaxMentions. Usernames follow the registration rules, 3 to 30 ASCII letters
+// or digits.
+func ExtractMentions(content string) []MentionSpan {
+	spans := make([]MentionSpan, 0)
+
+	for i := 0; i < len(content) && len(spans) < MaxMentions; i++ {
+		if content[i] != '@' || !mentionCanStartAfter(content, i) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(content) && isUsernameByte(content[end]) {
+			end++
+		}
+
+		if IsMentionAt(content, i, end) {
+			spans = append(spans, MentionSpan{
+				Username: content[i+1 : end],
+				Start:    i,
+				End:      end,
+			})
+		}
+
+		i = end - 1
+	}
+
+	return spans
+}
+
+// IsMentionAt reports whether content[start:end] is exactly one mention as
+// ExtractMentions would find it.
+func IsMentionAt(content string, start, end int) bool {
+	if start < 0 || end > len(content) || start >= end || content[start] != '@' {
+		return false
+	}
+
+	length := end - start - 1
+	if length < minMentionUsername || length > maxMentionUsername {
+		return false
+	}
+
+	for i := start + 1; i < end; i++ {
+		if !isUsernameByte(content[i]) {
+			return false
+		}
+	}
+
+	if end < len(content) && (isUsernameByte(content[end]) || content[end] == '_') {
+		return false
+	}
+
+	return mentionCanStartAfter(content, start)
+}
+
+// ValidMentions checks spans sent with a write, every span must be a mention
+// and spans must not overlap.
+func ValidMentions(content string, spans []MentionSpan) bool {
+	if len(spans) > MaxMentions {
+		return false
+	}
+
+	sorted := make([]MentionSpan, len(spans))
+	copy(sorted, spans)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	for i, span := range sorted {
+		if !IsMentionAt(content, span.Start, span.End) {
+			return false
+		}
+		if i > 0 && span.Start < sorted[i-1].End {
+			return false
+		}
+	}
+
+	return true
+}
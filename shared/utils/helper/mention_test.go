@@ -0,0 +1,52 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []MentionSpan
+	}{
+		{"none", "hello", []MentionSpan{}},
+		{"start", "@alice hi", []MentionSpan{{"alice", 0, 6}}},
+		{"punctuation", "hi @bob123, @carol!", []MentionSpan{{"bob123", 3, 10}, {"carol", 12, 18}}},
+		{"email", "mail bob@example.com", []MentionSpan{}},
+		{"too short", "@ab", []MentionSpan{}},
+		{"underscore breaks username", "@alice_x", []MentionSpan{}},
+		{"after multibyte", "é @dave", []MentionSpan{{"dave", 3, 8}}},
+		{"double at", "@@erin", []MentionSpan{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractMentions(tt.content)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractMentions(%q) = %v, want %v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidMentions(t *testing.T) {
+	content := "hi @alice and @bob123"
+
+	if !ValidMentions(content, ExtractMentions(content)) {
+		t.Error("extracted mentions should be valid")
+	}
+
+	if ValidMentions(content, []MentionSpan{{Start: 4, End: 9}}) {
+		t.Error("span without the @ should be invalid")
+	}
+
+	if ValidMentions(content, []MentionSpan{{Start: 3, End: 7}}) {
+		t.Error("span cutting a username short should be invalid")
+	}
+
+	if ValidMentions(content, []MentionSpan{{Start: 3, End: 9}, {Start: 3, End: 9}}) {
+		t.Error("overlapping spans should be invalid")
+	}
+}
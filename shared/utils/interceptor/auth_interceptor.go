@@ -14,10 +14,42 @@ import (
 type CtxKey string
 
 const (
-	CtxKeyUserID   CtxKey = "userID"
-	CtxKeyUsername CtxKey = "username"
+	CtxKeyUserID      CtxKey = "userID"
+	CtxKeyUsername    CtxKey = "username"
+	CtxKeySessionID   CtxKey = "sessionID"
+	CtxKeyTokenFamily CtxKey = "tokenFamily"
+	CtxKeyClientIP    CtxKey = "clientIP"
+	CtxKeyUserAgent   CtxKey = "userAgent"
 )
 
+// withClientInfo copies the optional session and client metadata forwarded
+// by the gateway into the context. These are never required for auth.
+func withClientInfo(ctx context.Context, md metadata.MD) context.Context {
+	if md == nil {
+		return ctx
+	}
+
+	if sessionIDArr := md.Get("session_id"); len(sessionIDArr) > 0 {
+		if sessionID, err := strconv.Atoi(sessionIDArr[0]); err == nil {
+			ctx = context.WithValue(ctx, CtxKeySessionID, sessionID)
+		}
+	}
+
+	if familyArr := md.Get("token_family"); len(familyArr) > 0 {
+		ctx = context.WithValue(ctx, CtxKeyTokenFamily, familyArr[0])
+	}
+
+	if ipArr := md.Get("client_ip"); len(ipArr) > 0 {
+		ctx = context.WithValue(ctx, CtxKeyClientIP, ipArr[0])
+	}
+
+	if userAgentArr := md.Get("client_user_agent"); len(userAgentArr) > 0 {
+		ctx = context.WithValue(ctx, CtxKeyUserAgent, userAgentArr[0])
+	}
+
+	return ctx
+}
+
 func AuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -42,14 +74,49 @@ func AuthInterceptor() grpc.UnaryServerInterceptor {
 			"/users.v1.UserService/ListFollowing": true,
 			"/users.v1.UserService/SearchUsers":   true,
 
+			"/users.v1.UserService/GetRegistrationMode": true,
+			"/users.v1.UserService/JoinWaitlist":        true,
+
+			// Internal
+			"/users.v1.UserService/GetUsersSettings":    true,
+			"/users.v1.UserService/GetUsersByUsernames": true,
+			"/users.v1.UserService/FilterFollowing":     true,
+
+			// Admin, guarded by the gateway
+			"/users.v1.UserService/QuerySecurityEvents": true,
+			"/users.v1.UserService/AdminCreateInvite":   true,
+			"/users.v1.UserService/AdminListInvites":    true,
+
 			// Post
 			"/posts.v1.PostService/GetPost":                  true,
+			"/posts.v1.PostService/GetThread":                true,
+			"/posts.v1.PostService/GetPostRevisions":         true,
 			"/posts.v1.PostService/GetLikedPosts":            true,
 			"/posts.v1.PostService/GetUserPosts":             true,
 			"/posts.v1.PostService/GetGlobalFeed":            true,
 			"/posts.v1.PostService/HandleAccountDeletion":    true,
 			"/posts.v1.PostService/HandleAccountRestoration": true,
 			"/posts.v1.PostService/SearchPosts":              true,
+			"/posts.v1.PostService/GetHashtagFeed":           true,
+			"/posts.v1.PostService/GetTrendingHashtags":      true,
+			"/posts.v1.PostService/ListPostLikers":           true,
+			"/posts.v1.PostService/ListReactions":            true,
+
+			// Internal
+			"/posts.v1.PostService/RefreshTrendingHashtags": true,
+			"/posts.v1.PostService/PublishScheduledPost":    true,
+			"/posts.v1.PostService/ClosePoll":               true,
+			"/posts.v1.PostService/ListPollVoters":          true,
+			"/posts.v1.PostService/GetLinkPreview":          true,
+			"/posts.v1.PostService/SetLinkPreview":          true,
+			"/posts.v1.PostService/ReconcileLikesCount":     true,
+			"/posts.v1.PostService/FanOutPost":              true,
+			"/posts.v1.PostService/BackfillTimeline":        true,
+			"/posts.v1.PostService/ClearTimelineSource":     true,
+			"/posts.v1.PostService/SetTimelineCelebrity":    true,
+
+			// Admin, guarded by the gateway
+			"/posts.v1.PostService/SetContentFlags": true,
 
 			// Comments
 			"/comments.v1.CommentService/GetAllCommentsByPostId":   true,
@@ -63,6 +130,8 @@ func AuthInterceptor() grpc.UnaryServerInterceptor {
 
 		isSkippedMethod := skipAuthMethods[info.FullMethod]
 
+		ctx = withClientInfo(ctx, md)
+
 		// Metadata optional for skipped methods
 		if isSkippedMethod {
 			// try to get metadata
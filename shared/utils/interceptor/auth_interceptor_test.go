@@ -117,3 +117,39 @@ func TestAuthInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthInterceptorClientInfo(t *testing.T) {
+	interceptor := AuthInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"user_id":           "1",
+		"username":          "test",
+		"session_id":        "42",
+		"token_family":      "family",
+		"client_ip":         "203.0.113.7",
+		"client_user_agent": "test-agent",
+	}))
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/users.v1.UserService/ListSessions",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		if sessionID, _ := ctx.Value(CtxKeySessionID).(int); sessionID != 42 {
+			t.Errorf("expected session id 42, got %v", ctx.Value(CtxKeySessionID))
+		}
+		if family, _ := ctx.Value(CtxKeyTokenFamily).(string); family != "family" {
+			t.Errorf("expected token family, got %v", ctx.Value(CtxKeyTokenFamily))
+		}
+		if ip, _ := ctx.Value(CtxKeyClientIP).(string); ip != "203.0.113.7" {
+			t.Errorf("expected client ip, got %v", ctx.Value(CtxKeyClientIP))
+		}
+		if userAgent, _ := ctx.Value(CtxKeyUserAgent).(string); userAgent != "test-agent" {
+			t.Errorf("expected user agent, got %v", ctx.Value(CtxKeyUserAgent))
+		}
+		return nil, nil
+	}
+
+	if _, err := interceptor(ctx, nil, info, handler); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}